@@ -25,6 +25,7 @@ require (
 	github.com/xlab/treeprint v1.1.0
 	golang.org/x/mod v0.8.0
 	golang.org/x/sync v0.1.0
+	golang.org/x/term v0.5.0
 	golang.org/x/text v0.7.0
 	gopkg.in/ini.v1 v1.67.0
 	gopkg.in/yaml.v3 v3.0.1
@@ -55,7 +56,6 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.5.0 // indirect
-	golang.org/x/term v0.5.0 // indirect
 	golang.org/x/tools v0.6.0 // indirect
 	google.golang.org/protobuf v1.28.0 // indirect
 )
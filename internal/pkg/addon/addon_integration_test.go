@@ -32,6 +32,24 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "aurora.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.WorkloadRedisTemplate(addon.RedisProps{
+				ClusterName: "redis",
+				Engine:      "Redis",
+				Mode:        "cluster",
+				Envs:        []string{"test"},
+			}),
+			outFileName: "redis.yml",
+		},
+		"valkey serverless": {
+			addonMarshaler: addon.WorkloadRedisTemplate(addon.RedisProps{
+				ClusterName: "sessions-cache",
+				Engine:      "Valkey",
+				Mode:        "serverless",
+				Envs:        []string{"test"},
+			}),
+			outFileName: "valkey-serverless.yml",
+		},
 		"ddb": {
 			addonMarshaler: addon.WorkloadDDBTemplate(&addon.DynamoDBProps{
 				StorageProps: &addon.StorageProps{
@@ -20,6 +20,7 @@ const (
 	rdsRDWSTemplatePath   = "addons/aurora/rdws/cf.yml"
 	rdsV2RDWSTemplatePath = "addons/aurora/rdws/serverlessv2.yml"
 	rdsRDWSParamsPath     = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath     = "addons/redis/cf.yml"
 
 	envS3TemplatePath                   = "addons/s3/env/cf.yml"
 	envS3AccessPolicyTemplatePath       = "addons/s3/env/access_policy.yml"
@@ -30,6 +31,7 @@ const (
 	envRDSForRDWSTemplatePath           = "addons/aurora/env/rdws/serverlessv2.yml"
 	envRDSIngressForRDWSTemplatePath    = "addons/aurora/env/rdws/ingress.yml"
 	envRDSIngressForRDWSParamsPath      = "addons/aurora/env/rdws/ingress.addons.parameters.yml"
+	envRedisTemplatePath                = "addons/redis/env/cf.yml"
 )
 
 const (
@@ -44,6 +46,18 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
+// Engine types for ElastiCache.
+const (
+	RedisEngineTypeRedis  = "Redis"
+	RedisEngineTypeValkey = "Valkey"
+)
+
+// Deployment modes for ElastiCache.
+const (
+	RedisModeCluster    = "cluster"
+	RedisModeServerless = "serverless"
+)
+
 var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
 
 var storageTemplateFunctions = map[string]interface{}{
@@ -51,6 +65,7 @@ var storageTemplateFunctions = map[string]interface{}{
 	"envVarName":    template.EnvVarNameFunc,
 	"envVarSecret":  template.EnvVarSecretFunc,
 	"toSnakeCase":   template.ToSnakeCaseFunc,
+	"toLower":       strings.ToLower,
 }
 
 // StorageProps holds basic input properties for S3Props and DynamoDBProps.
@@ -420,6 +435,49 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisProps holds ElastiCache-specific properties.
+type RedisProps struct {
+	ClusterName string   // The name of the cache.
+	Engine      string   // The engine type of the cache, either Redis or Valkey.
+	Mode        string   // Whether the cache is a serverless cache or a replication group.
+	Envs        []string // The copilot environments found inside the current app.
+}
+
+// WorkloadRedisTemplate creates a marshaler for a workload-level ElastiCache addon.
+func WorkloadRedisTemplate(input RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: input,
+		parser:     template.New(),
+		tmplPath:   redisTemplatePath,
+	}
+}
+
+// EnvRedisTemplate creates a marshaler for an environment-level ElastiCache addon.
+func EnvRedisTemplate(input RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: input,
+		parser:     template.New(),
+		tmplPath:   envRedisTemplatePath,
+	}
+}
+
+// RedisTemplate contains configuration options which fully describe an ElastiCache cache.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(r.tmplPath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 func newLSI(partitionKey string, lsis []string) ([]DDBLocalSecondaryIndex, error) {
 	var output []DDBLocalSecondaryIndex
 	for _, lsi := range lsis {
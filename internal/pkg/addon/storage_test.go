@@ -251,6 +251,74 @@ func TestRDSParams_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mode   string
+		engine string
+
+		mockDependencies func(ctrl *gomock.Controller, r *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mode:   RedisModeCluster,
+			engine: RedisEngineTypeRedis,
+			mockDependencies: func(ctrl *gomock.Controller, r *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Any(), *r, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"renders redis replication group content": {
+			mode:   RedisModeCluster,
+			engine: RedisEngineTypeRedis,
+			mockDependencies: func(ctrl *gomock.Controller, r *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Eq("mockPath"), *r, gomock.Any()).
+					Return(&template.Content{Buffer: bytes.NewBufferString("redis")}, nil)
+			},
+			wantedBinary: []byte("redis"),
+		},
+		"renders valkey serverless content": {
+			mode:   RedisModeServerless,
+			engine: RedisEngineTypeValkey,
+			mockDependencies: func(ctrl *gomock.Controller, r *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(gomock.Eq("mockPath"), *r, gomock.Any()).
+					Return(&template.Content{Buffer: bytes.NewBufferString("valkey")}, nil)
+			},
+			wantedBinary: []byte("valkey"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{
+				RedisProps: RedisProps{
+					Engine: tc.engine,
+					Mode:   tc.mode,
+				},
+				tmplPath: "mockPath",
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestDDBAttributeFromKey(t *testing.T) {
 	testCases := map[string]struct {
 		input     string
@@ -597,4 +665,14 @@ func TestConstructors(t *testing.T) {
 		out := EnvServerlessRDWSIngressTemplate(RDSIngressProps{})
 		require.Equal(t, envRDSIngressForRDWSTemplatePath, out.tmplPath)
 	})
+
+	t.Run("marshaler for workload-level redis", func(t *testing.T) {
+		out := WorkloadRedisTemplate(RedisProps{})
+		require.Equal(t, redisTemplatePath, out.tmplPath)
+	})
+
+	t.Run("marshaler for env-level redis", func(t *testing.T) {
+		out := EnvRedisTemplate(RedisProps{})
+		require.Equal(t, envRedisTemplatePath, out.tmplPath)
+	})
 }
@@ -15,12 +15,21 @@ import (
 
 const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
-	fmtECSResourceID    = "service/%s/%s"
-	ecsServiceNamespace = "ecs"
+	fmtECSResourceID          = "service/%s/%s"
+	ecsServiceNamespace       = "ecs"
+	ecsServiceDesiredCountDim = "ecs:service:DesiredCount"
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(input *aas.RegisterScalableTargetInput) (*aas.RegisterScalableTargetOutput, error)
+}
+
+// ScalableTarget holds the capacity range that Application Auto Scaling scales a resource within.
+type ScalableTarget struct {
+	MinCapacity int64
+	MaxCapacity int64
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +71,39 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceScalableTarget returns the capacity range of the ECS service.
+// If the service does not scale automatically, it returns an ErrScalableTargetNotFound error.
+func (a *ApplicationAutoscaling) ECSServiceScalableTarget(cluster, service string) (*ScalableTarget, error) {
+	resourceID := fmt.Sprintf(fmtECSResourceID, cluster, service)
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{resourceID}),
+		ScalableDimension: aws.String(ecsServiceDesiredCountDim),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, &ErrScalableTargetNotFound{resourceID: resourceID}
+	}
+	target := resp.ScalableTargets[0]
+	return &ScalableTarget{
+		MinCapacity: aws.Int64Value(target.MinCapacity),
+		MaxCapacity: aws.Int64Value(target.MaxCapacity),
+	}, nil
+}
+
+// UpdateECSServiceScalableTarget updates the capacity range that the ECS service scales within.
+func (a *ApplicationAutoscaling) UpdateECSServiceScalableTarget(cluster, service string, target ScalableTarget) error {
+	if _, err := a.client.RegisterScalableTarget(&aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension: aws.String(ecsServiceDesiredCountDim),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+		MinCapacity:       aws.Int64(target.MinCapacity),
+		MaxCapacity:       aws.Int64(target.MaxCapacity),
+	}); err != nil {
+		return fmt.Errorf("update scalable target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	return nil
+}
@@ -139,3 +139,105 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceScalableTarget(t *testing.T) {
+	mockIn := &aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+		ScalableDimension: aws.String("ecs:service:DesiredCount"),
+		ServiceNamespace:  aws.String("ecs"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr    error
+		wantTarget *ScalableTarget
+	}{
+		"errors if failed to describe the scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(mockIn).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scalable target for ECS service mockCluster/mockService: some error"),
+		},
+		"errors if the service does not scale automatically": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(mockIn).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+			wantErr: &ErrScalableTargetNotFound{resourceID: "service/mockCluster/mockService"},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(mockIn).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantTarget: &ScalableTarget{MinCapacity: 1, MaxCapacity: 10},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{client: mocks.NewMockapi(ctrl)}
+			tc.setupMocks(m)
+			a := ApplicationAutoscaling{client: m.client}
+
+			got, err := a.ECSServiceScalableTarget("mockCluster", "mockService")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantTarget, got)
+		})
+	}
+}
+
+func TestApplicationAutoscaling_UpdateECSServiceScalableTarget(t *testing.T) {
+	mockIn := &aas.RegisterScalableTargetInput{
+		ResourceId:        aws.String("service/mockCluster/mockService"),
+		ScalableDimension: aws.String("ecs:service:DesiredCount"),
+		ServiceNamespace:  aws.String("ecs"),
+		MinCapacity:       aws.Int64(2),
+		MaxCapacity:       aws.Int64(20),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr error
+	}{
+		"errors if failed to register the scalable target": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(mockIn).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("update scalable target for ECS service mockCluster/mockService: some error"),
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().RegisterScalableTarget(mockIn).Return(&aas.RegisterScalableTargetOutput{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := aasMocks{client: mocks.NewMockapi(ctrl)}
+			tc.setupMocks(m)
+			a := ApplicationAutoscaling{client: m.client}
+
+			err := a.UpdateECSServiceScalableTarget("mockCluster", "mockService", ScalableTarget{MinCapacity: 2, MaxCapacity: 20})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aas
+
+import "fmt"
+
+// ErrScalableTargetNotFound occurs when a resource is not registered with Application Auto Scaling.
+type ErrScalableTargetNotFound struct {
+	resourceID string
+}
+
+func (e *ErrScalableTargetNotFound) Error() string {
+	return fmt.Sprintf("no scalable target found for %s", e.resourceID)
+}
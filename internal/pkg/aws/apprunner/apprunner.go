@@ -43,6 +43,9 @@ type api interface {
 	StartDeployment(input *apprunner.StartDeploymentInput) (*apprunner.StartDeploymentOutput, error)
 	DescribeObservabilityConfiguration(input *apprunner.DescribeObservabilityConfigurationInput) (*apprunner.DescribeObservabilityConfigurationOutput, error)
 	DescribeVpcIngressConnection(input *apprunner.DescribeVpcIngressConnectionInput) (*apprunner.DescribeVpcIngressConnectionOutput, error)
+	DescribeAutoScalingConfiguration(input *apprunner.DescribeAutoScalingConfigurationInput) (*apprunner.DescribeAutoScalingConfigurationOutput, error)
+	CreateAutoScalingConfiguration(input *apprunner.CreateAutoScalingConfigurationInput) (*apprunner.CreateAutoScalingConfigurationOutput, error)
+	UpdateService(input *apprunner.UpdateServiceInput) (*apprunner.UpdateServiceOutput, error)
 }
 
 // AppRunner wraps an AWS AppRunner client.
@@ -224,6 +227,54 @@ func (a *AppRunner) WaitForOperation(operationId, svcARN string) error {
 	}
 }
 
+// ServiceAutoScalingConfiguration returns the auto scaling configuration of an App Runner service.
+func (a *AppRunner) ServiceAutoScalingConfiguration(svcARN string) (*AutoScalingConfiguration, error) {
+	svc, err := a.client.DescribeService(&apprunner.DescribeServiceInput{
+		ServiceArn: aws.String(svcARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe service %s: %w", svcARN, err)
+	}
+	if svc.Service.AutoScalingConfigurationSummary == nil {
+		return nil, fmt.Errorf("service %s has no auto scaling configuration", svcARN)
+	}
+	configARN := aws.StringValue(svc.Service.AutoScalingConfigurationSummary.AutoScalingConfigurationArn)
+	resp, err := a.client.DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(configARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe auto scaling configuration %s: %w", configARN, err)
+	}
+	return newAutoScalingConfiguration(resp.AutoScalingConfiguration), nil
+}
+
+// CreateAutoScalingConfiguration creates an auto scaling configuration, or a new revision of it if the name is already used.
+func (a *AppRunner) CreateAutoScalingConfiguration(in CreateAutoScalingConfigurationInput) (*AutoScalingConfiguration, error) {
+	resp, err := a.client.CreateAutoScalingConfiguration(&apprunner.CreateAutoScalingConfigurationInput{
+		AutoScalingConfigurationName: aws.String(in.Name),
+		MinSize:                      aws.Int64(in.MinSize),
+		MaxSize:                      aws.Int64(in.MaxSize),
+		MaxConcurrency:               aws.Int64(in.MaxConcurrency),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create auto scaling configuration %s: %w", in.Name, err)
+	}
+	return newAutoScalingConfiguration(resp.AutoScalingConfiguration), nil
+}
+
+// UpdateServiceAutoScalingConfiguration updates the auto scaling configuration that the App Runner service uses
+// and waits for the update to complete.
+func (a *AppRunner) UpdateServiceAutoScalingConfiguration(svcARN, configARN string) error {
+	resp, err := a.client.UpdateService(&apprunner.UpdateServiceInput{
+		ServiceArn:                  aws.String(svcARN),
+		AutoScalingConfigurationArn: aws.String(configARN),
+	})
+	if err != nil {
+		return fmt.Errorf("update auto scaling configuration of service %s: %w", svcARN, err)
+	}
+	return a.WaitForOperation(aws.StringValue(resp.OperationId), svcARN)
+}
+
 // PrivateURL returns the url associated with a VPC Ingress Connection.
 func (a *AppRunner) PrivateURL(vicARN string) (string, error) {
 	resp, err := a.client.DescribeVpcIngressConnection(&apprunner.DescribeVpcIngressConnectionInput{
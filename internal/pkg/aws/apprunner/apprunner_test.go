@@ -629,6 +629,221 @@ func TestAppRunner_ResumeService(t *testing.T) {
 	}
 }
 
+func TestAppRunner_ServiceAutoScalingConfiguration(t *testing.T) {
+	const (
+		mockSvcARN    = "mockSvcArn"
+		mockConfigARN = "arn:aws:apprunner:us-west-2:123456789012:autoscalingconfiguration/high-availability/3/abc"
+	)
+	testCases := map[string]struct {
+		mockAppRunnerClient func(m *mocks.Mockapi)
+
+		wantErr    error
+		wantConfig *AutoScalingConfiguration
+	}{
+		"return error if failed to describe the service": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{ServiceArn: aws.String(mockSvcARN)}).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe service mockSvcArn: some error"),
+		},
+		"return error if failed to describe the auto scaling configuration": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{ServiceArn: aws.String(mockSvcARN)}).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						AutoScalingConfigurationSummary: &apprunner.AutoScalingConfigurationSummary{
+							AutoScalingConfigurationArn: aws.String(mockConfigARN),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+					AutoScalingConfigurationArn: aws.String(mockConfigARN),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe auto scaling configuration %s: some error", mockConfigARN),
+		},
+		"success": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeService(&apprunner.DescribeServiceInput{ServiceArn: aws.String(mockSvcARN)}).Return(&apprunner.DescribeServiceOutput{
+					Service: &apprunner.Service{
+						AutoScalingConfigurationSummary: &apprunner.AutoScalingConfigurationSummary{
+							AutoScalingConfigurationArn: aws.String(mockConfigARN),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeAutoScalingConfiguration(&apprunner.DescribeAutoScalingConfigurationInput{
+					AutoScalingConfigurationArn: aws.String(mockConfigARN),
+				}).Return(&apprunner.DescribeAutoScalingConfigurationOutput{
+					AutoScalingConfiguration: &apprunner.AutoScalingConfiguration{
+						AutoScalingConfigurationArn:      aws.String(mockConfigARN),
+						AutoScalingConfigurationName:     aws.String("high-availability"),
+						AutoScalingConfigurationRevision: aws.Int64(3),
+						MinSize:                          aws.Int64(1),
+						MaxSize:                          aws.Int64(25),
+						MaxConcurrency:                   aws.Int64(100),
+					},
+				}, nil)
+			},
+			wantConfig: &AutoScalingConfiguration{
+				ARN:            mockConfigARN,
+				Name:           "high-availability",
+				Revision:       3,
+				MinSize:        1,
+				MaxSize:        25,
+				MaxConcurrency: 100,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppRunnerClient := mocks.NewMockapi(ctrl)
+			tc.mockAppRunnerClient(mockAppRunnerClient)
+			service := AppRunner{
+				client: mockAppRunnerClient,
+			}
+
+			got, err := service.ServiceAutoScalingConfiguration(mockSvcARN)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantConfig, got)
+		})
+	}
+}
+
+func TestAppRunner_CreateAutoScalingConfiguration(t *testing.T) {
+	mockIn := &apprunner.CreateAutoScalingConfigurationInput{
+		AutoScalingConfigurationName: aws.String("my-app-test-api"),
+		MinSize:                      aws.Int64(2),
+		MaxSize:                      aws.Int64(10),
+		MaxConcurrency:               aws.Int64(100),
+	}
+	testCases := map[string]struct {
+		mockAppRunnerClient func(m *mocks.Mockapi)
+
+		wantErr    error
+		wantConfig *AutoScalingConfiguration
+	}{
+		"return error if failed to create the configuration": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateAutoScalingConfiguration(mockIn).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("create auto scaling configuration my-app-test-api: some error"),
+		},
+		"success": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateAutoScalingConfiguration(mockIn).Return(&apprunner.CreateAutoScalingConfigurationOutput{
+					AutoScalingConfiguration: &apprunner.AutoScalingConfiguration{
+						AutoScalingConfigurationArn:      aws.String("mockConfigArn"),
+						AutoScalingConfigurationName:     aws.String("my-app-test-api"),
+						AutoScalingConfigurationRevision: aws.Int64(2),
+						MinSize:                          aws.Int64(2),
+						MaxSize:                          aws.Int64(10),
+						MaxConcurrency:                   aws.Int64(100),
+					},
+				}, nil)
+			},
+			wantConfig: &AutoScalingConfiguration{
+				ARN:            "mockConfigArn",
+				Name:           "my-app-test-api",
+				Revision:       2,
+				MinSize:        2,
+				MaxSize:        10,
+				MaxConcurrency: 100,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppRunnerClient := mocks.NewMockapi(ctrl)
+			tc.mockAppRunnerClient(mockAppRunnerClient)
+			service := AppRunner{
+				client: mockAppRunnerClient,
+			}
+
+			got, err := service.CreateAutoScalingConfiguration(CreateAutoScalingConfigurationInput{
+				Name:           "my-app-test-api",
+				MinSize:        2,
+				MaxSize:        10,
+				MaxConcurrency: 100,
+			})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantConfig, got)
+		})
+	}
+}
+
+func TestAppRunner_UpdateServiceAutoScalingConfiguration(t *testing.T) {
+	const (
+		mockOperationId = "mock-operation"
+		mockSvcARN      = "mockSvcArn"
+	)
+	mockIn := &apprunner.UpdateServiceInput{
+		ServiceArn:                  aws.String(mockSvcARN),
+		AutoScalingConfigurationArn: aws.String("mockConfigArn"),
+	}
+	testCases := map[string]struct {
+		mockAppRunnerClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"return error if failed to update the service": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(mockIn).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("update auto scaling configuration of service mockSvcArn: some error"),
+		},
+		"waits until operation succeeds": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().UpdateService(mockIn).Return(&apprunner.UpdateServiceOutput{
+					OperationId: aws.String(mockOperationId),
+				}, nil)
+				m.EXPECT().ListOperations(&apprunner.ListOperationsInput{ServiceArn: aws.String(mockSvcARN)}).Return(&apprunner.ListOperationsOutput{
+					OperationSummaryList: []*apprunner.OperationSummary{
+						{
+							Id:     aws.String(mockOperationId),
+							Status: aws.String("SUCCEEDED"),
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppRunnerClient := mocks.NewMockapi(ctrl)
+			tc.mockAppRunnerClient(mockAppRunnerClient)
+			service := AppRunner{
+				client: mockAppRunnerClient,
+			}
+
+			err := service.UpdateServiceAutoScalingConfiguration(mockSvcARN, "mockConfigArn")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestAppRunner_StartDeployment(t *testing.T) {
 	const (
 		mockOperationId = "mock-operation"
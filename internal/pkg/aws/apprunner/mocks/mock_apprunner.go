@@ -34,6 +34,36 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// CreateAutoScalingConfiguration mocks base method.
+func (m *Mockapi) CreateAutoScalingConfiguration(input *apprunner.CreateAutoScalingConfigurationInput) (*apprunner.CreateAutoScalingConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScalingConfiguration", input)
+	ret0, _ := ret[0].(*apprunner.CreateAutoScalingConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScalingConfiguration indicates an expected call of CreateAutoScalingConfiguration.
+func (mr *MockapiMockRecorder) CreateAutoScalingConfiguration(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalingConfiguration", reflect.TypeOf((*Mockapi)(nil).CreateAutoScalingConfiguration), input)
+}
+
+// DescribeAutoScalingConfiguration mocks base method.
+func (m *Mockapi) DescribeAutoScalingConfiguration(input *apprunner.DescribeAutoScalingConfigurationInput) (*apprunner.DescribeAutoScalingConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutoScalingConfiguration", input)
+	ret0, _ := ret[0].(*apprunner.DescribeAutoScalingConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutoScalingConfiguration indicates an expected call of DescribeAutoScalingConfiguration.
+func (mr *MockapiMockRecorder) DescribeAutoScalingConfiguration(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutoScalingConfiguration", reflect.TypeOf((*Mockapi)(nil).DescribeAutoScalingConfiguration), input)
+}
+
 // DescribeObservabilityConfiguration mocks base method.
 func (m *Mockapi) DescribeObservabilityConfiguration(input *apprunner.DescribeObservabilityConfigurationInput) (*apprunner.DescribeObservabilityConfigurationOutput, error) {
 	m.ctrl.T.Helper()
@@ -153,3 +183,18 @@ func (mr *MockapiMockRecorder) StartDeployment(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeployment", reflect.TypeOf((*Mockapi)(nil).StartDeployment), input)
 }
+
+// UpdateService mocks base method.
+func (m *Mockapi) UpdateService(input *apprunner.UpdateServiceInput) (*apprunner.UpdateServiceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", input)
+	ret0, _ := ret[0].(*apprunner.UpdateServiceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateService indicates an expected call of UpdateService.
+func (mr *MockapiMockRecorder) UpdateService(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*Mockapi)(nil).UpdateService), input)
+}
@@ -7,6 +7,7 @@ package apprunner
 import (
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 )
 
@@ -47,3 +48,32 @@ type ObservabilityConfiguration struct {
 
 // TraceConfiguration wraps AppRunner TraceConfiguration.
 type TraceConfiguration apprunner.TraceConfiguration
+
+// AutoScalingConfiguration contains the settings of an App Runner auto scaling configuration revision.
+type AutoScalingConfiguration struct {
+	ARN            string
+	Name           string
+	Revision       int64
+	MinSize        int64
+	MaxSize        int64
+	MaxConcurrency int64
+}
+
+// CreateAutoScalingConfigurationInput holds the fields needed to create an auto scaling configuration.
+type CreateAutoScalingConfigurationInput struct {
+	Name           string
+	MinSize        int64
+	MaxSize        int64
+	MaxConcurrency int64
+}
+
+func newAutoScalingConfiguration(in *apprunner.AutoScalingConfiguration) *AutoScalingConfiguration {
+	return &AutoScalingConfiguration{
+		ARN:            aws.StringValue(in.AutoScalingConfigurationArn),
+		Name:           aws.StringValue(in.AutoScalingConfigurationName),
+		Revision:       aws.Int64Value(in.AutoScalingConfigurationRevision),
+		MinSize:        aws.Int64Value(in.MinSize),
+		MaxSize:        aws.Int64Value(in.MaxSize),
+		MaxConcurrency: aws.Int64Value(in.MaxConcurrency),
+	}
+}
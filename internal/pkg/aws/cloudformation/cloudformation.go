@@ -119,6 +119,36 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 	return c.update(stack)
 }
 
+// PreviewChangeSet creates a change set to update an existing stack with the new configuration without executing it.
+// The change set is deleted after its changes are described.
+// If the stack does not exist, returns ErrStackNotFound. If there are no changes for the stack, returns ErrChangeSetEmpty.
+func (c *CloudFormation) PreviewChangeSet(stack *Stack) (changes *ChangeSetDescription, err error) {
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	status := StackStatus(aws.StringValue(descr.StackStatus))
+	if status.InProgress() {
+		return nil, &ErrStackUpdateInProgress{
+			Name: stack.Name,
+		}
+	}
+	cs, err := newUpdateChangeSet(c.client, stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.createOrDeleteIfEmpty(stack.stackConfig); err != nil {
+		return nil, err
+	}
+	// Always clean up the change set, even if we fail to describe it, so that it does not count towards the stack's limit.
+	defer func() {
+		if deleteErr := cs.delete(); deleteErr != nil && err == nil {
+			changes, err = nil, deleteErr
+		}
+	}()
+	return cs.describe()
+}
+
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if _, err := c.Update(stack); err != nil {
@@ -294,6 +324,67 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
+// DetectStackDrift starts a drift detection operation on a stack and returns the ID of the operation.
+func (c *CloudFormation) DetectStackDrift(stackName string) (string, error) {
+	out, err := c.client.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	return aws.StringValue(out.StackDriftDetectionId), nil
+}
+
+// DriftDetectionStatus returns the status of a stack drift detection operation.
+func (c *CloudFormation) DriftDetectionStatus(detectionID string) (*DriftDetection, error) {
+	out, err := c.client.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(detectionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe stack drift detection status %s: %w", detectionID, err)
+	}
+	return &DriftDetection{
+		ID:                   aws.StringValue(out.StackDriftDetectionId),
+		StackID:              aws.StringValue(out.StackId),
+		Status:               aws.StringValue(out.DetectionStatus),
+		StatusReason:         aws.StringValue(out.DetectionStatusReason),
+		StackDriftStatus:     aws.StringValue(out.StackDriftStatus),
+		DriftedResourceCount: aws.Int64Value(out.DriftedStackResourceCount),
+	}, nil
+}
+
+// StackResourceDrifts returns the resources of a stack that were modified or deleted outside of CloudFormation
+// according to the latest drift detection operation.
+func (c *CloudFormation) StackResourceDrifts(stackName string) ([]*StackResourceDrift, error) {
+	var drifts []*StackResourceDrift
+	var nextToken *string
+	for {
+		out, err := c.client.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			StackName: aws.String(stackName),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			if drift == nil {
+				continue
+			}
+			d := StackResourceDrift(*drift)
+			drifts = append(drifts, &d)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
+
 func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
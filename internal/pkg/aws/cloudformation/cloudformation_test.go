@@ -613,6 +613,141 @@ func TestCloudFormation_Update(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_PreviewChangeSet(t *testing.T) {
+	const (
+		mockStackName     = "id"
+		mockChangeSetName = "copilot-31323334-3536-4738-b930-313233333435"
+	)
+	mockChanges := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("Service"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		createMock  func(ctrl *gomock.Controller) client
+		wantedDescr *ChangeSetDescription
+		wantedErr   error
+	}{
+		"fail if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: mockStackName},
+		},
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStackName,
+			},
+		},
+		"delete change set and throw ErrChangeSetEmpty if the change set is empty": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).
+					Return(&cloudformation.DescribeChangeSetOutput{
+						Changes:      []*cloudformation.Change{},
+						StatusReason: aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+					}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("change set with name copilot-31323334-3536-4738-b930-313233333435 for stack id has no changes"),
+		},
+		"delete the change set even if it fails to be described": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("describe change set copilot-31323334-3536-4738-b930-313233333435 for stack id: some error"),
+		},
+		"describe and delete the change set without executing it": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName)}).
+					Return(&cloudformation.DescribeChangeSetOutput{
+						ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+						Changes:         mockChanges,
+					}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedDescr: &ChangeSetDescription{
+				ExecutionStatus: cloudformation.ExecutionStatusAvailable,
+				Changes:         mockChanges,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			descr, err := c.PreviewChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDescr, descr)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -1299,6 +1434,204 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DetectStackDrift(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedID    string
+		wantedError error
+	}{
+		"return a wrapped error if fail to start drift detection": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("detect drift of stack id: some error"),
+		},
+		"return the ID of the drift detection operation": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("mock-detection-id"),
+				}, nil)
+				return m
+			},
+			wantedID: "mock-detection-id",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.DetectStackDrift(mockStack.Name)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCloudFormation_DriftDetectionStatus(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedDetection *DriftDetection
+		wantedError     error
+	}{
+		"return a wrapped error if fail to describe the drift detection status": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack drift detection status mock-detection-id: some error"),
+		},
+		"return the status of the drift detection operation": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+					StackDriftDetectionId: aws.String("mock-detection-id"),
+				}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					StackDriftDetectionId:     aws.String("mock-detection-id"),
+					StackId:                   aws.String("mock-stack-id"),
+					DetectionStatus:           aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					StackDriftStatus:          aws.String(cloudformation.StackDriftStatusDrifted),
+					DriftedStackResourceCount: aws.Int64(2),
+				}, nil)
+				return m
+			},
+			wantedDetection: &DriftDetection{
+				ID:                   "mock-detection-id",
+				StackID:              "mock-stack-id",
+				Status:               "DETECTION_COMPLETE",
+				StackDriftStatus:     "DRIFTED",
+				DriftedResourceCount: 2,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			detection, err := c.DriftDetectionStatus("mock-detection-id")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDetection, detection)
+			require.True(t, detection.IsDone())
+		})
+	}
+}
+
+func TestCloudFormation_StackResourceDrifts(t *testing.T) {
+	filters := aws.StringSlice([]string{"MODIFIED", "DELETED"})
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedDrifts []*StackResourceDrift
+		wantedError  error
+	}{
+		"return a wrapped error if fail to describe resource drifts": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe resource drifts of stack id: some error"),
+		},
+		"return drifted resources across pages": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStack.Name),
+						StackResourceDriftStatusFilters: filters,
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{
+								LogicalResourceId:        aws.String("SecurityGroup"),
+								StackResourceDriftStatus: aws.String("MODIFIED"),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStack.Name),
+						StackResourceDriftStatusFilters: filters,
+						NextToken:                       aws.String("token"),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{
+								LogicalResourceId:        aws.String("Queue"),
+								StackResourceDriftStatus: aws.String("DELETED"),
+							},
+						},
+					}, nil),
+				)
+				return m
+			},
+			wantedDrifts: []*StackResourceDrift{
+				{
+					LogicalResourceId:        aws.String("SecurityGroup"),
+					StackResourceDriftStatus: aws.String("MODIFIED"),
+				},
+				{
+					LogicalResourceId:        aws.String("Queue"),
+					StackResourceDriftStatus: aws.String("DELETED"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			drifts, err := c.StackResourceDrifts(mockStack.Name)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDrifts, drifts)
+		})
+	}
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
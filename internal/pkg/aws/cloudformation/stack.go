@@ -105,6 +105,24 @@ type StackDescription cloudformation.Stack
 // StackResource is an alias the SDK's StackResource type.
 type StackResource cloudformation.StackResource
 
+// StackResourceDrift is an alias the SDK's StackResourceDrift type.
+type StackResourceDrift cloudformation.StackResourceDrift
+
+// DriftDetection is the status of a stack drift detection operation.
+type DriftDetection struct {
+	ID                   string
+	StackID              string
+	Status               string // One of DETECTION_IN_PROGRESS, DETECTION_COMPLETE or DETECTION_FAILED.
+	StatusReason         string
+	StackDriftStatus     string // One of DRIFTED, IN_SYNC, UNKNOWN or NOT_CHECKED.
+	DriftedResourceCount int64
+}
+
+// IsDone returns true if the drift detection operation is no longer in progress.
+func (d *DriftDetection) IsDone() bool {
+	return d.Status != cloudformation.StackDriftDetectionStatusDetectionInProgress
+}
+
 // SDK returns the underlying struct from the AWS SDK.
 func (d *StackDescription) SDK() *cloudformation.Stack {
 	raw := cloudformation.Stack(*d)
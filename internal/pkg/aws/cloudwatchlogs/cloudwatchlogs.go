@@ -27,6 +27,9 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
@@ -50,6 +53,7 @@ type LogEventsOpts struct {
 	StartTime              *int64
 	EndTime                *int64
 	StreamLastEventTime    map[string]int64
+	FilterPattern          string // If set, only retrieve the events matching the CloudWatch Logs filter pattern.
 
 	LogStreamLimit int
 }
@@ -125,23 +129,18 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 			// by one to get logs after the last event.
 			in.SetStartTime(streamLastEventTime[logStream] + 1)
 		}
-		// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
-		resp, err := c.client.GetLogEvents(in)
-		if err != nil {
-			return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
+		var streamEvents []*Event
+		if opts.FilterPattern != "" {
+			streamEvents, err = c.filteredStreamEvents(in, opts.FilterPattern)
+		} else {
+			streamEvents, err = c.streamEvents(in)
 		}
-
-		for _, event := range resp.Events {
-			log := &Event{
-				LogStreamName: logStream,
-				IngestionTime: aws.Int64Value(event.IngestionTime),
-				Message:       aws.StringValue(event.Message),
-				Timestamp:     aws.Int64Value(event.Timestamp),
-			}
-			events = append(events, log)
+		if err != nil {
+			return nil, err
 		}
-		if len(resp.Events) != 0 {
-			streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
+		events = append(events, streamEvents...)
+		if len(streamEvents) != 0 {
+			streamLastEventTime[logStream] = streamEvents[len(streamEvents)-1].Timestamp
 		}
 	}
 	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
@@ -158,6 +157,64 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// streamEvents returns the most recent events of the log stream in the input.
+func (c *CloudWatchLogs) streamEvents(in *cloudwatchlogs.GetLogEventsInput) ([]*Event, error) {
+	// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
+	resp, err := c.client.GetLogEvents(in)
+	if err != nil {
+		return nil, fmt.Errorf("get log events of %s/%s: %w", aws.StringValue(in.LogGroupName), aws.StringValue(in.LogStreamName), err)
+	}
+	events := make([]*Event, len(resp.Events))
+	for i, event := range resp.Events {
+		events[i] = &Event{
+			LogStreamName: aws.StringValue(in.LogStreamName),
+			IngestionTime: aws.Int64Value(event.IngestionTime),
+			Message:       aws.StringValue(event.Message),
+			Timestamp:     aws.Int64Value(event.Timestamp),
+		}
+	}
+	return events, nil
+}
+
+// filteredStreamEvents returns the events of the log stream in the input that match the filter pattern.
+// The events are filtered by CloudWatch Logs, so only the matching events are transferred.
+// FilterLogEvents returns the earliest events first, so if the input has a limit, the earliest matching events
+// after the start time are returned and no more pages are requested once the limit is reached.
+func (c *CloudWatchLogs) filteredStreamEvents(in *cloudwatchlogs.GetLogEventsInput, pattern string) ([]*Event, error) {
+	limit := int(aws.Int64Value(in.Limit))
+	var events []*Event
+	var nextToken *string
+	for {
+		resp, err := c.client.FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName:   in.LogGroupName,
+			LogStreamNames: aws.StringSlice([]string{aws.StringValue(in.LogStreamName)}),
+			FilterPattern:  aws.String(pattern),
+			StartTime:      in.StartTime,
+			EndTime:        in.EndTime,
+			Limit:          in.Limit,
+			NextToken:      nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filter log events of %s/%s: %w", aws.StringValue(in.LogGroupName), aws.StringValue(in.LogStreamName), err)
+		}
+		for _, event := range resp.Events {
+			events = append(events, &Event{
+				LogStreamName: aws.StringValue(event.LogStreamName),
+				IngestionTime: aws.Int64Value(event.IngestionTime),
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     aws.Int64Value(event.Timestamp),
+			})
+			if limit != 0 && len(events) == limit {
+				return events, nil
+			}
+		}
+		nextToken = resp.NextToken
+		if aws.StringValue(nextToken) == "" {
+			return events, nil
+		}
+	}
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
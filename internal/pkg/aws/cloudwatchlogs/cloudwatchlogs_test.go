@@ -25,6 +25,7 @@ func TestLogEvents(t *testing.T) {
 		limit                    *int64
 		logStreamLimit           int
 		lastEventTime            map[string]int64
+		filterPattern            string
 		mockcloudwatchlogsClient func(m *mocks.Mockapi)
 
 		wantLogEvents     []*Event
@@ -357,6 +358,98 @@ func TestLogEvents(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"returns error if fail to filter log events": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("filter log events of %s/%s: %w", "mockLogGroup", "copilot/mockLogGroup/mockLogStream", mockError),
+		},
+		"should filter log events across pages until the limit is reached": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			limit:         aws.Int64(3),
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+						},
+					},
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName:   aws.String("mockLogGroup"),
+						LogStreamNames: aws.StringSlice([]string{"copilot/mockLogGroup/mockLogStream"}),
+						FilterPattern:  aws.String("ERROR"),
+						Limit:          aws.Int64(3),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+								Message:       aws.String("ERROR 1"),
+								Timestamp:     aws.Int64(1),
+							},
+							{
+								LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+								Message:       aws.String("ERROR 2"),
+								Timestamp:     aws.Int64(2),
+							},
+						},
+						NextToken: aws.String("mockToken"),
+					}, nil),
+					m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+						LogGroupName:   aws.String("mockLogGroup"),
+						LogStreamNames: aws.StringSlice([]string{"copilot/mockLogGroup/mockLogStream"}),
+						FilterPattern:  aws.String("ERROR"),
+						Limit:          aws.Int64(3),
+						NextToken:      aws.String("mockToken"),
+					}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+						Events: []*cloudwatchlogs.FilteredLogEvent{
+							{
+								LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+								Message:       aws.String("ERROR 3"),
+								Timestamp:     aws.Int64(3),
+							},
+							{
+								LogStreamName: aws.String("copilot/mockLogGroup/mockLogStream"),
+								Message:       aws.String("ERROR 4"),
+								Timestamp:     aws.Int64(4),
+							},
+						},
+						NextToken: aws.String("mockToken2"),
+					}, nil),
+				)
+			},
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/mockLogGroup/mockLogStream",
+					Message:       "ERROR 1",
+					Timestamp:     1,
+				},
+				{
+					LogStreamName: "copilot/mockLogGroup/mockLogStream",
+					Message:       "ERROR 2",
+					Timestamp:     2,
+				},
+				{
+					LogStreamName: "copilot/mockLogGroup/mockLogStream",
+					Message:       "ERROR 3",
+					Timestamp:     3,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/mockLogGroup/mockLogStream": 3,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -379,6 +472,7 @@ func TestLogEvents(t *testing.T) {
 				StartTime:              tc.startTime,
 				StreamLastEventTime:    tc.lastEventTime,
 				LogStreamLimit:         tc.logStreamLimit,
+				FilterPattern:          tc.filterPattern,
 			})
 
 			if gotErr != nil {
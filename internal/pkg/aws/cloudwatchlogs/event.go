@@ -36,12 +36,7 @@ func (l *Event) JSONString() (string, error) {
 
 // HumanString returns the stringified LogEvent struct with human readable format.
 func (l *Event) HumanString() string {
-	for _, code := range fatalCodes {
-		l.Message = colorCodeMessage(l.Message, code, color.Red)
-	}
-	for _, code := range warningCodes {
-		l.Message = colorCodeMessage(l.Message, code, color.Yellow)
-	}
+	l.Message = colorCodeLevels(l.Message)
 	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
 }
 
@@ -52,6 +47,17 @@ func (l *Event) shortLogStreamName() string {
 	return l.LogStreamName[0:shortLogStreamNameLength]
 }
 
+// colorCodeLevels returns the given message with the fatal and warning codes colored.
+func colorCodeLevels(message string) string {
+	for _, code := range fatalCodes {
+		message = colorCodeMessage(message, code, color.Red)
+	}
+	for _, code := range warningCodes {
+		message = colorCodeMessage(message, code, color.Yellow)
+	}
+	return message
+}
+
 // colorCodeMessage returns the given message with color applied to every occurence of code
 func colorCodeMessage(message string, code string, colorToApply *c.Color) string {
 	if c.NoColor {
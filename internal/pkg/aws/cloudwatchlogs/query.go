@@ -0,0 +1,121 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// ptrField is the field that Logs Insights adds to every result to fetch the complete log record.
+	ptrField     = "@ptr"
+	messageField = "@message"
+)
+
+// queryPollInterval is the time to wait before polling again for the results of a running query.
+var queryPollInterval = SleepDuration
+
+// QueryOpts wraps the parameters to call Query.
+type QueryOpts struct {
+	LogGroup    string
+	QueryString string
+	StartTime   int64 // Unix timestamp in milliseconds.
+	EndTime     int64 // Unix timestamp in milliseconds.
+	Limit       *int64
+}
+
+// QueryResultField is a field of a Logs Insights query result.
+type QueryResultField struct {
+	Name  string
+	Value string
+}
+
+// QueryResult represents a row of the results of a Logs Insights query.
+type QueryResult struct {
+	Fields []QueryResultField
+}
+
+// Query runs the Logs Insights query against the log group and waits for its results.
+func (c *CloudWatchLogs) Query(opts QueryOpts) ([]*QueryResult, error) {
+	start, err := c.client.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(opts.LogGroup),
+		QueryString:  aws.String(opts.QueryString),
+		StartTime:    aws.Int64(opts.StartTime / int64(time.Second/time.Millisecond)),
+		EndTime:      aws.Int64(opts.EndTime / int64(time.Second/time.Millisecond)),
+		Limit:        opts.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on log group %s: %w", opts.LogGroup, err)
+	}
+	queryID := aws.StringValue(start.QueryId)
+	for {
+		resp, err := c.client.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: start.QueryId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		switch status := aws.StringValue(resp.Status); status {
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			time.Sleep(queryPollInterval)
+		case cloudwatchlogs.QueryStatusComplete:
+			return queryResults(resp.Results), nil
+		default:
+			return nil, fmt.Errorf("query %s ended with status %s", queryID, status)
+		}
+	}
+}
+
+func queryResults(rows [][]*cloudwatchlogs.ResultField) []*QueryResult {
+	results := make([]*QueryResult, len(rows))
+	for i, row := range rows {
+		result := &QueryResult{}
+		for _, field := range row {
+			name := aws.StringValue(field.Field)
+			if name == ptrField {
+				continue
+			}
+			result.Fields = append(result.Fields, QueryResultField{
+				Name:  name,
+				Value: aws.StringValue(field.Value),
+			})
+		}
+		results[i] = result
+	}
+	return results
+}
+
+// JSONString returns the stringified query result as a JSON object keyed by field name.
+func (r *QueryResult) JSONString() (string, error) {
+	fields := make(map[string]string, len(r.Fields))
+	for _, field := range r.Fields {
+		fields[field.Name] = field.Value
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("marshal a query result: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified query result with human readable format.
+// The log message is printed as is, and every other field is printed as name=value.
+func (r *QueryResult) HumanString() string {
+	parts := make([]string, len(r.Fields))
+	for i, field := range r.Fields {
+		if field.Name == messageField {
+			parts[i] = colorCodeLevels(field.Value)
+			continue
+		}
+		parts[i] = fmt.Sprintf("%s%s", color.Grey.Sprintf("%s=", field.Name), field.Value)
+	}
+	return fmt.Sprintf("%s\n", strings.Join(parts, " "))
+}
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
+	c "github.com/fatih/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudWatchLogs_Query(t *testing.T) {
+	queryPollInterval = 0
+	mockOpts := QueryOpts{
+		LogGroup:    "mockLogGroup",
+		QueryString: "fields @timestamp, @message | filter @message like /ERROR/",
+		StartTime:   1000,
+		EndTime:     4000,
+		Limit:       aws.Int64(10),
+	}
+	mockStartQuery := func(m *mocks.Mockapi) *gomock.Call {
+		return m.EXPECT().StartQuery(&cloudwatchlogs.StartQueryInput{
+			LogGroupName: aws.String("mockLogGroup"),
+			QueryString:  aws.String("fields @timestamp, @message | filter @message like /ERROR/"),
+			StartTime:    aws.Int64(1),
+			EndTime:      aws.Int64(4),
+			Limit:        aws.Int64(10),
+		})
+	}
+	testCases := map[string]struct {
+		setUpMocks func(m *mocks.Mockapi)
+
+		wanted      []*QueryResult
+		wantedError error
+	}{
+		"error if fail to start the query": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				mockStartQuery(m).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start query on log group mockLogGroup: some error"),
+		},
+		"error if fail to get the query results": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				mockStartQuery(m).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("mockID")}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get results of query mockID: some error"),
+		},
+		"error if the query does not complete": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				mockStartQuery(m).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("mockID")}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusTimeout),
+				}, nil)
+			},
+			wantedError: errors.New("query mockID ended with status Timeout"),
+		},
+		"waits for the query to complete and returns the results": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				mockStartQuery(m).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("mockID")}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{QueryId: aws.String("mockID")}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusRunning),
+					}, nil),
+					m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{QueryId: aws.String("mockID")}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusComplete),
+						Results: [][]*cloudwatchlogs.ResultField{
+							{
+								{Field: aws.String("@timestamp"), Value: aws.String("2022-01-01 00:00:01.000")},
+								{Field: aws.String("@message"), Value: aws.String("ERROR boom")},
+								{Field: aws.String("@ptr"), Value: aws.String("mockPtr")},
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []*QueryResult{
+				{
+					Fields: []QueryResultField{
+						{Name: "@timestamp", Value: "2022-01-01 00:00:01.000"},
+						{Name: "@message", Value: "ERROR boom"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setUpMocks(m)
+			client := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.Query(mockOpts)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestQueryResult_String(t *testing.T) {
+	c.NoColor = true
+	result := &QueryResult{
+		Fields: []QueryResultField{
+			{Name: "@timestamp", Value: "2022-01-01 00:00:01.000"},
+			{Name: "@message", Value: "ERROR boom"},
+			{Name: "count", Value: "3"},
+		},
+	}
+
+	human := result.HumanString()
+	json, err := result.JSONString()
+
+	require.Equal(t, "@timestamp=2022-01-01 00:00:01.000 ERROR boom count=3\n", human)
+	require.NoError(t, err)
+	require.Equal(t, `{"@message":"ERROR boom","@timestamp":"2022-01-01 00:00:01.000","count":"3"}`+"\n", json)
+}
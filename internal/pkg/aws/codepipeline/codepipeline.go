@@ -24,6 +24,9 @@ type api interface {
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
 	ListPipelineExecutions(input *cp.ListPipelineExecutionsInput) (*cp.ListPipelineExecutionsOutput, error)
 	RetryStageExecution(input *cp.RetryStageExecutionInput) (*cp.RetryStageExecutionOutput, error)
+	StartPipelineExecution(input *cp.StartPipelineExecutionInput) (*cp.StartPipelineExecutionOutput, error)
+	GetPipelineExecution(input *cp.GetPipelineExecutionInput) (*cp.GetPipelineExecutionOutput, error)
+	PutApprovalResult(input *cp.PutApprovalResultInput) (*cp.PutApprovalResultOutput, error)
 }
 
 type resourceGetter interface {
@@ -64,15 +67,34 @@ type PipelineState struct {
 
 // StageState wraps a CodePipeline stage state.
 type StageState struct {
-	StageName  string        `json:"stageName"`
-	Actions    []StageAction `json:"actions,omitempty"`
-	Transition string        `json:"transition"`
+	StageName   string        `json:"stageName"`
+	Actions     []StageAction `json:"actions,omitempty"`
+	Transition  string        `json:"transition"`
+	ExecutionID string        `json:"-"` // ID of the latest pipeline execution that ran the stage.
 }
 
 // StageAction wraps a CodePipeline stage action.
 type StageAction struct {
-	Name   string `json:"name"`
-	Status string `json:"status"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	ApprovalToken string `json:"-"` // Token of a manual approval action waiting for a response.
+}
+
+// PipelineExecution wraps a CodePipeline pipeline execution.
+type PipelineExecution struct {
+	ID            string
+	Status        string
+	StatusSummary string
+}
+
+// PendingApproval returns the manual approval action of the stage that's waiting for a response if any.
+func (ss StageState) PendingApproval() (StageAction, bool) {
+	for _, action := range ss.Actions {
+		if action.Status == cp.ActionExecutionStatusInProgress && action.ApprovalToken != "" {
+			return action, true
+		}
+	}
+	return StageAction{}, false
 }
 
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
@@ -176,6 +198,70 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	return nil
 }
 
+// RetryFailedActions re-initiates the failed actions of a stage in the given pipeline execution.
+// It returns the ID of the retried pipeline execution.
+func (c *CodePipeline) RetryFailedActions(pipelineName, stageName, executionID string) (string, error) {
+	out, err := c.client.RetryStageExecution(&cp.RetryStageExecutionInput{
+		PipelineExecutionId: aws.String(executionID),
+		PipelineName:        aws.String(pipelineName),
+		RetryMode:           aws.String(cp.StageRetryModeFailedActions),
+		StageName:           aws.String(stageName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("retry failed actions of stage %s in pipeline %s: %w", stageName, pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
+// StartPipelineExecution starts a new execution of the given pipeline with the latest revision of its source.
+// It returns the ID of the new pipeline execution.
+func (c *CodePipeline) StartPipelineExecution(pipelineName string) (string, error) {
+	out, err := c.client.StartPipelineExecution(&cp.StartPipelineExecutionInput{
+		Name: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of pipeline %s: %w", pipelineName, err)
+	}
+	return aws.StringValue(out.PipelineExecutionId), nil
+}
+
+// GetPipelineExecution retrieves the status of an execution of the given pipeline.
+func (c *CodePipeline) GetPipelineExecution(pipelineName, executionID string) (*PipelineExecution, error) {
+	out, err := c.client.GetPipelineExecution(&cp.GetPipelineExecutionInput{
+		PipelineName:        aws.String(pipelineName),
+		PipelineExecutionId: aws.String(executionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s of pipeline %s: %w", executionID, pipelineName, err)
+	}
+	return &PipelineExecution{
+		ID:            aws.StringValue(out.PipelineExecution.PipelineExecutionId),
+		Status:        aws.StringValue(out.PipelineExecution.Status),
+		StatusSummary: aws.StringValue(out.PipelineExecution.StatusSummary),
+	}, nil
+}
+
+// PutApprovalResult approves or rejects the manual approval action of a stage with a summary of the decision.
+func (c *CodePipeline) PutApprovalResult(pipelineName, stageName string, action StageAction, approved bool, summary string) error {
+	status := cp.ApprovalStatusRejected
+	if approved {
+		status = cp.ApprovalStatusApproved
+	}
+	if _, err := c.client.PutApprovalResult(&cp.PutApprovalResultInput{
+		PipelineName: aws.String(pipelineName),
+		StageName:    aws.String(stageName),
+		ActionName:   aws.String(action.Name),
+		Token:        aws.String(action.ApprovalToken),
+		Result: &cp.ApprovalResult{
+			Status:  aws.String(status),
+			Summary: aws.String(summary),
+		},
+	}); err != nil {
+		return fmt.Errorf("put approval result for action %s of stage %s in pipeline %s: %w", action.Name, stageName, pipelineName, err)
+	}
+	return nil
+}
+
 // GetPipelineState retrieves status information from a given pipeline.
 func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 	input := &cp.GetPipelineStateInput{
@@ -198,19 +284,25 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 				transition = "ENABLED"
 			}
 		}
+		var executionID string
+		if stage.LatestExecution != nil {
+			executionID = aws.StringValue(stage.LatestExecution.PipelineExecutionId)
+		}
 		var actions []StageAction
 		for _, actionState := range stage.ActionStates {
 			if actionState.LatestExecution != nil {
 				actions = append(actions, StageAction{
-					Name:   aws.StringValue(actionState.ActionName),
-					Status: aws.StringValue(actionState.LatestExecution.Status),
+					Name:          aws.StringValue(actionState.ActionName),
+					Status:        aws.StringValue(actionState.LatestExecution.Status),
+					ApprovalToken: aws.StringValue(actionState.LatestExecution.Token),
 				})
 			}
 		}
 		stageStates = append(stageStates, &StageState{
-			StageName:  stageName,
-			Actions:    actions,
-			Transition: transition,
+			StageName:   stageName,
+			Actions:     actions,
+			Transition:  transition,
+			ExecutionID: executionID,
 		})
 	}
 	return &PipelineState{
@@ -257,6 +349,10 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["RepositoryName"]))
 			case "CodeStarSourceConnection":
 				details = fmt.Sprintf("Repository: %s", aws.StringValue(config["FullRepositoryId"]))
+			case "S3":
+				details = fmt.Sprintf("Object: s3://%s/%s", aws.StringValue(config["S3Bucket"]), aws.StringValue(config["S3ObjectKey"]))
+			case "ECR":
+				details = fmt.Sprintf("Image: %s:%s", aws.StringValue(config["RepositoryName"]), aws.StringValue(config["ImageTag"]))
 			}
 		case "Build":
 			// Currently, we use CodeBuild only for the build stage: https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference-CodeBuild.html#action-reference-CodeBuild-config
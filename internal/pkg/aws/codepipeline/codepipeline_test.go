@@ -304,6 +304,17 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						ActionName:      aws.String("TestCommands"),
 						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusFailed)},
 					},
+					{
+						ActionName: aws.String("ApprovePromotionTo-prod"),
+						LatestExecution: &codepipeline.ActionExecution{
+							Status: aws.String(codepipeline.ActionExecutionStatusInProgress),
+							Token:  aws.String("approval-token"),
+						},
+					},
+				},
+				LatestExecution: &codepipeline.StageExecution{
+					PipelineExecutionId: aws.String("execution-id"),
+					Status:              aws.String(codepipeline.StageExecutionStatusFailed),
 				},
 				StageName: aws.String("DeployTo-test"),
 			},
@@ -377,8 +388,14 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 								Name:   "TestCommands",
 								Status: "Failed",
 							},
+							{
+								Name:          "ApprovePromotionTo-prod",
+								Status:        "InProgress",
+								ApprovalToken: "approval-token",
+							},
 						},
-						Transition: "ENABLED",
+						Transition:  "ENABLED",
+						ExecutionID: "execution-id",
 					},
 					{
 						StageName:  "DeployTo-prod",
@@ -579,3 +596,318 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_RetryFailedActions(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockStageName    = "DeployTo-test"
+		mockExecutionID  = "12345678-fake-exec-utio-nid987654321"
+	)
+	mockInput := &codepipeline.RetryStageExecutionInput{
+		PipelineExecutionId: aws.String(mockExecutionID),
+		PipelineName:        aws.String(mockPipelineName),
+		RetryMode:           aws.String(codepipeline.StageRetryModeFailedActions),
+		StageName:           aws.String(mockStageName),
+	}
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"returns the ID of the retried execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().RetryStageExecution(mockInput).Return(&codepipeline.RetryStageExecutionOutput{
+					PipelineExecutionId: aws.String(mockExecutionID),
+				}, nil)
+			},
+			wantedID: mockExecutionID,
+		},
+		"wraps error if the stage is not retryable": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().RetryStageExecution(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retry failed actions of stage DeployTo-test in pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			id, err := cp.RetryFailedActions(mockPipelineName, mockStageName, mockExecutionID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodePipeline_StartPipelineExecution(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"returns the ID of the new execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(&codepipeline.StartPipelineExecutionInput{
+					Name: aws.String(mockPipelineName),
+				}).Return(&codepipeline.StartPipelineExecutionOutput{
+					PipelineExecutionId: aws.String("execution-id"),
+				}, nil)
+			},
+			wantedID: "execution-id",
+		},
+		"wraps error from the CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().StartPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("start execution of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			id, err := cp.StartPipelineExecution(mockPipelineName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodePipeline_GetPipelineExecution(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockExecutionID  = "execution-id"
+	)
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wanted    *PipelineExecution
+		wantedErr error
+	}{
+		"returns the status of the execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(&codepipeline.GetPipelineExecutionInput{
+					PipelineName:        aws.String(mockPipelineName),
+					PipelineExecutionId: aws.String(mockExecutionID),
+				}).Return(&codepipeline.GetPipelineExecutionOutput{
+					PipelineExecution: &codepipeline.PipelineExecution{
+						PipelineExecutionId: aws.String(mockExecutionID),
+						Status:              aws.String(codepipeline.PipelineExecutionStatusFailed),
+						StatusSummary:       aws.String("Action TestCommands failed"),
+					},
+				}, nil)
+			},
+			wanted: &PipelineExecution{
+				ID:            mockExecutionID,
+				Status:        "Failed",
+				StatusSummary: "Action TestCommands failed",
+			},
+		},
+		"wraps error from the CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().GetPipelineExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get execution execution-id of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			execution, err := cp.GetPipelineExecution(mockPipelineName, mockExecutionID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, execution)
+		})
+	}
+}
+
+func TestCodePipeline_PutApprovalResult(t *testing.T) {
+	const (
+		mockPipelineName = "pipeline-dinder-badgoose-repo"
+		mockStageName    = "DeployTo-prod"
+	)
+	mockAction := StageAction{
+		Name:          "ApprovePromotionTo-prod",
+		Status:        "InProgress",
+		ApprovalToken: "approval-token",
+	}
+
+	tests := map[string]struct {
+		inApproved bool
+		callMocks  func(m codepipelineMocks)
+
+		wantedErr error
+	}{
+		"approves the action with the summary": {
+			inApproved: true,
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String(mockPipelineName),
+					StageName:    aws.String(mockStageName),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("approval-token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusApproved),
+						Summary: aws.String("lgtm"),
+					},
+				}).Return(&codepipeline.PutApprovalResultOutput{}, nil)
+			},
+		},
+		"rejects the action with the summary": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(&codepipeline.PutApprovalResultInput{
+					PipelineName: aws.String(mockPipelineName),
+					StageName:    aws.String(mockStageName),
+					ActionName:   aws.String("ApprovePromotionTo-prod"),
+					Token:        aws.String("approval-token"),
+					Result: &codepipeline.ApprovalResult{
+						Status:  aws.String(codepipeline.ApprovalStatusRejected),
+						Summary: aws.String("lgtm"),
+					},
+				}).Return(&codepipeline.PutApprovalResultOutput{}, nil)
+			},
+		},
+		"wraps error from the CodePipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().PutApprovalResult(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put approval result for action ApprovePromotionTo-prod of stage DeployTo-prod in pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := cp.PutApprovalResult(mockPipelineName, mockStageName, mockAction, tc.inApproved, "lgtm")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStageState_PendingApproval(t *testing.T) {
+	tests := map[string]struct {
+		in StageState
+
+		wantedAction StageAction
+		wantedOK     bool
+	}{
+		"returns false if there are no actions": {
+			in: StageState{StageName: "DeployTo-prod"},
+		},
+		"returns false if the approval was already given": {
+			in: StageState{
+				StageName: "DeployTo-prod",
+				Actions: []StageAction{
+					{Name: "ApprovePromotionTo-prod", Status: "Succeeded", ApprovalToken: "approval-token"},
+				},
+			},
+		},
+		"returns false if the in progress actions are not approvals": {
+			in: StageState{
+				StageName: "DeployTo-prod",
+				Actions: []StageAction{
+					{Name: "CreateOrUpdate-api-prod", Status: "InProgress"},
+				},
+			},
+		},
+		"returns the action waiting for approval": {
+			in: StageState{
+				StageName: "DeployTo-prod",
+				Actions: []StageAction{
+					{Name: "ApprovePromotionTo-prod", Status: "InProgress", ApprovalToken: "approval-token"},
+					{Name: "CreateOrUpdate-api-prod"},
+				},
+			},
+			wantedAction: StageAction{Name: "ApprovePromotionTo-prod", Status: "InProgress", ApprovalToken: "approval-token"},
+			wantedOK:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			action, ok := tc.in.PendingApproval()
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedAction, action)
+		})
+	}
+}
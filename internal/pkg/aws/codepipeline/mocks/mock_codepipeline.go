@@ -50,6 +50,21 @@ func (mr *MockapiMockRecorder) GetPipeline(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*Mockapi)(nil).GetPipeline), arg0)
 }
 
+// GetPipelineExecution mocks base method.
+func (m *Mockapi) GetPipelineExecution(input *codepipeline.GetPipelineExecutionInput) (*codepipeline.GetPipelineExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineExecution", input)
+	ret0, _ := ret[0].(*codepipeline.GetPipelineExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineExecution indicates an expected call of GetPipelineExecution.
+func (mr *MockapiMockRecorder) GetPipelineExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineExecution", reflect.TypeOf((*Mockapi)(nil).GetPipelineExecution), input)
+}
+
 // GetPipelineState mocks base method.
 func (m *Mockapi) GetPipelineState(arg0 *codepipeline.GetPipelineStateInput) (*codepipeline.GetPipelineStateOutput, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockapiMockRecorder) ListPipelineExecutions(input interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineExecutions", reflect.TypeOf((*Mockapi)(nil).ListPipelineExecutions), input)
 }
 
+// PutApprovalResult mocks base method.
+func (m *Mockapi) PutApprovalResult(input *codepipeline.PutApprovalResultInput) (*codepipeline.PutApprovalResultOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApprovalResult", input)
+	ret0, _ := ret[0].(*codepipeline.PutApprovalResultOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutApprovalResult indicates an expected call of PutApprovalResult.
+func (mr *MockapiMockRecorder) PutApprovalResult(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApprovalResult", reflect.TypeOf((*Mockapi)(nil).PutApprovalResult), input)
+}
+
 // RetryStageExecution mocks base method.
 func (m *Mockapi) RetryStageExecution(input *codepipeline.RetryStageExecutionInput) (*codepipeline.RetryStageExecutionOutput, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +125,21 @@ func (mr *MockapiMockRecorder) RetryStageExecution(input interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*Mockapi)(nil).RetryStageExecution), input)
 }
 
+// StartPipelineExecution mocks base method.
+func (m *Mockapi) StartPipelineExecution(input *codepipeline.StartPipelineExecutionInput) (*codepipeline.StartPipelineExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPipelineExecution", input)
+	ret0, _ := ret[0].(*codepipeline.StartPipelineExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPipelineExecution indicates an expected call of StartPipelineExecution.
+func (mr *MockapiMockRecorder) StartPipelineExecution(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPipelineExecution", reflect.TypeOf((*Mockapi)(nil).StartPipelineExecution), input)
+}
+
 // MockresourceGetter is a mock of resourceGetter interface.
 type MockresourceGetter struct {
 	ctrl     *gomock.Controller
@@ -7,6 +7,10 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
@@ -25,6 +30,9 @@ const (
 	stableServiceDeploymentNum       = 1
 	// ECS EndpointsID
 	EndpointsID = ecs.EndpointsID
+
+	portForwardingDocumentName = "AWS-StartPortForwardingSessionToRemoteHost"
+	portForwardingDefaultHost  = "localhost"
 )
 
 type api interface {
@@ -34,19 +42,27 @@ type api interface {
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+	RegisterTaskDefinition(input *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
 	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
 
+type ssmAPI interface {
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartSessionWithOutput(ssmSession *ecs.Session, out io.Writer) error
+	StartPortForwardingSession(ssmSession *ssm.StartSessionOutput, params *ssm.StartSessionInput) error
 }
 
 // ECS wraps an AWS ECS client.
 type ECS struct {
 	client         api
+	ssmClient      ssmAPI
 	newSessStarter func() ssmSessionStarter
 
 	maxServiceStableTries int
@@ -73,10 +89,21 @@ type ExecuteCommandInput struct {
 	Container string
 }
 
+// ForwardPortInput holds the fields needed to forward a local port through a running container.
+type ForwardPortInput struct {
+	Cluster    string
+	Task       string
+	Container  string
+	LocalPort  string
+	RemotePort string
+	RemoteHost string // The host to forward to from the container. If empty, the port of the container itself is forwarded.
+}
+
 // New returns a Service configured against the input session.
 func New(s *session.Session) *ECS {
 	return &ECS{
-		client: ecs.New(s),
+		client:    ecs.New(s),
+		ssmClient: ssm.New(s),
 		newSessStarter: func() ssmSessionStarter {
 			return exec.NewSSMPluginCommand(s)
 		},
@@ -97,6 +124,98 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// RegisterTaskDefinitionOpts sets the optional parameter for RegisterTaskDefinitionRevision.
+type RegisterTaskDefinitionOpts func(*ecs.RegisterTaskDefinitionInput)
+
+// WithContainerSecrets adds secrets to a container of the task definition.
+// The secrets are given as a map of environment variable names to SSM parameter names or secret ARNs,
+// and replace any existing secret of the container with the same name.
+func WithContainerSecrets(container string, secrets map[string]string) RegisterTaskDefinitionOpts {
+	return func(in *ecs.RegisterTaskDefinitionInput) {
+		for _, def := range in.ContainerDefinitions {
+			if aws.StringValue(def.Name) != container {
+				continue
+			}
+			var kept []*ecs.Secret
+			for _, secret := range def.Secrets {
+				if _, ok := secrets[aws.StringValue(secret.Name)]; !ok {
+					kept = append(kept, secret)
+				}
+			}
+			names := make([]string, 0, len(secrets))
+			for name := range secrets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				kept = append(kept, &ecs.Secret{
+					Name:      aws.String(name),
+					ValueFrom: aws.String(secrets[name]),
+				})
+			}
+			def.Secrets = kept
+		}
+	}
+}
+
+// RegisterTaskDefinitionRevision registers a new revision of the task definition with the same
+// parameters and tags, modified by opts, and returns the ARN of the new revision.
+// If the latest revision of the task definition family already has the same parameters, for example because it was
+// registered with the same opts before, its ARN is returned instead so that revisions don't pile up.
+func (e *ECS) RegisterTaskDefinitionRevision(taskDefName string, opts ...RegisterTaskDefinitionOpts) (string, error) {
+	resp, err := e.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefName),
+		Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe task definition %s: %w", taskDefName, err)
+	}
+	in := registerTaskDefinitionInput(resp.TaskDefinition)
+	for _, opt := range opts {
+		opt(in)
+	}
+	family := aws.StringValue(resp.TaskDefinition.Family)
+	latest, err := e.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(family),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe latest revision of task definition family %s: %w", family, err)
+	}
+	if reflect.DeepEqual(in, registerTaskDefinitionInput(latest.TaskDefinition)) {
+		return aws.StringValue(latest.TaskDefinition.TaskDefinitionArn), nil
+	}
+	if len(resp.Tags) > 0 {
+		in.Tags = resp.Tags
+	}
+	out, err := e.client.RegisterTaskDefinition(in)
+	if err != nil {
+		return "", fmt.Errorf("register a new revision of task definition %s: %w", taskDefName, err)
+	}
+	return aws.StringValue(out.TaskDefinition.TaskDefinitionArn), nil
+}
+
+// registerTaskDefinitionInput returns the input to register a task definition with the same parameters as td.
+func registerTaskDefinitionInput(td *ecs.TaskDefinition) *ecs.RegisterTaskDefinitionInput {
+	return &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions:    td.ContainerDefinitions,
+		Cpu:                     td.Cpu,
+		EphemeralStorage:        td.EphemeralStorage,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		Family:                  td.Family,
+		InferenceAccelerators:   td.InferenceAccelerators,
+		IpcMode:                 td.IpcMode,
+		Memory:                  td.Memory,
+		NetworkMode:             td.NetworkMode,
+		PidMode:                 td.PidMode,
+		PlacementConstraints:    td.PlacementConstraints,
+		ProxyConfiguration:      td.ProxyConfiguration,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		RuntimePlatform:         td.RuntimePlatform,
+		TaskRoleArn:             td.TaskRoleArn,
+		Volumes:                 td.Volumes,
+	}
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	resp, err := e.client.DescribeServices(&ecs.DescribeServicesInput{
@@ -125,6 +244,13 @@ func WithForceUpdate() UpdateServiceOpts {
 	}
 }
 
+// WithDesiredCount sets the number of tasks that the service should run.
+func WithDesiredCount(count int64) UpdateServiceOpts {
+	return func(in *ecs.UpdateServiceInput) {
+		in.DesiredCount = aws.Int64(count)
+	}
+}
+
 // UpdateService calls ECS API and updates the specific service running in the cluster.
 func (e *ECS) UpdateService(clusterName, serviceName string, opts ...UpdateServiceOpts) error {
 	in := &ecs.UpdateServiceInput{
@@ -407,6 +533,76 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 	return err
 }
 
+// ExecuteCommandWithOutput executes commands in a running container without a terminal attached,
+// and writes the output of the session to out.
+func (e *ECS) ExecuteCommandWithOutput(in ExecuteCommandInput, out io.Writer) error {
+	execCmdresp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	})
+	if err != nil {
+		return &ErrExecuteCommand{err: err}
+	}
+	sessID := aws.StringValue(execCmdresp.Session.SessionId)
+	if err = e.newSessStarter().StartSessionWithOutput(execCmdresp.Session, out); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
+
+// ForwardPort forwards a local port to a port of a running container, or of a remote host reachable from the container,
+// until the session is terminated.
+func (e *ECS) ForwardPort(in ForwardPortInput) error {
+	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: aws.String(in.Cluster),
+		Tasks:   aws.StringSlice([]string{in.Task}),
+	})
+	if err != nil {
+		return fmt.Errorf("describe task %s: %w", in.Task, err)
+	}
+	if len(resp.Tasks) == 0 {
+		return fmt.Errorf("cannot find task %s in cluster %s", in.Task, in.Cluster)
+	}
+	task := resp.Tasks[0]
+	var runtimeID string
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) == in.Container {
+			runtimeID = aws.StringValue(container.RuntimeId)
+			break
+		}
+	}
+	if runtimeID == "" {
+		return fmt.Errorf("cannot find the runtime ID of container %s in task %s", in.Container, in.Task)
+	}
+	clusterARN := aws.StringValue(task.ClusterArn)
+	cluster := clusterARN[strings.LastIndex(clusterARN, "/")+1:]
+	host := in.RemoteHost
+	if host == "" {
+		host = portForwardingDefaultHost
+	}
+	params := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingDocumentName),
+		Target:       aws.String(fmt.Sprintf("ecs:%s_%s_%s", cluster, in.Task, runtimeID)),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{host}),
+			"portNumber":      aws.StringSlice([]string{in.RemotePort}),
+			"localPortNumber": aws.StringSlice([]string{in.LocalPort}),
+		},
+	}
+	sess, err := e.ssmClient.StartSession(params)
+	if err != nil {
+		return fmt.Errorf("start port forwarding session to container %s in task %s: %w", in.Container, in.Task, err)
+	}
+	sessID := aws.StringValue(sess.SessionId)
+	if err = e.newSessStarter().StartPortForwardingSession(sess, params); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
+
 // NetworkConfiguration returns the network configuration of a service.
 func (e *ECS) NetworkConfiguration(cluster, serviceName string) (*NetworkConfiguration, error) {
 	service, err := e.service(cluster, serviceName)
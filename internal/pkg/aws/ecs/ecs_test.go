@@ -6,12 +6,15 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -104,6 +107,156 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_RegisterTaskDefinitionRevision(t *testing.T) {
+	mockError := errors.New("some error")
+	mockTaskDef := func() *ecs.TaskDefinition {
+		return &ecs.TaskDefinition{
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/task-def:1"),
+			Family:            aws.String("task-def"),
+			ExecutionRoleArn:  aws.String("executionRole"),
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("job"),
+					Secrets: []*ecs.Secret{
+						{Name: aws.String("DB_PASS"), ValueFrom: aws.String("/copilot/app/test/secrets/db")},
+						{Name: aws.String("API_KEY"), ValueFrom: aws.String("/copilot/app/test/secrets/api")},
+					},
+				},
+				{
+					Name: aws.String("sidecar"),
+				},
+			},
+		}
+	}
+	mockOverriddenContainers := func() []*ecs.ContainerDefinition {
+		return []*ecs.ContainerDefinition{
+			{
+				Name: aws.String("job"),
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("API_KEY"), ValueFrom: aws.String("/copilot/app/test/secrets/api")},
+					{Name: aws.String("DB_PASS"), ValueFrom: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db")},
+					{Name: aws.String("TENANT_KEY"), ValueFrom: aws.String("/copilot/app/test/secrets/tenant")},
+				},
+			},
+			{
+				Name: aws.String("sidecar"),
+			},
+		}
+	}
+	mockOpts := []RegisterTaskDefinitionOpts{
+		WithContainerSecrets("job", map[string]string{
+			"TENANT_KEY": "/copilot/app/test/secrets/tenant",
+			"DB_PASS":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:db",
+		}),
+	}
+
+	testCases := map[string]struct {
+		opts          []RegisterTaskDefinitionOpts
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+		wantARN string
+	}{
+		"should return wrapped error if the task definition cannot be described": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: aws.String("task-def:1"),
+					Include:        aws.StringSlice([]string{"TAGS"}),
+				}).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("describe task definition task-def:1: %w", mockError),
+		},
+		"should return wrapped error if the latest revision cannot be described": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{Family: aws.String("task-def")},
+				}, nil)
+				m.EXPECT().DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: aws.String("task-def"),
+				}).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("describe latest revision of task definition family task-def: %w", mockError),
+		},
+		"should return wrapped error if the revision cannot be registered": {
+			opts: mockOpts,
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: mockTaskDef(),
+				}, nil)
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: mockTaskDef(),
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("register a new revision of task definition task-def:1: %w", mockError),
+		},
+		"registers a copy of the task definition with the secrets added to the container": {
+			opts: mockOpts,
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: mockTaskDef(),
+					Tags:           []*ecs.Tag{{Key: aws.String("copilot-application"), Value: aws.String("app")}},
+				}, nil)
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: mockTaskDef(),
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+					Family:               aws.String("task-def"),
+					ExecutionRoleArn:     aws.String("executionRole"),
+					ContainerDefinitions: mockOverriddenContainers(),
+					Tags:                 []*ecs.Tag{{Key: aws.String("copilot-application"), Value: aws.String("app")}},
+				}).Return(&ecs.RegisterTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/task-def:2")},
+				}, nil)
+			},
+			wantARN: "arn:aws:ecs:us-west-2:123456789012:task-definition/task-def:2",
+		},
+		"reuses the latest revision if it already has the same secrets": {
+			opts: mockOpts,
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: mockTaskDef(),
+					Tags:           []*ecs.Tag{{Key: aws.String("copilot-application"), Value: aws.String("app")}},
+				}, nil)
+				latest := mockTaskDef()
+				latest.TaskDefinitionArn = aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/task-def:2")
+				latest.ContainerDefinitions = mockOverriddenContainers()
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: latest,
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(gomock.Any()).Times(0)
+			},
+			wantARN: "arn:aws:ecs:us-west-2:123456789012:task-definition/task-def:2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			arn, err := service.RegisterTaskDefinitionRevision("task-def:1", tc.opts...)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantARN, arn)
+			}
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -1236,6 +1389,240 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 }
 
+func TestECS_ExecuteCommandWithOutput(t *testing.T) {
+	mockExecCmdIn := &ecs.ExecuteCommandInput{
+		Cluster:     aws.String("mockCluster"),
+		Command:     aws.String("mockCommand"),
+		Interactive: aws.Bool(true),
+		Container:   aws.String("mockContainer"),
+		Task:        aws.String("mockTask"),
+	}
+	mockSess := &ecs.Session{
+		SessionId: aws.String("mockSessID"),
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		mockAPI         func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockssmSessionStarter)
+		wantedError     error
+	}{
+		"return error if fail to call ExecuteCommand": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(nil, mockErr)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     &ErrExecuteCommand{err: mockErr},
+		},
+		"return error if fail to start the session": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithOutput(mockSess, gomock.Any()).Return(mockErr)
+			},
+			wantedError: fmt.Errorf("start session mockSessID using ssm plugin: some error"),
+		},
+		"success": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithOutput(mockSess, gomock.Any()).DoAndReturn(func(_ *ecs.Session, out io.Writer) error {
+					_, err := out.Write([]byte("hello"))
+					return err
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.mockAPI(mockAPI)
+			tc.mockSessStarter(mockSessStarter)
+
+			ecs := ECS{
+				client: mockAPI,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			var out strings.Builder
+			err := ecs.ExecuteCommandWithOutput(ExecuteCommandInput{
+				Cluster:   "mockCluster",
+				Command:   "mockCommand",
+				Container: "mockContainer",
+				Task:      "mockTask",
+			}, &out)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "hello", out.String())
+			}
+		})
+	}
+}
+
+func TestECS_ForwardPort(t *testing.T) {
+	mockDescribeTasksIn := &ecs.DescribeTasksInput{
+		Cluster: aws.String("mockCluster"),
+		Tasks:   aws.StringSlice([]string{"mockTask"}),
+	}
+	mockTasks := &ecs.DescribeTasksOutput{
+		Tasks: []*ecs.Task{
+			{
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster"),
+				Containers: []*ecs.Container{
+					{
+						Name:      aws.String("sidecar"),
+						RuntimeId: aws.String("mockTask-111"),
+					},
+					{
+						Name:      aws.String("mockContainer"),
+						RuntimeId: aws.String("mockTask-222"),
+					},
+				},
+			},
+		},
+	}
+	mockStartSessionIn := func(host string) *ssm.StartSessionInput {
+		return &ssm.StartSessionInput{
+			DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+			Target:       aws.String("ecs:mockCluster_mockTask_mockTask-222"),
+			Parameters: map[string][]*string{
+				"host":            aws.StringSlice([]string{host}),
+				"portNumber":      aws.StringSlice([]string{"5432"}),
+				"localPortNumber": aws.StringSlice([]string{"15432"}),
+			},
+		}
+	}
+	mockSess := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessID"),
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inRemoteHost    string
+		mockAPI         func(m *mocks.Mockapi)
+		mockSSM         func(m *mocks.MockssmAPI)
+		mockSessStarter func(m *mocks.MockssmSessionStarter)
+		wantedError     error
+	}{
+		"return error if fail to describe the task": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(nil, mockErr)
+			},
+			mockSSM:         func(m *mocks.MockssmAPI) {},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     errors.New("describe task mockTask: some error"),
+		},
+		"return error if the container is not found in the task": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster"),
+						},
+					},
+				}, nil)
+			},
+			mockSSM:         func(m *mocks.MockssmAPI) {},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     errors.New("cannot find the runtime ID of container mockContainer in task mockTask"),
+		},
+		"return error if fail to start the session": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(mockTasks, nil)
+			},
+			mockSSM: func(m *mocks.MockssmAPI) {
+				m.EXPECT().StartSession(mockStartSessionIn("localhost")).Return(nil, mockErr)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     errors.New("start port forwarding session to container mockContainer in task mockTask: some error"),
+		},
+		"return error if the ssm plugin fails": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(mockTasks, nil)
+			},
+			mockSSM: func(m *mocks.MockssmAPI) {
+				m.EXPECT().StartSession(mockStartSessionIn("localhost")).Return(mockSess, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, mockStartSessionIn("localhost")).Return(mockErr)
+			},
+			wantedError: errors.New("start session mockSessID using ssm plugin: some error"),
+		},
+		"forwards to the container by default": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(mockTasks, nil)
+			},
+			mockSSM: func(m *mocks.MockssmAPI) {
+				m.EXPECT().StartSession(mockStartSessionIn("localhost")).Return(mockSess, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, mockStartSessionIn("localhost")).Return(nil)
+			},
+		},
+		"forwards to a remote host through the container": {
+			inRemoteHost: "db.example.com",
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTasks(mockDescribeTasksIn).Return(mockTasks, nil)
+			},
+			mockSSM: func(m *mocks.MockssmAPI) {
+				m.EXPECT().StartSession(mockStartSessionIn("db.example.com")).Return(mockSess, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, mockStartSessionIn("db.example.com")).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockSSM := mocks.NewMockssmAPI(ctrl)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.mockAPI(mockAPI)
+			tc.mockSSM(mockSSM)
+			tc.mockSessStarter(mockSessStarter)
+
+			ecs := ECS{
+				client:    mockAPI,
+				ssmClient: mockSSM,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			err := ecs.ForwardPort(ForwardPortInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTask",
+				Container:  "mockContainer",
+				LocalPort:  "15432",
+				RemotePort: "5432",
+				RemoteHost: tc.inRemoteHost,
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestECS_NetworkConfiguration(t *testing.T) {
 	testCases := map[string]struct {
 		mockAPI func(m *mocks.Mockapi)
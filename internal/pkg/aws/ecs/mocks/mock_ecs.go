@@ -5,9 +5,11 @@
 package mocks
 
 import (
+	io "io"
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
+	ssm "github.com/aws/aws-sdk-go/service/ssm"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -124,6 +126,21 @@ func (mr *MockapiMockRecorder) ListTasks(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*Mockapi)(nil).ListTasks), input)
 }
 
+// RegisterTaskDefinition mocks base method.
+func (m *Mockapi) RegisterTaskDefinition(input *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTaskDefinition", input)
+	ret0, _ := ret[0].(*ecs.RegisterTaskDefinitionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTaskDefinition indicates an expected call of RegisterTaskDefinition.
+func (mr *MockapiMockRecorder) RegisterTaskDefinition(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskDefinition", reflect.TypeOf((*Mockapi)(nil).RegisterTaskDefinition), input)
+}
+
 // RunTask mocks base method.
 func (m *Mockapi) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +200,44 @@ func (mr *MockapiMockRecorder) WaitUntilTasksRunning(input interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTasksRunning", reflect.TypeOf((*Mockapi)(nil).WaitUntilTasksRunning), input)
 }
 
+// MockssmAPI is a mock of ssmAPI interface.
+type MockssmAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmAPIMockRecorder
+}
+
+// MockssmAPIMockRecorder is the mock recorder for MockssmAPI.
+type MockssmAPIMockRecorder struct {
+	mock *MockssmAPI
+}
+
+// NewMockssmAPI creates a new mock instance.
+func NewMockssmAPI(ctrl *gomock.Controller) *MockssmAPI {
+	mock := &MockssmAPI{ctrl: ctrl}
+	mock.recorder = &MockssmAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmAPI) EXPECT() *MockssmAPIMockRecorder {
+	return m.recorder
+}
+
+// StartSession mocks base method.
+func (m *MockssmAPI) StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", input)
+	ret0, _ := ret[0].(*ssm.StartSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockssmAPIMockRecorder) StartSession(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmAPI)(nil).StartSession), input)
+}
+
 // MockssmSessionStarter is a mock of ssmSessionStarter interface.
 type MockssmSessionStarter struct {
 	ctrl     *gomock.Controller
@@ -206,6 +261,20 @@ func (m *MockssmSessionStarter) EXPECT() *MockssmSessionStarterMockRecorder {
 	return m.recorder
 }
 
+// StartPortForwardingSession mocks base method.
+func (m *MockssmSessionStarter) StartPortForwardingSession(ssmSession *ssm.StartSessionOutput, params *ssm.StartSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", ssmSession, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockssmSessionStarterMockRecorder) StartPortForwardingSession(ssmSession, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartPortForwardingSession), ssmSession, params)
+}
+
 // StartSession mocks base method.
 func (m *MockssmSessionStarter) StartSession(ssmSession *ecs.Session) error {
 	m.ctrl.T.Helper()
@@ -219,3 +288,17 @@ func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
 }
+
+// StartSessionWithOutput mocks base method.
+func (m *MockssmSessionStarter) StartSessionWithOutput(ssmSession *ecs.Session, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSessionWithOutput", ssmSession, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSessionWithOutput indicates an expected call of StartSessionWithOutput.
+func (mr *MockssmSessionStarterMockRecorder) StartSessionWithOutput(ssmSession, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionWithOutput", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSessionWithOutput), ssmSession, out)
+}
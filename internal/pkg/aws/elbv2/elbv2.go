@@ -77,6 +77,46 @@ func (e *ELBV2) ListenerRuleHostHeaders(ruleARN string) ([]string, error) {
 	return hostHeaders, nil
 }
 
+// TargetGroupWeight is the weight of a target group that a listener rule forwards traffic to.
+type TargetGroupWeight struct {
+	ARN    string
+	Weight int
+}
+
+// TargetGroupWeights returns the weights of the target groups that a listener rule forwards traffic to.
+func (e *ELBV2) TargetGroupWeights(ruleARN string) ([]TargetGroupWeight, error) {
+	resp, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+		RuleArns: aws.StringSlice([]string{ruleARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get listener rule for %s: %w", ruleARN, err)
+	}
+	if len(resp.Rules) == 0 {
+		return nil, fmt.Errorf("cannot find listener rule %s", ruleARN)
+	}
+	var weights []TargetGroupWeight
+	for _, action := range resp.Rules[0].Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig == nil {
+			// A forward action to a single target group receives all the traffic.
+			weights = append(weights, TargetGroupWeight{
+				ARN:    aws.StringValue(action.TargetGroupArn),
+				Weight: 1,
+			})
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			weights = append(weights, TargetGroupWeight{
+				ARN:    aws.StringValue(tg.TargetGroupArn),
+				Weight: int(aws.Int64Value(tg.Weight)),
+			})
+		}
+	}
+	return weights, nil
+}
+
 // Rule wraps an elbv2.Rule to add some nice functionality to it.
 type Rule elbv2.Rule
 
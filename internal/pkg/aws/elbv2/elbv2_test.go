@@ -220,6 +220,103 @@ func TestELBV2_ListenerRuleHostHeaders(t *testing.T) {
 	}
 }
 
+func TestELBV2_TargetGroupWeights(t *testing.T) {
+	mockARN := "mockListenerRuleARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []TargetGroupWeight
+		wantedError error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{mockARN}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get listener rule for mockListenerRuleARN: some error"),
+		},
+		"cannot find listener rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{}, nil)
+			},
+			wantedError: errors.New("cannot find listener rule mockListenerRuleARN"),
+		},
+		"success with weighted target groups": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumForward),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{
+												TargetGroupArn: aws.String("blue"),
+												Weight:         aws.Int64(90),
+											},
+											{
+												TargetGroupArn: aws.String("green"),
+												Weight:         aws.Int64(10),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []TargetGroupWeight{
+				{ARN: "blue", Weight: 90},
+				{ARN: "green", Weight: 10},
+			},
+		},
+		"success with a single target group": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("blue"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []TargetGroupWeight{
+				{ARN: "blue", Weight: 1},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.TargetGroupWeights(mockARN)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
 func TestELBV2_DescribeRule(t *testing.T) {
 	mockARN := "mockListenerRuleARN"
 	testCases := map[string]struct {
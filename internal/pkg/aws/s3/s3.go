@@ -5,6 +5,7 @@
 package s3
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	awsarn "github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -23,10 +25,15 @@ const (
 	EndpointsID = s3.EndpointsID
 
 	// Error codes.
-	errCodeNotFound = "NotFound"
+	errCodeNotFound                   = "NotFound"
+	errCodePreconditionFailed         = "PreconditionFailed"
+	errCodeConditionalRequestConflict = "ConditionalRequestConflict"
 
 	// Object location prefixes.
 	s3URIPrefix = "s3://"
+
+	// Maximum number of keys that can be deleted in a single DeleteObjects request.
+	maxDeleteObjects = 1000
 )
 
 type s3ManagerAPI interface {
@@ -35,8 +42,13 @@ type s3ManagerAPI interface {
 
 type s3API interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	GetBucketVersioning(input *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
+	GetBucketNotificationConfiguration(input *s3.GetBucketNotificationConfigurationRequest) (*s3.NotificationConfiguration, error)
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -48,6 +60,13 @@ type NamedBinary interface {
 // CompressAndUploadFunc is invoked to zip multiple template contents and upload them to an S3 bucket under the specified key.
 type CompressAndUploadFunc func(key string, objects ...NamedBinary) (url string, err error)
 
+// Object is an object stored in an S3 bucket.
+type Object struct {
+	Key  string
+	ETag string // Entity tag of the object without the surrounding quotes.
+	Size int64
+}
+
 // S3 wraps an Amazon Simple Storage Service client.
 type S3 struct {
 	s3Manager s3ManagerAPI
@@ -66,7 +85,179 @@ func New(s *session.Session) *S3 {
 // Per s3's recommendation https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html:
 // The bucket owner, in addition to the object owner, is granted full control.
 func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
-	return s.upload(bucket, key, data)
+	return s.upload(bucket, key, data, ObjectMetadata{})
+}
+
+// ErrObjectExists is returned when an object already exists in a bucket.
+type ErrObjectExists struct {
+	Bucket string
+	Key    string
+}
+
+func (e *ErrObjectExists) Error() string {
+	return fmt.Sprintf("object %s already exists in bucket %s", e.Key, e.Bucket)
+}
+
+// UploadIfNotExists uploads a file to an S3 bucket under the specified key only if no object exists under the key.
+// If an object already exists or is being written under the key, returns an ErrObjectExists.
+func (s *S3) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	url, err := s.upload(bucket, key, data, ObjectMetadata{}, func(u *s3manager.Uploader) {
+		u.RequestOptions = append(u.RequestOptions, func(r *request.Request) {
+			r.HTTPRequest.Header.Set("If-None-Match", "*")
+		})
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && (aerr.Code() == errCodePreconditionFailed || aerr.Code() == errCodeConditionalRequestConflict) {
+		return "", &ErrObjectExists{
+			Bucket: bucket,
+			Key:    key,
+		}
+	}
+	return url, err
+}
+
+// ObjectMetadata holds the system-defined metadata of an object that are returned as HTTP headers when the object is served.
+type ObjectMetadata struct {
+	ContentType     string
+	CacheControl    string
+	ContentEncoding string
+}
+
+// UploadWithMetadata uploads a file to an S3 bucket under the specified key with the system-defined metadata.
+// Empty metadata fields are left for S3 to default.
+func (s *S3) UploadWithMetadata(bucket, key string, data io.Reader, metadata ObjectMetadata) (string, error) {
+	return s.upload(bucket, key, data, metadata)
+}
+
+// ListObjects returns all the objects in the bucket whose key begins with prefix.
+func (s *S3) ListObjects(bucket, prefix string) ([]Object, error) {
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		in.Prefix = aws.String(prefix)
+	}
+	var objects []Object
+	for {
+		out, err := s.s3Client.ListObjectsV2(in)
+		if err != nil {
+			return nil, fmt.Errorf("list objects with prefix %q in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, obj := range out.Contents {
+			objects = append(objects, Object{
+				Key:  aws.StringValue(obj.Key),
+				ETag: strings.Trim(aws.StringValue(obj.ETag), `"`),
+				Size: aws.Int64Value(obj.Size),
+			})
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return objects, nil
+		}
+		in.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// ErrObjectNotFound is returned when an object doesn't exist in a bucket.
+type ErrObjectNotFound struct {
+	Bucket string
+	Key    string
+}
+
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.Key, e.Bucket)
+}
+
+// GetObject returns the content of the object stored under key in the bucket.
+// If the object doesn't exist, returns an ErrObjectNotFound.
+func (s *S3) GetObject(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{
+				Bucket: bucket,
+				Key:    key,
+			}
+		}
+		return nil, fmt.Errorf("get object %s from bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	content, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
+// Metadata returns the system-defined metadata of an object.
+func (s *S3) Metadata(bucket, key string) (ObjectMetadata, error) {
+	out, err := s.s3Client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return ObjectMetadata{}, fmt.Errorf("head object %s from bucket %s: %w", key, bucket, err)
+	}
+	return ObjectMetadata{
+		ContentType:     aws.StringValue(out.ContentType),
+		CacheControl:    aws.StringValue(out.CacheControl),
+		ContentEncoding: aws.StringValue(out.ContentEncoding),
+	}, nil
+}
+
+// IsBucketVersioned returns true if versioning is enabled for the bucket.
+func (s *S3) IsBucketVersioned(bucket string) (bool, error) {
+	out, err := s.s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return false, fmt.Errorf("get versioning of bucket %s: %w", bucket, err)
+	}
+	return aws.StringValue(out.Status) == s3.BucketVersioningStatusEnabled, nil
+}
+
+// IsEventBridgeEnabled returns true if the bucket sends notifications of its events to Amazon EventBridge.
+func (s *S3) IsEventBridgeEnabled(bucket string) (bool, error) {
+	out, err := s.s3Client.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return false, fmt.Errorf("get notification configuration of bucket %s: %w", bucket, err)
+	}
+	return out.EventBridgeConfiguration != nil, nil
+}
+
+// DeleteObjects deletes the objects with the given keys from the bucket.
+func (s *S3) DeleteObjects(bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += maxDeleteObjects {
+		end := start + maxDeleteObjects
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+		out, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		}
+		if len(out.Errors) > 0 {
+			e := out.Errors[0]
+			return fmt.Errorf("delete object %s from bucket %s: %s", aws.StringValue(e.Key), bucket, aws.StringValue(e.Message))
+		}
+	}
+	return nil
 }
 
 // EmptyBucket deletes all objects within the bucket.
@@ -194,14 +385,23 @@ func (s *S3) isBucketExists(bucket string) (bool, error) {
 	return true, nil
 }
 
-func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
+func (s *S3) upload(bucket, key string, buf io.Reader, metadata ObjectMetadata, opts ...func(*s3manager.Uploader)) (string, error) {
 	in := &s3manager.UploadInput{
 		Body:   buf,
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(s3.ObjectCannedACLBucketOwnerFullControl),
 	}
-	resp, err := s.s3Manager.Upload(in)
+	if metadata.ContentType != "" {
+		in.ContentType = aws.String(metadata.ContentType)
+	}
+	if metadata.CacheControl != "" {
+		in.CacheControl = aws.String(metadata.CacheControl)
+	}
+	if metadata.ContentEncoding != "" {
+		in.ContentEncoding = aws.String(metadata.ContentEncoding)
+	}
+	resp, err := s.s3Manager.Upload(in, opts...)
 	if err != nil {
 		return "", fmt.Errorf("upload %s to bucket %s: %w", key, bucket, err)
 	}
@@ -8,9 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -20,6 +23,32 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestS3_UploadWithMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+	mockS3ManagerClient.EXPECT().Upload(gomock.Any()).Do(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) {
+		require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+		require.Equal(t, "mockFileName", aws.StringValue(in.Key))
+		require.Equal(t, "text/css", aws.StringValue(in.ContentType))
+		require.Equal(t, "max-age=60", aws.StringValue(in.CacheControl))
+		require.Nil(t, in.ContentEncoding)
+	}).Return(&s3manager.UploadOutput{
+		Location: "mockURL",
+	}, nil)
+	service := S3{
+		s3Manager: mockS3ManagerClient,
+	}
+
+	gotURL, gotErr := service.UploadWithMetadata("mockBucket", "mockFileName", bytes.NewBuffer([]byte("bar")), ObjectMetadata{
+		ContentType:  "text/css",
+		CacheControl: "max-age=60",
+	})
+
+	require.NoError(t, gotErr)
+	require.Equal(t, "mockURL", gotURL)
+}
+
 func TestS3_Upload(t *testing.T) {
 	testCases := map[string]struct {
 		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
@@ -78,6 +107,70 @@ func TestS3_Upload(t *testing.T) {
 	}
 }
 
+func TestS3_UploadIfNotExists(t *testing.T) {
+	testCases := map[string]struct {
+		uploadErr error
+
+		wantedURL string
+		wantedErr error
+	}{
+		"return ErrObjectExists if the precondition fails": {
+			uploadErr: awserr.New("PreconditionFailed", "At least one of the pre-conditions you specified did not hold", nil),
+			wantedErr: &ErrObjectExists{Bucket: "mockBucket", Key: "mockFileName"},
+		},
+		"return ErrObjectExists if the object is being written concurrently": {
+			uploadErr: awserr.New("ConditionalRequestConflict", "A conflicting conditional operation is currently in progress", nil),
+			wantedErr: &ErrObjectExists{Bucket: "mockBucket", Key: "mockFileName"},
+		},
+		"wrap other errors": {
+			uploadErr: errors.New("some error"),
+			wantedErr: errors.New("upload mockFileName to bucket mockBucket: some error"),
+		},
+		"should upload the object if it doesn't exist": {
+			wantedURL: "mockURL",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+			mockS3ManagerClient.EXPECT().Upload(gomock.Any(), gomock.Any()).DoAndReturn(func(in *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+				require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+				require.Equal(t, "mockFileName", aws.StringValue(in.Key))
+				uploader := &s3manager.Uploader{}
+				for _, opt := range opts {
+					opt(uploader)
+				}
+				req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+				req.ApplyOptions(uploader.RequestOptions...)
+				require.Equal(t, "*", req.HTTPRequest.Header.Get("If-None-Match"))
+				if tc.uploadErr != nil {
+					return nil, tc.uploadErr
+				}
+				return &s3manager.UploadOutput{Location: "mockURL"}, nil
+			})
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			// WHEN
+			gotURL, gotErr := service.UploadIfNotExists("mockBucket", "mockFileName", bytes.NewBuffer([]byte("bar")))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, gotErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantedURL, gotURL)
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
@@ -296,6 +389,389 @@ func TestS3_EmptyBucket(t *testing.T) {
 	}
 }
 
+func TestS3_ListObjects(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    []Object
+		wantedErr error
+	}{
+		"should wrap the error if objects can't be listed": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`list objects with prefix "assets" in bucket mockBucket: some error`),
+		},
+		"should list objects across pages and strip the quotes around ETags": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("assets"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("assets/index.html"), ETag: aws.String(`"abc"`), Size: aws.Int64(10)},
+					},
+					IsTruncated:           aws.Bool(true),
+					NextContinuationToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("assets"),
+					ContinuationToken: aws.String("mockToken"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("assets/main.js"), ETag: aws.String(`"def-2"`), Size: aws.Int64(20)},
+					},
+				}, nil)
+			},
+			wanted: []Object{
+				{Key: "assets/index.html", ETag: "abc", Size: 10},
+				{Key: "assets/main.js", ETag: "def-2", Size: 20},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, err := service.ListObjects("mockBucket", "assets")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestS3_GetObject(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    string
+		wantedErr error
+	}{
+		"should return ErrObjectNotFound if the key doesn't exist": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "message", nil))
+			},
+			wantedErr: &ErrObjectNotFound{
+				Bucket: "mockBucket",
+				Key:    "mockKey",
+			},
+		},
+		"should wrap the error if the object can't be retrieved": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(strings.NewReader("hello")),
+				}, nil)
+			},
+			wanted: "hello",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, err := service.GetObject("mockBucket", "mockKey")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
+func TestS3_Metadata(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    ObjectMetadata
+		wantedErr error
+	}{
+		"should wrap the error if the object can't be retrieved": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("head object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the system-defined metadata of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(&s3.HeadObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.HeadObjectOutput{
+					ContentType:  aws.String("text/html"),
+					CacheControl: aws.String("max-age=60"),
+				}, nil)
+			},
+			wanted: ObjectMetadata{
+				ContentType:  "text/html",
+				CacheControl: "max-age=60",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, err := service.Metadata("mockBucket", "mockKey")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestS3_IsBucketVersioned(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    bool
+		wantedErr error
+	}{
+		"should wrap the error if the versioning can't be retrieved": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketVersioning(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get versioning of bucket mockBucket: some error"),
+		},
+		"should return false if versioning was never enabled": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{}, nil)
+			},
+		},
+		"should return false if versioning is suspended": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{
+					Status: aws.String(s3.BucketVersioningStatusSuspended),
+				}, nil)
+			},
+		},
+		"should return true if versioning is enabled": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketVersioning(&s3.GetBucketVersioningInput{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.GetBucketVersioningOutput{
+					Status: aws.String(s3.BucketVersioningStatusEnabled),
+				}, nil)
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, err := service.IsBucketVersioned("mockBucket")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestS3_IsEventBridgeEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wanted    bool
+		wantedErr error
+	}{
+		"should wrap the error if the notification configuration can't be retrieved": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketNotificationConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get notification configuration of bucket mockBucket: some error"),
+		},
+		"should return false if EventBridge notifications are off": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketNotificationConfiguration(gomock.Any()).Return(&s3.NotificationConfiguration{}, nil)
+			},
+		},
+		"should return true if EventBridge notifications are on": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+					Bucket: aws.String("mockBucket"),
+				}).Return(&s3.NotificationConfiguration{
+					EventBridgeConfiguration: &s3.EventBridgeConfiguration{},
+				}, nil)
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			got, err := service.IsEventBridgeEnabled("mockBucket")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestS3_DeleteObjects(t *testing.T) {
+	manyKeys := make([]string, 1001)
+	for i := range manyKeys {
+		manyKeys[i] = fmt.Sprintf("key-%d", i)
+	}
+	testCases := map[string]struct {
+		inKeys       []string
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedErr error
+	}{
+		"should not call the API if there are no keys": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Times(0)
+			},
+		},
+		"should wrap the error if objects can't be deleted": {
+			inKeys: []string{"index.html"},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("delete objects from bucket mockBucket: some error"),
+		},
+		"should return the first error of a partially failed request": {
+			inKeys: []string{"index.html"},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{
+					Errors: []*s3.Error{
+						{Key: aws.String("index.html"), Message: aws.String("Access Denied")},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("delete object index.html from bucket mockBucket: Access Denied"),
+		},
+		"should delete the keys in batches of 1000": {
+			inKeys: manyKeys,
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Len(t, in.Delete.Objects, 1000)
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+				m.EXPECT().DeleteObjects(&s3.DeleteObjectsInput{
+					Bucket: aws.String("mockBucket"),
+					Delete: &s3.Delete{
+						Objects: []*s3.ObjectIdentifier{
+							{Key: aws.String("key-1000")},
+						},
+						Quiet: aws.Bool(true),
+					},
+				}).Return(&s3.DeleteObjectsOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			err := service.DeleteObjects("mockBucket", tc.inKeys)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
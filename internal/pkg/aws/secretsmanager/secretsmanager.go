@@ -5,7 +5,10 @@
 package secretsmanager
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -19,6 +22,10 @@ type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
 	DescribeSecret(input *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
+	GetSecretValue(input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	ListSecrets(input *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+	PutSecretValue(input *secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	TagResource(input *secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -70,7 +77,67 @@ func (s *SecretsManager) CreateSecret(secretName, secretString string) (string,
 	return aws.StringValue(resp.ARN), nil
 }
 
+// PutSecretInput contains fields needed to create or update a secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+}
+
+// PutSecretOutput contains the ARN of a created or updated secret.
+type PutSecretOutput struct {
+	ARN         string
+	Overwritten bool // True if the secret already existed and its value was replaced.
+}
+
+// PutSecret tries to create the secret with the tags, and overwrites its value if the secret exists and `Overwrite` is true.
+// ErrSecretAlreadyExists is returned if the secret exists and `Overwrite` is false.
+func (s *SecretsManager) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	tags := convertTags(in.Tags)
+	resp, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         tags,
+	})
+	if err == nil {
+		return &PutSecretOutput{
+			ARN: aws.StringValue(resp.ARN),
+		}, nil
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) || aerr.Code() != secretsmanager.ErrCodeResourceExistsException {
+		return nil, fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	if !in.Overwrite {
+		return nil, &ErrSecretAlreadyExists{
+			secretName: in.Name,
+			parentErr:  err,
+		}
+	}
+
+	out, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update secret %s: %w", in.Name, err)
+	}
+	// Tag the existing secret as well so that workloads in the environment are allowed to read it.
+	if _, err := s.secretsManager.TagResource(&secretsmanager.TagResourceInput{
+		SecretId: aws.String(in.Name),
+		Tags:     tags,
+	}); err != nil {
+		return nil, fmt.Errorf("add tags to secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN:         aws.StringValue(out.ARN),
+		Overwritten: true,
+	}, nil
+}
+
 // DeleteSecret force removes the secret from SecretsManager.
+// ErrSecretNotFound is returned if the secret does not exist.
 func (s *SecretsManager) DeleteSecret(secretName string) error {
 	_, err := s.secretsManager.DeleteSecret(&secretsmanager.DeleteSecretInput{
 		SecretId:                   aws.String(secretName),
@@ -78,11 +145,86 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	})
 
 	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				return &ErrSecretNotFound{
+					secretName: secretName,
+					parentErr:  err,
+				}
+			}
+		}
 		return fmt.Errorf("delete secret %s from secrets manager: %w", secretName, err)
 	}
 	return nil
 }
 
+// Secret is the metadata of a secret.
+type Secret struct {
+	Name        string
+	LastChanged time.Time
+}
+
+// ListSecrets returns the secrets whose name begins with prefix, sorted by name.
+func (s *SecretsManager) ListSecrets(prefix string) ([]Secret, error) {
+	in := &secretsmanager.ListSecretsInput{
+		Filters: []*secretsmanager.Filter{
+			{
+				Key:    aws.String(secretsmanager.FilterNameStringTypeName),
+				Values: aws.StringSlice([]string{prefix}),
+			},
+		},
+	}
+	var secrets []Secret
+	for {
+		out, err := s.secretsManager.ListSecrets(in)
+		if err != nil {
+			return nil, fmt.Errorf("list secrets with prefix %s: %w", prefix, err)
+		}
+		for _, secret := range out.SecretList {
+			name := aws.StringValue(secret.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue // The name filter is not case-sensitive.
+			}
+			lastChanged := secret.LastChangedDate
+			if lastChanged == nil {
+				lastChanged = secret.CreatedDate
+			}
+			secrets = append(secrets, Secret{
+				Name:        name,
+				LastChanged: aws.TimeValue(lastChanged),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// GetSecretValue returns the string value of the current version of a secret.
+// ErrSecretNotFound is returned if the secret does not exist.
+func (s *SecretsManager) GetSecretValue(secretName string) (string, error) {
+	resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			if aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				return "", &ErrSecretNotFound{
+					secretName: secretName,
+					parentErr:  err,
+				}
+			}
+		}
+		return "", fmt.Errorf("get value of secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(resp.SecretString), nil
+}
+
 type DescribeSecretOutput struct {
 	Name        *string
 	CreatedDate *time.Time
@@ -113,6 +255,24 @@ func (s *SecretsManager) DescribeSecret(secretName string) (*DescribeSecretOutpu
 	}, nil
 }
 
+func convertTags(inTags map[string]string) []*secretsmanager.Tag {
+	// Sort the map so that the unit test won't be flaky.
+	keys := make([]string, 0, len(inTags))
+	for k := range inTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var tags []*secretsmanager.Tag
+	for _, key := range keys {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(inTags[key]),
+		})
+	}
+	return tags
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -107,6 +107,123 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 	}
 }
 
+func TestSecretsManager_PutSecret(t *testing.T) {
+	const mockName = "copilot/myapp/myenv/secrets/db-credentials"
+	mockTags := []*secretsmanager.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("myapp"),
+		},
+		{
+			Key:   aws.String("copilot-environment"),
+			Value: aws.String("myenv"),
+		},
+	}
+	mockCreateSecretInput := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(mockName),
+		SecretString: aws.String(`{"username":"admin"}`),
+		Tags:         mockTags,
+	}
+	mockAwsErr := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+
+	tests := map[string]struct {
+		inOverwrite bool
+		callMock    func(m *mocks.Mockapi)
+
+		wantedOut   *PutSecretOutput
+		wantedError error
+	}{
+		"should wrap error returned by CreateSecret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("create secret copilot/myapp/myenv/secrets/db-credentials: some error"),
+		},
+		"should return ErrSecretAlreadyExists if the secret exists and overwrite is false": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockAwsErr)
+			},
+			wantedError: &ErrSecretAlreadyExists{
+				secretName: mockName,
+				parentErr:  mockAwsErr,
+			},
+		},
+		"should create the secret with tags": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(&secretsmanager.CreateSecretOutput{
+					ARN: aws.String("arn"),
+				}, nil)
+			},
+			wantedOut: &PutSecretOutput{
+				ARN: "arn",
+			},
+		},
+		"should wrap error returned by PutSecretValue": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockAwsErr)
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("update secret copilot/myapp/myenv/secrets/db-credentials: some error"),
+		},
+		"should overwrite the value and tag the existing secret": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().CreateSecret(mockCreateSecretInput).Return(nil, mockAwsErr),
+					m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+						SecretId:     aws.String(mockName),
+						SecretString: aws.String(`{"username":"admin"}`),
+					}).Return(&secretsmanager.PutSecretValueOutput{
+						ARN: aws.String("arn"),
+					}, nil),
+					m.EXPECT().TagResource(&secretsmanager.TagResourceInput{
+						SecretId: aws.String(mockName),
+						Tags:     mockTags,
+					}).Return(&secretsmanager.TagResourceOutput{}, nil),
+				)
+			},
+			wantedOut: &PutSecretOutput{
+				ARN:         "arn",
+				Overwritten: true,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			out, err := sm.PutSecret(PutSecretInput{
+				Name:      mockName,
+				Value:     `{"username":"admin"}`,
+				Overwrite: tc.inOverwrite,
+				Tags: map[string]string{
+					"copilot-environment": "myenv",
+					"copilot-application": "myapp",
+				},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, out)
+		})
+	}
+}
+
 func TestSecretsManager_DeleteSecret(t *testing.T) {
 	mockSecretName := "github-token-backend-badgoose"
 	mockError := errors.New("mockError")
@@ -127,6 +244,19 @@ func TestSecretsManager_DeleteSecret(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("delete secret %s from secrets manager: %w", mockSecretName, mockError),
 		},
+		"should return ErrSecretNotFound if the secret does not exist": {
+			inSecretName: mockSecretName,
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteSecret(&secretsmanager.DeleteSecretInput{
+					SecretId:                   aws.String(mockSecretName),
+					ForceDeleteWithoutRecovery: aws.Bool(true),
+				}).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectedError: &ErrSecretNotFound{
+				secretName: mockSecretName,
+				parentErr:  awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil),
+			},
+		},
 		"should return no error if successful": {
 			inSecretName: mockSecretName,
 			callMock: func(m *mocks.Mockapi) {
@@ -160,6 +290,97 @@ func TestSecretsManager_DeleteSecret(t *testing.T) {
 	}
 }
 
+func TestSecretsManager_ListSecrets(t *testing.T) {
+	const prefix = "copilot/phonetool/test/secrets/"
+	changed := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+	created := time.Date(2023, time.February, 1, 2, 0, 0, 0, time.UTC)
+	filters := []*secretsmanager.Filter{
+		{
+			Key:    aws.String("name"),
+			Values: aws.StringSlice([]string{prefix}),
+		},
+	}
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedSecrets []Secret
+		wantedError   error
+	}{
+		"should wrap error returned by ListSecrets": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets with prefix copilot/phonetool/test/secrets/: some error"),
+		},
+		"should return the secrets with the prefix across pages sorted by name": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+					Filters: filters,
+				}).Return(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{
+							Name:            aws.String(prefix + "redis"),
+							CreatedDate:     aws.Time(created),
+							LastChangedDate: aws.Time(changed),
+						},
+						{
+							Name:        aws.String("Copilot/phonetool/test/secrets/other"),
+							CreatedDate: aws.Time(created),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+					Filters:   filters,
+					NextToken: aws.String("token"),
+				}).Return(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{
+							Name:        aws.String(prefix + "db"),
+							CreatedDate: aws.Time(created),
+						},
+					},
+				}, nil)
+			},
+			wantedSecrets: []Secret{
+				{
+					Name:        prefix + "db",
+					LastChanged: created,
+				},
+				{
+					Name:        prefix + "redis",
+					LastChanged: changed,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			secrets, err := sm.ListSecrets(prefix)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, secrets)
+		})
+	}
+}
+
 func TestSecretsManager_DescribeSecret(t *testing.T) {
 	mockTime := time.Now()
 	mockSecretName := "github-token-backend-badgoose"
@@ -251,3 +472,65 @@ func TestSecretsManager_DescribeSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretValue(t *testing.T) {
+	mockSecretName := "github-token-backend-badgoose"
+	mockAwsErr := awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil)
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get value of secret %s: some error", mockSecretName),
+		},
+		"should return ErrSecretNotFound if the secret does not exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(nil, mockAwsErr)
+			},
+			wantedError: &ErrSecretNotFound{
+				secretName: mockSecretName,
+				parentErr:  mockAwsErr,
+			},
+		},
+		"should return the value of the secret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(gomock.Any()).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("hunter2"),
+				}, nil)
+			},
+			wantedValue: "hunter2",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			value, err := sm.GetSecretValue(mockSecretName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, value)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -19,6 +20,9 @@ import (
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
 }
 
 // SSM wraps an AWS SSM client.
@@ -41,6 +45,14 @@ type PutSecretInput struct {
 	Tags      map[string]string
 }
 
+// Secret holds the metadata of a parameter, without its value.
+type Secret struct {
+	Name         string
+	Type         string
+	Version      int64
+	LastModified time.Time
+}
+
 // PutSecretOutput wraps an ssm PutParameterOutput struct.
 type PutSecretOutput ssm.PutParameterOutput
 
@@ -115,6 +127,88 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// ListSecrets returns the metadata of the parameters that are tagged with every key-value pair in tags,
+// sorted by name.
+func (s *SSM) ListSecrets(tags map[string]string) ([]Secret, error) {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var filters []*ssm.ParameterStringFilter
+	for _, key := range keys {
+		filters = append(filters, &ssm.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", key)),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{tags[key]}),
+		})
+	}
+
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: filters,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters: %w", err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, Secret{
+				Name:         aws.StringValue(param.Name),
+				Type:         aws.StringValue(param.Type),
+				Version:      aws.Int64Value(param.Version),
+				LastModified: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// GetSecretValue returns the decrypted value of a parameter.
+// ErrParameterNotFound is returned if the parameter does not exist.
+func (s *SSM) GetSecretValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		if isParameterNotFound(err) {
+			return "", &ErrParameterNotFound{name}
+		}
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// DeleteSecret deletes a parameter.
+// ErrParameterNotFound is returned if the parameter does not exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		if isParameterNotFound(err) {
+			return &ErrParameterNotFound{name}
+		}
+		return fmt.Errorf("delete parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+func isParameterNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == ssm.ErrCodeParameterNotFound
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,211 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ListSecrets(t *testing.T) {
+	lastModified := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecrets []Secret
+		wantedError   error
+	}{
+		"returns wrapped error if failed to describe parameters": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe parameters: some error"),
+		},
+		"returns the parameters of every page sorted by name": {
+			mockClient: func(m *mocks.Mockapi) {
+				filters := []*ssm.ParameterStringFilter{
+					{
+						Key:    aws.String("tag:copilot-application"),
+						Option: aws.String("Equals"),
+						Values: aws.StringSlice([]string{"myapp"}),
+					},
+					{
+						Key:    aws.String("tag:copilot-environment"),
+						Option: aws.String("Equals"),
+						Values: aws.StringSlice([]string{"myenv"}),
+					},
+				}
+				gomock.InOrder(
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: filters,
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/db-password"),
+								Type:             aws.String("SecureString"),
+								Version:          aws.Int64(2),
+								LastModifiedDate: aws.Time(lastModified),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: filters,
+						NextToken:        aws.String("token"),
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/api-key"),
+								Type:             aws.String("SecureString"),
+								Version:          aws.Int64(1),
+								LastModifiedDate: aws.Time(lastModified),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedSecrets: []Secret{
+				{
+					Name:         "/copilot/myapp/myenv/secrets/api-key",
+					Type:         "SecureString",
+					Version:      1,
+					LastModified: lastModified,
+				},
+				{
+					Name:         "/copilot/myapp/myenv/secrets/db-password",
+					Type:         "SecureString",
+					Version:      2,
+					LastModified: lastModified,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ListSecrets(map[string]string{
+				deploy.AppTagKey: "myapp",
+				deploy.EnvTagKey: "myenv",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecrets, got)
+			}
+		})
+	}
+}
+
+func TestSSM_GetSecretValue(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{name: mockName},
+		},
+		"returns wrapped error if failed to get the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+		"returns the decrypted value": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("super secure password"),
+					},
+				}, nil)
+			},
+			wantedValue: "super secure password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.GetSecretValue(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{name: mockName},
+		},
+		"returns wrapped error if failed to delete the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.DeleteSecret(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
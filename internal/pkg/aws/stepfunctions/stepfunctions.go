@@ -6,15 +6,44 @@ package stepfunctions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sfn"
 )
 
+// maxListExecutionsResults is the maximum number of executions that ListExecutions accepts to return in a page.
+const maxListExecutionsResults = 1000
+
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
 	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+}
+
+// Execution describes a state machine execution.
+type Execution struct {
+	ARN       string
+	Name      string
+	Status    string
+	StartDate time.Time
+	StopDate  time.Time // Zero if the execution is still running.
+	Error     string
+	Cause     string
+}
+
+// HistoryEvent is an event in the history of a state machine execution.
+type HistoryEvent struct {
+	ID        int64
+	Type      string
+	Timestamp time.Time
+	StateName string // Name of the state entered or exited, if any.
+	Output    string // Output of a submitted task or of an exited state, if any.
+	Error     string
+	Cause     string
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -41,13 +70,125 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 	return aws.StringValue(out.Definition), nil
 }
 
-//Execute starts a state machine execution.
-func (s *StepFunctions) Execute(arn string) error {
-	_, err := s.client.StartExecution(&sfn.StartExecutionInput{
+// Execute starts a state machine execution with the JSON input, if any, and returns the ARN of the execution.
+func (s *StepFunctions) Execute(arn, input string) (string, error) {
+	in := &sfn.StartExecutionInput{
 		StateMachineArn: aws.String(arn),
+	}
+	if input != "" {
+		in.Input = aws.String(input)
+	}
+	out, err := s.client.StartExecution(in)
+	if err != nil {
+		return "", fmt.Errorf("execute state machine %s: %w", arn, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// DescribeExecution returns the status of a state machine execution.
+func (s *StepFunctions) DescribeExecution(executionARN string) (*Execution, error) {
+	out, err := s.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
 	})
 	if err != nil {
-		return fmt.Errorf("execute state machine %s: %w", arn, err)
+		return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	return &Execution{
+		ARN:       aws.StringValue(out.ExecutionArn),
+		Name:      aws.StringValue(out.Name),
+		Status:    aws.StringValue(out.Status),
+		StartDate: aws.TimeValue(out.StartDate),
+		StopDate:  aws.TimeValue(out.StopDate),
+		Error:     aws.StringValue(out.Error),
+		Cause:     aws.StringValue(out.Cause),
+	}, nil
+}
+
+// Executions returns up to limit of the most recent executions of a state machine, starting with the latest one.
+// The error and cause of the executions are not set.
+func (s *StepFunctions) Executions(stateMachineARN string, limit int) ([]Execution, error) {
+	var executions []Execution
+	var nextToken *string
+	for {
+		maxResults := limit - len(executions)
+		if maxResults > maxListExecutionsResults {
+			maxResults = maxListExecutionsResults
+		}
+		out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(stateMachineARN),
+			MaxResults:      aws.Int64(int64(maxResults)),
+			NextToken:       nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		for _, item := range out.Executions {
+			executions = append(executions, Execution{
+				ARN:       aws.StringValue(item.ExecutionArn),
+				Name:      aws.StringValue(item.Name),
+				Status:    aws.StringValue(item.Status),
+				StartDate: aws.TimeValue(item.StartDate),
+				StopDate:  aws.TimeValue(item.StopDate),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil || len(executions) >= limit {
+			return executions, nil
+		}
+	}
+}
+
+// ExecutionHistory returns the events of a state machine execution in chronological order.
+func (s *StepFunctions) ExecutionHistory(executionARN string) ([]HistoryEvent, error) {
+	var events []HistoryEvent
+	var nextToken *string
+	for {
+		out, err := s.client.GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+			ExecutionArn: aws.String(executionARN),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get history of execution %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			events = append(events, historyEvent(event))
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			return events, nil
+		}
+	}
+}
+
+func historyEvent(in *sfn.HistoryEvent) HistoryEvent {
+	event := HistoryEvent{
+		ID:        aws.Int64Value(in.Id),
+		Type:      aws.StringValue(in.Type),
+		Timestamp: aws.TimeValue(in.Timestamp),
+	}
+	switch {
+	case in.StateEnteredEventDetails != nil:
+		event.StateName = aws.StringValue(in.StateEnteredEventDetails.Name)
+	case in.StateExitedEventDetails != nil:
+		event.StateName = aws.StringValue(in.StateExitedEventDetails.Name)
+		event.Output = aws.StringValue(in.StateExitedEventDetails.Output)
+	case in.TaskSubmittedEventDetails != nil:
+		event.Output = aws.StringValue(in.TaskSubmittedEventDetails.Output)
+	case in.TaskFailedEventDetails != nil:
+		event.Error = aws.StringValue(in.TaskFailedEventDetails.Error)
+		event.Cause = aws.StringValue(in.TaskFailedEventDetails.Cause)
+	case in.TaskTimedOutEventDetails != nil:
+		event.Error = aws.StringValue(in.TaskTimedOutEventDetails.Error)
+		event.Cause = aws.StringValue(in.TaskTimedOutEventDetails.Cause)
+	case in.ExecutionFailedEventDetails != nil:
+		event.Error = aws.StringValue(in.ExecutionFailedEventDetails.Error)
+		event.Cause = aws.StringValue(in.ExecutionFailedEventDetails.Cause)
+	case in.ExecutionTimedOutEventDetails != nil:
+		event.Error = aws.StringValue(in.ExecutionTimedOutEventDetails.Error)
+		event.Cause = aws.StringValue(in.ExecutionTimedOutEventDetails.Cause)
+	case in.ExecutionAbortedEventDetails != nil:
+		event.Error = aws.StringValue(in.ExecutionAbortedEventDetails.Error)
+		event.Cause = aws.StringValue(in.ExecutionAbortedEventDetails.Cause)
 	}
-	return nil
+	return event
 }
@@ -73,9 +73,11 @@ func TestStepFunctions_StateMachineDefinition(t *testing.T) {
 func TestStepFunctions_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inStateMachineARN string
+		inInput           string
 
 		mockStepFunctionsClient func(m *mocks.Mockapi)
 
+		wantedARN   string
 		wantedError error
 	}{
 
@@ -98,6 +100,20 @@ func TestStepFunctions_Execute(t *testing.T) {
 					StartDate:    func() *time.Time { t := time.Now(); return &t }(),
 				}, nil)
 			},
+			wantedARN: "forca barca",
+		},
+		"success with input": {
+			inStateMachineARN: "forca barca",
+			inInput:           `{"Overrides":{}}`,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("forca barca"),
+					Input:           aws.String(`{"Overrides":{}}`),
+				}).Return(&sfn.StartExecutionOutput{
+					ExecutionArn: aws.String("forca barca"),
+				}, nil)
+			},
+			wantedARN: "forca barca",
 		},
 	}
 
@@ -112,10 +128,270 @@ func TestStepFunctions_Execute(t *testing.T) {
 				client: mockStepFunctionsClient,
 			}
 
-			err := sfn.Execute(tc.inStateMachineARN)
+			arn, err := sfn.Execute(tc.inStateMachineARN, tc.inInput)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
+func TestStepFunctions_DescribeExecution(t *testing.T) {
+	mockStartDate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wanted      *Execution
+		wantedError error
+	}{
+		"fail to describe execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe execution mockExecutionARN: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+				}).Return(&sfn.DescribeExecutionOutput{
+					ExecutionArn: aws.String("mockExecutionARN"),
+					Status:       aws.String(sfn.ExecutionStatusFailed),
+					StartDate:    aws.Time(mockStartDate),
+					StopDate:     aws.Time(mockStartDate.Add(time.Minute)),
+					Error:        aws.String("States.TaskFailed"),
+					Cause:        aws.String("Essential container in task exited"),
+				}, nil)
+			},
+			wanted: &Execution{
+				ARN:       "mockExecutionARN",
+				Status:    sfn.ExecutionStatusFailed,
+				StartDate: mockStartDate,
+				StopDate:  mockStartDate.Add(time.Minute),
+				Error:     "States.TaskFailed",
+				Cause:     "Essential container in task exited",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			got, err := sfn.DescribeExecution("mockExecutionARN")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStepFunctions_Executions(t *testing.T) {
+	mockStartDate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockStopDate := mockStartDate.Add(time.Minute)
+	testCases := map[string]struct {
+		inLimit                 int
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wanted      []Execution
+		wantedError error
+	}{
+		"fail to list executions": {
+			inLimit: 10,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine mockStateMachineARN: some error"),
+		},
+		"caps the page size to the maximum accepted by the API": {
+			inLimit: 1500,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockStateMachineARN"),
+					MaxResults:      aws.Int64(1000),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("arn1"),
+							Name:         aws.String("exec1"),
+							Status:       aws.String(sfn.ExecutionStatusRunning),
+							StartDate:    aws.Time(mockStartDate),
+						},
+					},
+				}, nil)
+			},
+			wanted: []Execution{
+				{ARN: "arn1", Name: "exec1", Status: sfn.ExecutionStatusRunning, StartDate: mockStartDate},
+			},
+		},
+		"stops paginating once the limit is reached": {
+			inLimit: 3,
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+						StateMachineArn: aws.String("mockStateMachineARN"),
+						MaxResults:      aws.Int64(3),
+					}).Return(&sfn.ListExecutionsOutput{
+						Executions: []*sfn.ExecutionListItem{
+							{
+								ExecutionArn: aws.String("arn1"),
+								Name:         aws.String("exec1"),
+								Status:       aws.String(sfn.ExecutionStatusRunning),
+								StartDate:    aws.Time(mockStartDate),
+							},
+							{
+								ExecutionArn: aws.String("arn2"),
+								Name:         aws.String("exec2"),
+								Status:       aws.String(sfn.ExecutionStatusFailed),
+								StartDate:    aws.Time(mockStartDate),
+								StopDate:     aws.Time(mockStopDate),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+						StateMachineArn: aws.String("mockStateMachineARN"),
+						MaxResults:      aws.Int64(1),
+						NextToken:       aws.String("next"),
+					}).Return(&sfn.ListExecutionsOutput{
+						Executions: []*sfn.ExecutionListItem{
+							{
+								ExecutionArn: aws.String("arn3"),
+								Name:         aws.String("exec3"),
+								Status:       aws.String(sfn.ExecutionStatusSucceeded),
+								StartDate:    aws.Time(mockStartDate),
+								StopDate:     aws.Time(mockStopDate),
+							},
+						},
+						NextToken: aws.String("more"),
+					}, nil),
+				)
+			},
+			wanted: []Execution{
+				{ARN: "arn1", Name: "exec1", Status: sfn.ExecutionStatusRunning, StartDate: mockStartDate},
+				{ARN: "arn2", Name: "exec2", Status: sfn.ExecutionStatusFailed, StartDate: mockStartDate, StopDate: mockStopDate},
+				{ARN: "arn3", Name: "exec3", Status: sfn.ExecutionStatusSucceeded, StartDate: mockStartDate, StopDate: mockStopDate},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			got, err := sfn.Executions("mockStateMachineARN", tc.inLimit)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionHistory(t *testing.T) {
+	mockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wanted      []HistoryEvent
+		wantedError error
+	}{
+		"fail to get execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution mockExecutionARN: some error"),
+		},
+		"success across pages": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+						ExecutionArn: aws.String("mockExecutionARN"),
+					}).Return(&sfn.GetExecutionHistoryOutput{
+						Events: []*sfn.HistoryEvent{
+							{
+								Id:        aws.Int64(1),
+								Type:      aws.String(sfn.HistoryEventTypeTaskStateEntered),
+								Timestamp: aws.Time(mockTime),
+								StateEnteredEventDetails: &sfn.StateEnteredEventDetails{
+									Name: aws.String("Run Fargate Task"),
+								},
+							},
+						},
+						NextToken: aws.String("mockToken"),
+					}, nil),
+					m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+						ExecutionArn: aws.String("mockExecutionARN"),
+						NextToken:    aws.String("mockToken"),
+					}).Return(&sfn.GetExecutionHistoryOutput{
+						Events: []*sfn.HistoryEvent{
+							{
+								Id:        aws.Int64(2),
+								Type:      aws.String(sfn.HistoryEventTypeTaskFailed),
+								Timestamp: aws.Time(mockTime),
+								TaskFailedEventDetails: &sfn.TaskFailedEventDetails{
+									Error: aws.String("States.TaskFailed"),
+									Cause: aws.String("boom"),
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wanted: []HistoryEvent{
+				{
+					ID:        1,
+					Type:      sfn.HistoryEventTypeTaskStateEntered,
+					Timestamp: mockTime,
+					StateName: "Run Fargate Task",
+				},
+				{
+					ID:        2,
+					Type:      sfn.HistoryEventTypeTaskFailed,
+					Timestamp: mockTime,
+					Error:     "States.TaskFailed",
+					Cause:     "boom",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			got, err := sfn.ExecutionHistory("mockExecutionARN")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
 		})
 	}
 }
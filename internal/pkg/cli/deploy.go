@@ -4,14 +4,21 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/spf13/afero"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -31,24 +38,36 @@ import (
 const (
 	svcWkldType = "svc"
 	jobWkldType = "job"
+
+	maxParallelDeployments = 5 // Maximum number of workloads deployed at the same time.
 )
 
-type deployOpts struct {
+type deployVars struct {
 	deployWkldVars
 
-	deployWkld     actionCommand
-	setupDeployCmd func(*deployOpts, string)
+	workloadNames []string // Names of the workloads to deploy, multiple workloads are deployed following a deployment plan.
+	deployAll     bool     // Whether to deploy all the workloads in the workspace.
+}
+
+type deployOpts struct {
+	deployVars
 
-	sel    wsSelector
-	store  store
-	ws     wsWlDirReader
-	prompt prompter
+	deployWkld       actionCommand
+	newWkldDeployCmd func(vars deployWkldVars, workloadType string, output termprogress.FileWriter, diagnostics io.Writer) (actionCommand, error)
+
+	sel             wsSelector
+	store           store
+	ws              wsWlDirReader
+	prompt          prompter
+	unmarshal       func([]byte) (manifest.DynamicWorkload, error)
+	newInterpolator func(app, env string) interpolator
+	laneWriter      termprogress.FileWriter // Where the deployment lanes of multiple workloads are rendered.
 
 	// values for logging
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("deploy"))
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
@@ -61,54 +80,68 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	}
 	prompter := prompt.New()
 	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewLocalWorkloadSelector(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+		deployVars:      vars,
+		store:           store,
+		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
+		ws:              ws,
+		prompt:          prompter,
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		laneWriter:      os.Stderr,
 
-		setupDeployCmd: func(o *deployOpts, workloadType string) {
+		newWkldDeployCmd: func(vars deployWkldVars, workloadType string, output termprogress.FileWriter, diagnostics io.Writer) (actionCommand, error) {
 			switch {
 			case contains(workloadType, manifestinfo.JobTypes()):
 				opts := &deployJobOpts{
-					deployWkldVars: o.deployWkldVars,
+					deployWkldVars: vars,
 
-					store:           o.store,
-					ws:              o.ws,
+					store:           store,
+					ws:              ws,
 					newInterpolator: newManifestInterpolator,
 					unmarshal:       manifest.UnmarshalWorkload,
-					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
+					sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
+					output:          output,
+					diagnostics:     diagnostics,
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
 				}
-				o.deployWkld = opts
+				return opts, nil
 			case contains(workloadType, manifestinfo.ServiceTypes()):
 				opts := &deploySvcOpts{
-					deployWkldVars: o.deployWkldVars,
+					deployWkldVars: vars,
 
-					store:           o.store,
-					ws:              o.ws,
+					store:           store,
+					ws:              ws,
 					newInterpolator: newManifestInterpolator,
 					unmarshal:       manifest.UnmarshalWorkload,
 					spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
-					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
-					prompt:          o.prompt,
+					sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
+					prompt:          prompter,
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
+					output:          output,
+					diagnostics:     diagnostics,
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
 					return newSvcDeployer(opts)
 				}
-				o.deployWkld = opts
+				return opts, nil
 			}
+			return nil, fmt.Errorf("unrecognized workload type %q", workloadType)
 		},
 	}, nil
 }
 
 func (o *deployOpts) Run() error {
+	if o.deployAll || len(o.workloadNames) > 1 {
+		return o.runMultiple()
+	}
+	if len(o.workloadNames) == 1 {
+		o.name = o.workloadNames[0]
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -154,18 +187,206 @@ func (o *deployOpts) loadWkldCmd() error {
 	if err != nil {
 		return fmt.Errorf("retrieve %s from application %s: %w", o.appName, o.name, err)
 	}
-	o.setupDeployCmd(o, wl.Type)
-	if strings.Contains(strings.ToLower(wl.Type), jobWkldType) {
-		o.wlType = jobWkldType
+	cmd, err := o.newWkldDeployCmd(o.deployWkldVars, wl.Type, nil, nil)
+	if err != nil {
+		return fmt.Errorf("deploy %s: %w", o.name, err)
+	}
+	o.deployWkld = cmd
+	o.wlType = wkldType(wl.Type)
+	return nil
+}
+
+func wkldType(workloadType string) string {
+	if strings.Contains(strings.ToLower(workloadType), jobWkldType) {
+		return jobWkldType
+	}
+	return svcWkldType
+}
+
+// runMultiple deploys several workloads to an environment.
+// Workloads that don't depend on each other are deployed in parallel, and each one is rendered in its own lane.
+func (o *deployOpts) runMultiple() error {
+	names, err := o.listWorkloadNames()
+	if err != nil {
+		return err
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	cmds := make(map[string]actionCommand, len(names))
+	mfts := make(map[string]any, len(names))
+	logs := make(map[string]*bytes.Buffer, len(names))
+	for _, name := range names {
+		logs[name] = &bytes.Buffer{}
+		cmd, err := o.loadWkldCmdFor(name, logs[name])
+		if err != nil {
+			return err
+		}
+		mft, err := o.envManifest(name)
+		if err != nil {
+			return err
+		}
+		cmds[name] = cmd
+		mfts[name] = mft
+	}
+	plan, err := newDeploymentPlan(names, mfts)
+	if err != nil {
+		return err
+	}
+
+	deployed, deployErr := o.executeDeploymentPlan(plan, cmds)
+	// The messages of each deployment are held until the lanes are done rendering, otherwise they garble the lanes.
+	for _, name := range plan.workloads() {
+		_, _ = logs[name].WriteTo(log.DiagnosticWriter)
+	}
+	for _, name := range plan.workloads() {
+		if !deployed[name] {
+			continue
+		}
+		if err := cmds[name].RecommendActions(); err != nil {
+			return err
+		}
+	}
+	return deployErr
+}
+
+func (o *deployOpts) listWorkloadNames() ([]string, error) {
+	if !o.deployAll {
+		return o.workloadNames, nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list services and jobs in the workspace: %w", err)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no services or jobs found in the workspace")
+	}
+	return names, nil
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
 		return nil
 	}
-	o.wlType = svcWkldType
+	name, err := o.sel.Environment("Select an environment", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
 	return nil
 }
 
+// loadWkldCmdFor returns the validated deploy command of a workload without deploying it.
+// The messages of the deployment are written to diagnostics.
+func (o *deployOpts) loadWkldCmdFor(name string, diagnostics io.Writer) (actionCommand, error) {
+	wl, err := o.store.GetWorkload(o.appName, name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve %s from application %s: %w", name, o.appName, err)
+	}
+	vars := o.deployWkldVars
+	vars.name = name
+	// The progress of each deployment is replaced by its lane.
+	cmd, err := o.newWkldDeployCmd(vars, wl.Type, discardFile{}, diagnostics)
+	if err != nil {
+		return nil, fmt.Errorf("deploy %s: %w", name, err)
+	}
+	if err := cmd.Ask(); err != nil {
+		return nil, fmt.Errorf("ask %s deploy of %s: %w", wkldType(wl.Type), name, err)
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validate %s deploy of %s: %w", wkldType(wl.Type), name, err)
+	}
+	return cmd, nil
+}
+
+// envManifest returns the manifest of a workload with the environment overrides applied.
+func (o *deployOpts) envManifest(name string) (any, error) {
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.envName).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override to manifest for %s: %w", o.envName, name, err)
+	}
+	return envMft.Manifest(), nil
+}
+
+// executeDeploymentPlan deploys the workloads stage by stage while rendering a lane per workload.
+// A workload is skipped if one of its dependencies wasn't deployed.
+// It returns the names of the deployed workloads and an error if any workload wasn't deployed.
+func (o *deployOpts) executeDeploymentPlan(plan *deploymentPlan, cmds map[string]actionCommand) (map[string]bool, error) {
+	lanes := termprogress.NewLaneRenderer(plan.workloads(), termprogress.RenderOptions{})
+	deployed := make(map[string]bool)
+	var errs []error
+	g, ctx := errgroup.WithContext(context.Background())
+	g.Go(func() error {
+		_, err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(o.laneWriter), lanes)
+		return err
+	})
+	g.Go(func() error {
+		defer lanes.Close()
+		var mu sync.Mutex
+		for _, stage := range plan.stages {
+			stageGroup := new(errgroup.Group)
+			stageGroup.SetLimit(maxParallelDeployments)
+			for _, name := range stage {
+				name := name
+				mu.Lock()
+				dependency, skip := undeployedDependency(plan.dependencies[name], deployed)
+				if skip {
+					lanes.Skip(name, fmt.Sprintf("%s was not deployed", dependency))
+					errs = append(errs, fmt.Errorf("skip deployment of %s: dependency %s was not deployed", name, dependency))
+				}
+				mu.Unlock()
+				if skip {
+					continue
+				}
+				stageGroup.Go(func() error {
+					lanes.Start(name)
+					err := cmds[name].Execute()
+					mu.Lock()
+					defer mu.Unlock()
+					if err != nil {
+						lanes.Fail(name, err.Error())
+						errs = append(errs, fmt.Errorf("deploy %s: %w", name, err))
+						return nil
+					}
+					lanes.Succeed(name)
+					deployed[name] = true
+					return nil
+				})
+			}
+			_ = stageGroup.Wait() // Deployments never return an error so that the other workloads of the stage complete.
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("render deployments: %w", err)
+	}
+	return deployed, errors.Join(errs...)
+}
+
+func undeployedDependency(dependencies []string, deployed map[string]bool) (string, bool) {
+	for _, dependency := range dependencies {
+		if !deployed[dependency] {
+			return dependency, true
+		}
+	}
+	return "", false
+}
+
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
@@ -174,7 +395,11 @@ func BuildDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys the services "api" and "frontend" to a "test" environment in order of their dependencies.
+  /code $ copilot deploy --name api,frontend --env test
+  Deploys all the services and jobs in the workspace to a "test" environment.
+  /code $ copilot deploy --all --env test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeployOpts(vars)
 			if err != nil {
@@ -187,12 +412,14 @@ func BuildDeployCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.workloadNames, nameFlag, nameFlagShort, nil, workloadsFlagDescription)
+	cmd.Flags().BoolVar(&vars.deployAll, allFlag, false, deployAllFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(nameFlag, allFlag)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -80,12 +80,21 @@ func (d *backendSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
+	if err := d.deploy(in, *stackConfigOutput); err != nil {
 		return nil, err
 	}
 	return noopActionRecommender{}, nil
 }
 
+// PreviewChangeSet returns the resource changes that deploying the backend service would apply, without executing them.
+func (d *backendSvcDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(stackConfigOutput.conf)
+}
+
 func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -0,0 +1,141 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+)
+
+// Display settings for the change set table.
+const (
+	minCellWidth           = 10 // minimum number of characters in a table's cell.
+	tabWidth               = 4  // number of characters in between columns.
+	cellPaddingWidth       = 2  // number of padding characters added by default to a cell.
+	paddingChar            = ' '
+	noAdditionalFormatting = 0
+)
+
+// DeployDiff returns the stringified diff of the template against the deployed template of the workload.
+// If the workload is not deployed yet, every section of the template is reported as an insertion.
+func (d *workloadDeployer) DeployDiff(template string) (string, error) {
+	tmpl, err := d.deployer.WorkloadTemplate(d.app.Name, d.env.Name, d.name)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return "", fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+		}
+		tmpl = ""
+	}
+	return diffTemplates(tmpl, template)
+}
+
+// DeployDiff returns the stringified diff of the template against the deployed template of the environment.
+func (d *envDeployer) DeployDiff(template string) (string, error) {
+	tmpl, err := d.envDeployer.EnvironmentTemplate(d.app.Name, d.env.Name)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return "", fmt.Errorf("retrieve the deployed template for %q: %w", d.env.Name, err)
+		}
+		tmpl = ""
+	}
+	return diffTemplates(tmpl, template)
+}
+
+func diffTemplates(from, to string) (string, error) {
+	tree, err := diff.From(from).Parse([]byte(to))
+	if err != nil {
+		return "", fmt.Errorf("parse the diff against the deployed template: %w", err)
+	}
+	buf := new(strings.Builder)
+	if err := tree.Write(buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// previewChangeSet returns the resource changes that deploying the workload stack would apply.
+// If the stack is not deployed yet, there is no change set to preview and an empty string is returned.
+func (d *workloadDeployer) previewChangeSet(conf cloudformation.StackConfiguration) (string, error) {
+	descr, err := d.deployer.PreviewService(conf, d.resources.S3Bucket, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("preview changes for %q: %w", d.name, err)
+	}
+	return renderChangeSet(descr), nil
+}
+
+// PreviewChangeSet returns the resource changes that deploying the environment stack would apply.
+func (d *envDeployer) PreviewChangeSet(in *DeployEnvironmentInput) (string, error) {
+	stackInput, err := d.buildStackInput(in)
+	if err != nil {
+		return "", err
+	}
+	oldParams, err := d.envDeployer.DeployedEnvironmentParameters(d.app.Name, d.env.Name)
+	if err != nil {
+		return "", fmt.Errorf("describe environment stack parameters: %w", err)
+	}
+	lastForceUpdateID, err := d.envDeployer.ForceUpdateOutputID(d.app.Name, d.env.Name)
+	if err != nil {
+		return "", fmt.Errorf("retrieve environment stack force update ID: %w", err)
+	}
+	stack, err := d.newStack(stackInput, lastForceUpdateID, oldParams)
+	if err != nil {
+		return "", err
+	}
+	descr, err := d.envDeployer.PreviewEnvironment(stack, stackInput.ArtifactBucketARN, awscloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("preview changes for environment %q: %w", d.env.Name, err)
+	}
+	return renderChangeSet(descr), nil
+}
+
+// renderChangeSet returns a table of the resource changes in the change set, or an empty string if there are none.
+func renderChangeSet(descr *awscloudformation.ChangeSetDescription) string {
+	if descr == nil || len(descr.Changes) == 0 {
+		return ""
+	}
+	buf := new(strings.Builder)
+	writer := tabwriter.NewWriter(buf, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Action", "Logical ID", "Type", "Replacement"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, change := range descr.Changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		replacement := aws.StringValue(rc.Replacement)
+		if replacement == "" {
+			replacement = "-"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", aws.StringValue(rc.Action), aws.StringValue(rc.LogicalResourceId),
+			aws.StringValue(rc.ResourceType), replacement)
+	}
+	writer.Flush()
+	return buf.String()
+}
+
+func underline(headings []string) []string {
+	var lines []string
+	for _, heading := range headings {
+		lines = append(lines, strings.Repeat("-", len(heading)))
+	}
+	return lines
+}
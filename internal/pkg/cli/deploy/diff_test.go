@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	cfnmocks "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDeployer_DeployDiff(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate  string
+		setUpMocks  func(m *mocks.MockserviceDeployer)
+		wanted      string
+		wantedError error
+	}{
+		"error getting the deployed template": {
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New(`retrieve the deployed template for "mockSvc": some error`),
+		},
+		"error parsing the diff": {
+			inTemplate: "Resources: [",
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources: {}", nil)
+			},
+			wantedError: errors.New("parse the diff against the deployed template: unmarshal new template: yaml: line 1: did not find expected node content"),
+		},
+		"reports every section as an insertion if the stack is not deployed": {
+			inTemplate: "Resources:\n  Queue:\n    Type: AWS::SQS::Queue",
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("", &cloudformation.ErrStackNotFound{})
+			},
+			wanted: `+ Resources:
+    Queue:
+      Type: AWS::SQS::Queue
+`,
+		},
+		"reports the differences against the deployed template": {
+			inTemplate: "Resources:\n  Queue:\n    Type: AWS::SQS::Queue",
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources:\n  Topic:\n    Type: AWS::SNS::Topic", nil)
+			},
+			wanted: `~ Resources:
+    + Queue:
+        Type: AWS::SQS::Queue
+    - Topic:
+        Type: AWS::SNS::Topic
+`,
+		},
+		"returns an empty string if the templates are identical": {
+			inTemplate: "Resources: {}",
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().WorkloadTemplate("mockApp", "mockEnv", "mockSvc").Return("Resources: {}", nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceDeployer(ctrl)
+			tc.setUpMocks(m)
+			d := &workloadDeployer{
+				name:     "mockSvc",
+				app:      &config.Application{Name: "mockApp"},
+				env:      &config.Environment{Name: "mockEnv"},
+				deployer: m,
+			}
+
+			got, err := d.DeployDiff(tc.inTemplate)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestEnvDeployer_DeployDiff(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate  string
+		setUpMocks  func(m *mocks.MockenvironmentDeployer)
+		wanted      string
+		wantedError error
+	}{
+		"error getting the deployed template": {
+			setUpMocks: func(m *mocks.MockenvironmentDeployer) {
+				m.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New(`retrieve the deployed template for "mockEnv": some error`),
+		},
+		"reports the differences against the deployed template": {
+			inTemplate: "Outputs:\n  VpcId:\n    Value: !Ref VPC",
+			setUpMocks: func(m *mocks.MockenvironmentDeployer) {
+				m.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return("Outputs:\n  VpcId:\n    Value:\n      Ref: DefaultVPC", nil)
+			},
+			wanted: `~ Outputs:
+    ~ VpcId:
+        ~ Value:
+            ~ Ref: DefaultVPC -> VPC
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvironmentDeployer(ctrl)
+			tc.setUpMocks(m)
+			d := &envDeployer{
+				app:         &config.Application{Name: "mockApp"},
+				env:         &config.Environment{Name: "mockEnv"},
+				envDeployer: m,
+			}
+
+			got, err := d.DeployDiff(tc.inTemplate)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestWorkloadDeployer_previewChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMocks  func(m *mocks.MockserviceDeployer)
+		wanted      string
+		wantedError error
+	}{
+		"error previewing the change set": {
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().PreviewService(gomock.Any(), "mockBucket", gomock.Len(1)).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`preview changes for "mockSvc": some error`),
+		},
+		"returns an empty string if the stack is not deployed": {
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().PreviewService(gomock.Any(), "mockBucket", gomock.Len(1)).Return(nil, &cloudformation.ErrStackNotFound{})
+			},
+		},
+		"returns an empty string if there are no changes": {
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().PreviewService(gomock.Any(), "mockBucket", gomock.Len(1)).Return(&cloudformation.ChangeSetDescription{}, nil)
+			},
+		},
+		"renders the resource changes": {
+			setUpMocks: func(m *mocks.MockserviceDeployer) {
+				m.EXPECT().PreviewService(gomock.Any(), "mockBucket", gomock.Len(1)).Return(&cloudformation.ChangeSetDescription{
+					Changes: []*awscfn.Change{
+						{
+							ResourceChange: &awscfn.ResourceChange{
+								Action:            aws.String(awscfn.ChangeActionModify),
+								LogicalResourceId: aws.String("Service"),
+								ResourceType:      aws.String("AWS::ECS::Service"),
+								Replacement:       aws.String(awscfn.ReplacementFalse),
+							},
+						},
+						{
+							ResourceChange: &awscfn.ResourceChange{
+								Action:            aws.String(awscfn.ChangeActionAdd),
+								LogicalResourceId: aws.String("Queue"),
+								ResourceType:      aws.String("AWS::SQS::Queue"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: `Action    Logical ID  Type               Replacement
+------    ----------  ----               -----------
+Modify    Service     AWS::ECS::Service  False
+Add       Queue       AWS::SQS::Queue    -
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceDeployer(ctrl)
+			tc.setUpMocks(m)
+			d := &workloadDeployer{
+				name:      "mockSvc",
+				app:       &config.Application{Name: "mockApp"},
+				env:       &config.Environment{Name: "mockEnv", ExecutionRoleARN: "mockRoleARN"},
+				resources: &stack.AppRegionalResources{S3Bucket: "mockBucket"},
+				deployer:  m,
+			}
+
+			got, err := d.previewChangeSet(cfnmocks.NewMockStackConfiguration(ctrl))
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
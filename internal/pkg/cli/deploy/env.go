@@ -52,6 +52,8 @@ type appResourcesGetter interface {
 
 type environmentDeployer interface {
 	UpdateAndRenderEnvironment(conf deploycfn.StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) error
+	PreviewEnvironment(conf deploycfn.StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) (*cloudformation.ChangeSetDescription, error)
+	EnvironmentTemplate(appName, envName string) (string, error)
 	DeployedEnvironmentParameters(app, env string) ([]*awscfn.Parameter, error)
 	ForceUpdateOutputID(app, env string) (string, error)
 }
@@ -88,6 +88,15 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	return noopActionRecommender{}, nil
 }
 
+// PreviewChangeSet returns the resource changes that deploying the job would apply, without executing them.
+func (d *jobDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(stackConfigOutput.conf)
+}
+
 type jobStackConfigurationOutput struct {
 	conf cloudformation.StackConfiguration
 }
@@ -133,12 +133,21 @@ func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecomm
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
+	if err := d.deploy(in, *stackConfigOutput); err != nil {
 		return nil, err
 	}
 	return noopActionRecommender{}, nil
 }
 
+// PreviewChangeSet returns the resource changes that deploying the load balanced web service would apply, without executing them.
+func (d *lbWebSvcDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(stackConfigOutput.conf)
+}
+
 func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -236,12 +245,12 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 	}
 	if d.app.Domain != "" {
 		if err := validateAppVersionForAlias(d.app.Name, d.appVersionGetter); err != nil {
-			logAppVersionOutdatedError(aws.StringValue(d.lbMft.Name))
+			logAppVersionOutdatedError(d.logger(), aws.StringValue(d.lbMft.Name))
 			return err
 		}
-		return validateLBWSAlias(d.lbMft.RoutingRule.Alias, d.app, d.env.Name)
+		return validateLBWSAlias(d.logger(), d.lbMft.RoutingRule.Alias, d.app, d.env.Name)
 	}
-	log.Errorf(ecsALBAliasUsedWithoutDomainFriendlyText)
+	d.logger().Errorf(ecsALBAliasUsedWithoutDomainFriendlyText)
 	return fmt.Errorf("cannot specify http.alias when application is not associated with a domain and env %s doesn't import one or more certificates", d.env.Name)
 }
 
@@ -255,17 +264,17 @@ func (d *lbWebSvcDeployer) validateNLBRuntime() error {
 		return fmt.Errorf("cannot specify nlb.alias when env %s imports one or more certificates", d.env.Name)
 	}
 	if d.app.Domain == "" {
-		log.Errorf(ecsNLBAliasUsedWithoutDomainFriendlyText)
+		d.logger().Errorf(ecsNLBAliasUsedWithoutDomainFriendlyText)
 		return fmt.Errorf("cannot specify nlb.alias when application is not associated with a domain")
 	}
 	if err := validateAppVersionForAlias(d.app.Name, d.appVersionGetter); err != nil {
-		logAppVersionOutdatedError(aws.StringValue(d.lbMft.Name))
+		logAppVersionOutdatedError(d.logger(), aws.StringValue(d.lbMft.Name))
 		return err
 	}
-	return validateLBWSAlias(d.lbMft.NLBConfig.Aliases, d.app, d.env.Name)
+	return validateLBWSAlias(d.logger(), d.lbMft.NLBConfig.Aliases, d.app, d.env.Name)
 }
 
-func validateLBWSAlias(aliases manifest.Alias, app *config.Application, envName string) error {
+func validateLBWSAlias(logger *log.Logger, aliases manifest.Alias, app *config.Application, envName string) error {
 	if aliases.IsEmpty() {
 		return nil
 	}
@@ -296,7 +305,7 @@ func validateLBWSAlias(aliases manifest.Alias, app *config.Application, envName
 		if validAlias {
 			continue
 		}
-		log.Errorf(`%s must match one of the following patterns:
+		logger.Errorf(`%s must match one of the following patterns:
 - %s.%s.%s,
 - <name>.%s.%s.%s,
 - %s.%s,
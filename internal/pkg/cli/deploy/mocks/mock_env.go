@@ -217,6 +217,21 @@ func (mr *MockenvironmentDeployerMockRecorder) DeployedEnvironmentParameters(app
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedEnvironmentParameters", reflect.TypeOf((*MockenvironmentDeployer)(nil).DeployedEnvironmentParameters), app, env)
 }
 
+// EnvironmentTemplate mocks base method.
+func (m *MockenvironmentDeployer) EnvironmentTemplate(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentTemplate), appName, envName)
+}
+
 // ForceUpdateOutputID mocks base method.
 func (m *MockenvironmentDeployer) ForceUpdateOutputID(app, env string) (string, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +247,26 @@ func (mr *MockenvironmentDeployerMockRecorder) ForceUpdateOutputID(app, env inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUpdateOutputID", reflect.TypeOf((*MockenvironmentDeployer)(nil).ForceUpdateOutputID), app, env)
 }
 
+// PreviewEnvironment mocks base method.
+func (m *MockenvironmentDeployer) PreviewEnvironment(conf cloudformation1.StackConfiguration, bucketARN string, opts ...cloudformation0.StackOption) (*cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketARN}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewEnvironment", varargs...)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewEnvironment indicates an expected call of PreviewEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) PreviewEnvironment(conf, bucketARN interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketARN}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).PreviewEnvironment), varargs...)
+}
+
 // UpdateAndRenderEnvironment mocks base method.
 func (m *MockenvironmentDeployer) UpdateAndRenderEnvironment(conf cloudformation1.StackConfiguration, bucketARN string, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	revision "github.com/aws/copilot-cli/internal/pkg/deploy/revision"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -176,3 +177,40 @@ func (mr *MockaliasCertValidatorMockRecorder) ValidateCertAliases(aliases, certs
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertAliases", reflect.TypeOf((*MockaliasCertValidator)(nil).ValidateCertAliases), aliases, certs)
 }
+
+// MockrevisionRecorder is a mock of revisionRecorder interface.
+type MockrevisionRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevisionRecorderMockRecorder
+}
+
+// MockrevisionRecorderMockRecorder is the mock recorder for MockrevisionRecorder.
+type MockrevisionRecorderMockRecorder struct {
+	mock *MockrevisionRecorder
+}
+
+// NewMockrevisionRecorder creates a new mock instance.
+func NewMockrevisionRecorder(ctrl *gomock.Controller) *MockrevisionRecorder {
+	mock := &MockrevisionRecorder{ctrl: ctrl}
+	mock.recorder = &MockrevisionRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevisionRecorder) EXPECT() *MockrevisionRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockrevisionRecorder) Record(env, workload string, rev *revision.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", env, workload, rev)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockrevisionRecorderMockRecorder) Record(env, workload, rev interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockrevisionRecorder)(nil).Record), env, workload, rev)
+}
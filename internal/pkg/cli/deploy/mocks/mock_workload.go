@@ -328,6 +328,41 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(conf, bucketName interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// PreviewService mocks base method.
+func (m *MockserviceDeployer) PreviewService(conf cloudformation0.StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (*cloudformation.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewService", varargs...)
+	ret0, _ := ret[0].(*cloudformation.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewService indicates an expected call of PreviewService.
+func (mr *MockserviceDeployerMockRecorder) PreviewService(conf, bucketName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewService", reflect.TypeOf((*MockserviceDeployer)(nil).PreviewService), varargs...)
+}
+
+// WorkloadTemplate mocks base method.
+func (m *MockserviceDeployer) WorkloadTemplate(app, env, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadTemplate", app, env, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadTemplate indicates an expected call of WorkloadTemplate.
+func (mr *MockserviceDeployerMockRecorder) WorkloadTemplate(app, env, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadTemplate", reflect.TypeOf((*MockserviceDeployer)(nil).WorkloadTemplate), app, env, name)
+}
+
 // Mockspinner is a mock of spinner interface.
 type Mockspinner struct {
 	ctrl     *gomock.Controller
@@ -6,9 +6,9 @@ package deploy
 import (
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/aws/copilot-cli/internal/pkg/override"
-	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/afero"
 )
 
@@ -24,7 +24,9 @@ type UserAgentAdder interface {
 
 // NewOverrider looks up if a CDK or YAMLPatch Overrider exists at pathsToOverriderDir and initializes the respective Overrider.
 // If the directory is empty, then returns a noop Overrider.
-func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAgentAdder) (Overrider, error) {
+// Out-of-band info from the CDK sub-commands is written to execWriter, which shouldn't be stdout as users expect stdout to only
+// contain the final override output.
+func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAgentAdder, execWriter io.Writer) (Overrider, error) {
 	info, err := override.Lookup(pathToOverridesDir, fs)
 	if err != nil {
 		var errNotExist *override.ErrNotExist
@@ -37,9 +39,7 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 	case info.IsCDK():
 		sess.UserAgentExtras("override cdk")
 		return override.WithCDK(pathToOverridesDir, override.CDKOpts{
-			// Write out-of-band info from sub-commands to stderr as users expect stdout to only
-			// contain the final override output.
-			ExecWriter: log.DiagnosticWriter,
+			ExecWriter: execWriter,
 			FS:         fs,
 			EnvVars: map[string]string{
 				"COPILOT_APPLICATION_NAME": app,
@@ -48,7 +48,9 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 		}), nil
 	case info.IsYAMLPatch():
 		sess.UserAgentExtras("override yamlpatch")
-		return new(override.Noop), nil
+		return override.WithYAMLPatch(info.Path(), override.YAMLPatchOpts{
+			FS: fs,
+		}), nil
 	default:
 		return new(override.Noop), nil
 	}
@@ -4,6 +4,7 @@
 package deploy
 
 import (
+	"io"
 	"path/filepath"
 	"testing"
 
@@ -27,7 +28,7 @@ func TestNewOverrider(t *testing.T) {
 		fs := afero.NewMemMapFs()
 
 		// WHEN
-		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, new(mockSessProvider))
+		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, new(mockSessProvider), io.Discard)
 
 		// THEN
 		require.NoError(t, err)
@@ -40,7 +41,7 @@ func TestNewOverrider(t *testing.T) {
 		_ = fs.MkdirAll("overrides", 0755)
 
 		// WHEN
-		_, err := NewOverrider("overrides", "demo", "test", fs, new(mockSessProvider))
+		_, err := NewOverrider("overrides", "demo", "test", fs, new(mockSessProvider), io.Discard)
 
 		// THEN
 		require.ErrorContains(t, err, `look up overrider at "overrides":`)
@@ -55,7 +56,7 @@ func TestNewOverrider(t *testing.T) {
 		sess := new(mockSessProvider)
 
 		// WHEN
-		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, sess)
+		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, sess, io.Discard)
 
 		// THEN
 		require.NoError(t, err)
@@ -63,4 +64,20 @@ func TestNewOverrider(t *testing.T) {
 		require.True(t, ok)
 		require.Contains(t, sess.UserAgent, "override cdk")
 	})
+	t.Run("should initialize a YAML patch overrider", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = fs.MkdirAll("overrides", 0755)
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "cfn.patches.yml"), []byte("- {op: remove, path: /Resources}"), 0755)
+		sess := new(mockSessProvider)
+
+		// WHEN
+		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, sess, io.Discard)
+
+		// THEN
+		require.NoError(t, err)
+		_, ok := ovrdr.(*override.YAMLPatch)
+		require.True(t, ok)
+		require.Contains(t, sess.UserAgent, "override yamlpatch")
+	})
 }
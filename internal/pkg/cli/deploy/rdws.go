@@ -111,7 +111,7 @@ func (d *rdwsDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommende
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, stackConfigOutput.svcStackConfigurationOutput); err != nil {
+	if err := d.deploy(in, stackConfigOutput.svcStackConfigurationOutput); err != nil {
 		return nil, err
 	}
 	return &rdwsDeployOutput{
@@ -124,6 +124,15 @@ type rdwsStackConfigurationOutput struct {
 	rdSvcAlias string
 }
 
+// PreviewChangeSet returns the resource changes that deploying the request-driven web service would apply, without executing them.
+func (d *rdwsDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(stackConfigOutput.conf)
+}
+
 func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -131,7 +140,7 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	}
 
 	if d.app.Domain == "" && d.rdwsMft.Alias != nil {
-		log.Errorf(rdwsAliasUsedWithoutDomainFriendlyText)
+		d.logger().Errorf(rdwsAliasUsedWithoutDomainFriendlyText)
 		return nil, errors.New("alias specified when application is not associated with a domain")
 	}
 
@@ -170,7 +179,7 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 		}, nil
 	}
 
-	if err = validateRDSvcAliasAndAppVersion(d.name,
+	if err = validateRDSvcAliasAndAppVersion(d.logger(), d.name,
 		aws.StringValue(d.rdwsMft.Alias), d.env.Name, d.app, d.appVersionGetter); err != nil {
 		return nil, err
 	}
@@ -185,12 +194,12 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 	}, nil
 }
 
-func validateRDSvcAliasAndAppVersion(svcName, alias, envName string, app *config.Application, appVersionGetter versionGetter) error {
+func validateRDSvcAliasAndAppVersion(logger *log.Logger, svcName, alias, envName string, app *config.Application, appVersionGetter versionGetter) error {
 	if alias == "" {
 		return nil
 	}
 	if err := validateAppVersionForAlias(app.Name, appVersionGetter); err != nil {
-		logAppVersionOutdatedError(svcName)
+		logAppVersionOutdatedError(logger, svcName)
 		return err
 	}
 	// Alias should be within root hosted zone.
@@ -198,7 +207,7 @@ func validateRDSvcAliasAndAppVersion(svcName, alias, envName string, app *config
 Where <subdomain> cannot be the application name.
 `, color.HighlightUserInput(alias), color.HighlightCode("http.alias"), app.Domain)
 	if err := checkUnsupportedRDSvcAlias(alias, envName, app); err != nil {
-		log.Errorf(aliasInvalidLog)
+		logger.Errorf(aliasInvalidLog)
 		return err
 	}
 
@@ -212,7 +221,7 @@ Where <subdomain> cannot be the application name.
 		return nil
 	}
 
-	log.Errorf(aliasInvalidLog)
+	logger.Errorf(aliasInvalidLog)
 	return fmt.Errorf("alias is not supported in hosted zones that are not managed by Copilot")
 }
 
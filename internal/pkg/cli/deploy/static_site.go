@@ -17,15 +17,33 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/spf13/afero"
 )
 
+const (
+	fmtSyncStaticAssetsStart    = "Syncing static assets of %s"
+	fmtSyncStaticAssetsFailed   = "Failed to sync static assets of %s.\n"
+	fmtSyncStaticAssetsComplete = "Synced static assets of %s: %d uploaded, %d unchanged, %d deleted.\n"
+)
+
+type objectSyncer interface {
+	UploadWithMetadata(bucket, key string, data io.Reader, metadata s3.ObjectMetadata) (string, error)
+	ListObjects(bucket, prefix string) ([]s3.Object, error)
+	DeleteObjects(bucket string, keys []string) error
+	Metadata(bucket, key string) (s3.ObjectMetadata, error)
+	GetObject(bucket, key string) ([]byte, error)
+}
+
 type staticSiteDeployer struct {
 	*svcDeployer
 	staticSiteMft *manifest.StaticSite
 	bucketName    string
 	fs            afero.Fs
-	uploadFn      func(fs afero.Fs, source, destination string, opts *asset.UploadOpts) ([]string, error)
+	s3            objectSyncer
+	syncFn        func(fs afero.Fs, mappings []asset.SyncMapping, opts *asset.SyncOpts) (*asset.SyncOutput, error)
 }
 
 // NewStaticSiteDeployer is the constructor for staticSiteDeployer.
@@ -44,7 +62,8 @@ func NewStaticSiteDeployer(in *WorkloadDeployerInput) (*staticSiteDeployer, erro
 		staticSiteMft: mft,
 		fs:            afero.NewOsFs(),
 		bucketName:    svcDeployer.resources.S3Bucket,
-		uploadFn:      asset.Upload,
+		s3:            s3.New(svcDeployer.envSess),
+		syncFn:        asset.Sync,
 	}, nil
 }
 
@@ -68,7 +87,7 @@ func (d *staticSiteDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFor
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(conf)
+	return d.generateCloudFormationTemplate(cloudformation.WrapWithTemplateOverrider(conf, d.overrider))
 }
 
 // DeployWorkload deploys a static site service using CloudFormation.
@@ -77,7 +96,7 @@ func (d *staticSiteDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, svcStackConfigurationOutput{
+	if err := d.deploy(in, svcStackConfigurationOutput{
 		conf: cloudformation.WrapWithTemplateOverrider(conf, d.overrider),
 	}); err != nil {
 		return nil, err
@@ -85,24 +104,94 @@ func (d *staticSiteDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	return noopActionRecommender{}, nil
 }
 
-// UploadArtifacts uploads static assets to the app stackset bucket.
+// PreviewChangeSet returns the resource changes that deploying the static site would apply, without executing them.
+func (d *staticSiteDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	conf, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(cloudformation.WrapWithTemplateOverrider(conf, d.overrider))
+}
+
+// UploadArtifacts uploads the static assets that changed since the last deployment to the app stackset bucket.
 func (d *staticSiteDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
-	for _, f := range d.staticSiteMft.FileUploads {
-		if _, err := d.uploadFn(d.fs, filepath.Join(f.Context, f.Source), f.Destination,
-			&asset.UploadOpts{
-				Reincludes: f.Reinclude.ToStringSlice(),
-				Excludes:   f.Exclude.ToStringSlice(),
-				Recursive:  f.Recursive,
-				UploadFn: func(key string, contents io.Reader) (string, error) {
-					return d.s3Client.Upload(d.bucketName, key, contents)
-				},
-			}); err != nil {
-			return nil, err
+	mappings := make([]asset.SyncMapping, len(d.staticSiteMft.FileUploads))
+	for i, f := range d.staticSiteMft.FileUploads {
+		mappings[i] = asset.SyncMapping{
+			Source:      filepath.Join(f.Context, f.Source),
+			Destination: f.Destination,
+			Reincludes:  f.Reinclude.ToStringSlice(),
+			Excludes:    f.Exclude.ToStringSlice(),
+			Recursive:   f.Recursive,
+			Prune:       f.Prune,
+			Metadata:    metadataRules(f.Metadata),
 		}
 	}
+	d.spinner.Start(fmt.Sprintf(fmtSyncStaticAssetsStart, color.HighlightUserInput(d.name)))
+	out, err := d.syncFn(d.fs, mappings, &asset.SyncOpts{
+		UploadFn: func(key string, contents io.Reader, md asset.Metadata) (string, error) {
+			return d.s3.UploadWithMetadata(d.bucketName, key, contents, s3.ObjectMetadata{
+				ContentType:     md.ContentType,
+				CacheControl:    md.CacheControl,
+				ContentEncoding: md.ContentEncoding,
+			})
+		},
+		ListFn: func(prefix string) (map[string]string, error) {
+			objects, err := d.s3.ListObjects(d.bucketName, prefix)
+			if err != nil {
+				return nil, err
+			}
+			etags := make(map[string]string, len(objects))
+			for _, obj := range objects {
+				etags[obj.Key] = obj.ETag
+			}
+			return etags, nil
+		},
+		DeleteFn: func(keys []string) error {
+			return d.s3.DeleteObjects(d.bucketName, keys)
+		},
+		MetadataFn: func(key string) (asset.Metadata, error) {
+			md, err := d.s3.Metadata(d.bucketName, key)
+			if err != nil {
+				return asset.Metadata{}, err
+			}
+			return asset.Metadata{
+				ContentType:     md.ContentType,
+				CacheControl:    md.CacheControl,
+				ContentEncoding: md.ContentEncoding,
+			}, nil
+		},
+		MetadataRecordKey: artifactpath.StaticSiteMetadata(d.env.Name, d.name),
+		DownloadFn: func(key string) ([]byte, error) {
+			return d.s3.GetObject(d.bucketName, key)
+		},
+	})
+	if err != nil {
+		d.spinner.Stop(log.Serrorf(fmtSyncStaticAssetsFailed, color.HighlightUserInput(d.name)))
+		return nil, fmt.Errorf("sync static assets: %w", err)
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtSyncStaticAssetsComplete, color.HighlightUserInput(d.name), len(out.Uploaded), out.Unchanged, len(out.Deleted)))
 	return d.uploadArtifacts()
 }
 
+func metadataRules(in []manifest.FileMetadata) []asset.MetadataRule {
+	if len(in) == 0 {
+		return nil
+	}
+	rules := make([]asset.MetadataRule, len(in))
+	for i, md := range in {
+		rules[i] = asset.MetadataRule{
+			Pattern: md.Match,
+			Metadata: asset.Metadata{
+				ContentType:     md.ContentType,
+				CacheControl:    md.CacheControl,
+				ContentEncoding: md.ContentEncoding,
+			},
+		}
+	}
+	return rules
+}
+
 func (d *staticSiteDeployer) stackConfiguration(in *StackRuntimeConfiguration) (cloudformation.StackConfiguration, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -9,10 +9,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/asset"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -21,37 +24,60 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 	const mockS3Bucket = "mockBucket"
 
 	tests := map[string]struct {
-		mockUploadFn func(fs afero.Fs, source, destination string, opts *asset.UploadOpts) ([]string, error)
+		mockSyncFn func(fs afero.Fs, mappings []asset.SyncMapping, opts *asset.SyncOpts) (*asset.SyncOutput, error)
 
 		wantErr error
 	}{
-		"error if failed to upload": {
-			mockUploadFn: func(fs afero.Fs, source, destination string, opts *asset.UploadOpts) ([]string, error) {
+		"error if failed to sync": {
+			mockSyncFn: func(fs afero.Fs, mappings []asset.SyncMapping, opts *asset.SyncOpts) (*asset.SyncOutput, error) {
 				return nil, errors.New("some error")
 			},
-			wantErr: fmt.Errorf("some error"),
+			wantErr: fmt.Errorf("sync static assets: some error"),
 		},
 		"success": {
-			mockUploadFn: func(fs afero.Fs, source, destination string, opts *asset.UploadOpts) ([]string, error) {
-				if source != "frontend/assets" {
+			mockSyncFn: func(fs afero.Fs, mappings []asset.SyncMapping, opts *asset.SyncOpts) (*asset.SyncOutput, error) {
+				if len(mappings) != 1 {
+					return nil, fmt.Errorf("unexpected number of mappings")
+				}
+				if mappings[0].Source != "frontend/assets" {
 					return nil, fmt.Errorf("unexpected full source path")
 				}
-				if opts.Reincludes != nil {
+				if mappings[0].Destination != "static" || !mappings[0].Recursive || !mappings[0].Prune {
+					return nil, fmt.Errorf("unexpected mapping options")
+				}
+				if mappings[0].Reincludes != nil {
 					return nil, fmt.Errorf("unexpected reinclude")
 				}
-				if len(opts.Excludes) != 1 || opts.Excludes[0] != "*.manifest" {
+				if len(mappings[0].Excludes) != 1 || mappings[0].Excludes[0] != "*.manifest" {
 					return nil, fmt.Errorf("unexpected exclude")
 				}
-				return nil, nil
+				if len(mappings[0].Metadata) != 1 || mappings[0].Metadata[0].Pattern != "*.js" ||
+					mappings[0].Metadata[0].CacheControl != "max-age=60" {
+					return nil, fmt.Errorf("unexpected metadata rules")
+				}
+				if opts.MetadataRecordKey != "manual/static-sites/mockEnv/mockSvc/metadata.json" {
+					return nil, fmt.Errorf("unexpected metadata record key")
+				}
+				return &asset.SyncOutput{}, nil
 			},
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSpinner := mocks.NewMockspinner(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any())
+			mockSpinner.EXPECT().Stop(gomock.Any())
 			deployer := &staticSiteDeployer{
 				svcDeployer: &svcDeployer{
 					workloadDeployer: &workloadDeployer{
+						name: "mockSvc",
+						env: &config.Environment{
+							Name: "mockEnv",
+						},
+						spinner: mockSpinner,
 						customResources: func(fs template.Reader) ([]*customresource.CustomResource, error) {
 							return nil, nil
 						},
@@ -68,12 +94,16 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 								Exclude: manifest.StringSliceOrString{
 									String: aws.String("*.manifest"),
 								},
+								Prune: true,
+								Metadata: []manifest.FileMetadata{
+									{Match: "*.js", CacheControl: "max-age=60"},
+								},
 							},
 						},
 					},
 				},
 				bucketName: mockS3Bucket,
-				uploadFn:   tc.mockUploadFn,
+				syncFn:     tc.mockSyncFn,
 			}
 			_, gotErr := deployer.UploadArtifacts()
 
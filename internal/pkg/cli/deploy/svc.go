@@ -14,8 +14,10 @@ import (
 	"golang.org/x/mod/semver"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
@@ -37,9 +39,14 @@ type aliasCertValidator interface {
 	ValidateCertAliases(aliases []string, certs []string) error
 }
 
+type revisionRecorder interface {
+	Record(env, workload string, rev *revision.Revision) error
+}
+
 type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
+	revisions     revisionRecorder
 	now           func() time.Time
 }
 
@@ -53,11 +60,13 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 		newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 			return f(wkldDeployer.envSess)
 		},
-		now: time.Now,
+		revisions: revision.NewStore(s3.New(wkldDeployer.envSess), wkldDeployer.resources.S3Bucket),
+		now:       time.Now,
 	}, nil
 }
 
-func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
+func (d *svcDeployer) deploy(in *DeployWorkloadInput, stackConfigOutput svcStackConfigurationOutput) error {
+	deployOptions := in.Options
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
 	}
@@ -71,9 +80,11 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 			return fmt.Errorf("deploy service: %w", err)
 		}
 		if !deployOptions.ForceNewUpdate {
-			log.Warningln("Set --force to force an update for the service.")
+			d.logger().Warningln("Set --force to force an update for the service.")
 			return fmt.Errorf("deploy service: %w", err)
 		}
+	} else {
+		d.recordRevision(stackConfigOutput.conf, in.ImageDigests)
 	}
 	// Force update the service if --force is set and the service is not updated by the CFN.
 	if deployOptions.ForceNewUpdate {
@@ -93,6 +104,24 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	return nil
 }
 
+// recordRevision records the deployed stack so that "svc rollback" can redeploy it.
+// The service is already deployed, so a failure to record the revision is only logged.
+func (d *svcDeployer) recordRevision(conf cloudformation.StackConfiguration, images map[string]ContainerImageIdentifier) {
+	digests := make(map[string]string, len(images))
+	for container, img := range images {
+		if img.Digest != "" {
+			digests[container] = img.Digest
+		}
+	}
+	rev, err := revision.NewRevision(conf, digests)
+	if err == nil {
+		err = d.revisions.Record(d.env.Name, d.name, rev)
+	}
+	if err != nil {
+		d.logger().Warningf("Failed to record the deployment of %s for rollbacks: %v\n", d.name, err)
+	}
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
@@ -110,8 +139,8 @@ func validateAppVersionForAlias(appName string, appVersionGetter versionGetter)
 	return nil
 }
 
-func logAppVersionOutdatedError(name string) {
-	log.Errorf(`Cannot deploy service %s because the application version is incompatible.
+func logAppVersionOutdatedError(logger *log.Logger, name string) {
+	logger.Errorf(`Cannot deploy service %s because the application version is incompatible.
 To upgrade the application, please run %s first (see https://aws.github.io/copilot-cli/docs/credentials/#application-credentials).
 `, name, color.HighlightCode("copilot app upgrade"))
 }
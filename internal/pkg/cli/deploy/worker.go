@@ -129,7 +129,7 @@ func (d *workerSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecom
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deploy(in.Options, stackConfigOutput.svcStackConfigurationOutput); err != nil {
+	if err := d.deploy(in, stackConfigOutput.svcStackConfigurationOutput); err != nil {
 		return nil, err
 	}
 	return &workerSvcDeployOutput{
@@ -155,6 +155,15 @@ type workerSvcStackConfigurationOutput struct {
 	subscriptions []manifest.TopicSubscription
 }
 
+// PreviewChangeSet returns the resource changes that deploying the worker service would apply, without executing them.
+func (d *workerSvcDeployer) PreviewChangeSet(in *DeployWorkloadInput) (string, error) {
+	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return "", err
+	}
+	return d.previewChangeSet(stackConfigOutput.conf)
+}
+
 func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*workerSvcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -5,12 +5,15 @@ package deploy
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -39,6 +42,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/google/uuid"
 	"github.com/spf13/afero"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -47,7 +51,8 @@ const (
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
 )
 const (
-	imageTagLatest = "latest"
+	imageTagLatest         = "latest"
+	maxParallelImageBuilds = 4 // Maximum number of container images built at the same time.
 )
 
 // ActionRecommender contains methods that output action recommendation.
@@ -86,6 +91,8 @@ type endpointGetter interface {
 
 type serviceDeployer interface {
 	DeployService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+	PreviewService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) (*awscloudformation.ChangeSetDescription, error)
+	WorkloadTemplate(app, env, name string) (string, error)
 }
 
 type spinner interface {
@@ -139,6 +146,8 @@ type workloadDeployer struct {
 	mft           interface{}
 	rawMft        []byte
 	workspacePath string
+	out           termprogress.FileWriter // Where the output of building container images is written.
+	diagnostics   io.Writer               // Where the messages of the deployment are written, defaults to the diagnostic writer.
 
 	// Dependencies.
 	fs                 fileReader
@@ -172,6 +181,8 @@ type WorkloadDeployerInput struct {
 	RawMft           []byte      // Content of the manifest file without any transformations.
 	EnvVersionGetter versionGetter
 	Overrider        Overrider
+	Output           termprogress.FileWriter // Where the progress of the deployment is written. Defaults to stderr.
+	DiagnosticWriter io.Writer               // Where the messages of the deployment are written. Defaults to the diagnostic writer.
 
 	// Workload specific configuration.
 	customResources customResourcesFunc
@@ -203,7 +214,12 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create default session with region %s: %w", in.Env.Region, err)
 	}
-	resources, err := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(in.App, in.Env.Region)
+	var out termprogress.FileWriter = os.Stderr
+	var spinnerOut io.Writer = log.DiagnosticWriter
+	if in.Output != nil {
+		out, spinnerOut = in.Output, in.Output
+	}
+	resources, err := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(out)).GetAppResourcesByRegion(in.App, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", in.App.Name, in.Env.Region, err)
 	}
@@ -252,13 +268,15 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		image:              in.Image,
 		resources:          resources,
 		workspacePath:      ws.Path(),
+		out:                out,
+		diagnostics:        in.DiagnosticWriter,
 		fs:                 &afero.Afero{Fs: afero.NewOsFs()},
 		s3Client:           s3.New(envSession),
 		addons:             addons,
 		imageBuilderPusher: imageBuilderPusher,
-		deployer:           cloudformation.New(envSession, cloudformation.WithProgressTracker(os.Stderr)),
+		deployer:           cloudformation.New(envSession, cloudformation.WithProgressTracker(out)),
 		endpointGetter:     envDescriber,
-		spinner:            termprogress.NewSpinner(log.DiagnosticWriter),
+		spinner:            termprogress.NewSpinner(spinnerOut),
 		templateFS:         template.New(),
 		envVersionGetter:   in.EnvVersionGetter,
 		overrider:          in.Overrider,
@@ -275,6 +293,11 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	}, nil
 }
 
+// logger returns the logger of the messages of the deployment, which are written to the diagnostic writer by default.
+func (w *workloadDeployer) logger() *log.Logger {
+	return log.New(log.WriterOrDiagnostic(w.diagnostics))
+}
+
 // AddonsTemplate returns this workload's addon template.
 func (w *workloadDeployer) AddonsTemplate() (string, error) {
 	if w.addons == nil {
@@ -352,20 +375,104 @@ func (d *workloadDeployer) uploadContainerImages(imgBuilderPusher imageBuilderPu
 	if err != nil {
 		return nil, err
 	}
-	images := make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
-	for name, buildArgs := range buildArgsPerContainer {
-		digest, err := imgBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArgs)
-		if err != nil {
-			return nil, fmt.Errorf("build and push image: %w", err)
+	if len(buildArgsPerContainer) == 1 {
+		images := make(map[string]ContainerImageIdentifier, 1)
+		for name, buildArgs := range buildArgsPerContainer {
+			docker := dockerengine.New(exec.NewCmd(exec.Stdout(d.out), exec.Stderr(d.out))).WithLogWriter(d.diagnostics)
+			digest, err := imgBuilderPusher.BuildAndPush(docker, buildArgs)
+			if err != nil {
+				return nil, fmt.Errorf("build and push image: %w", err)
+			}
+			images[name] = d.imageIdentifier(digest)
 		}
-		images[name] = ContainerImageIdentifier{
-			Digest:            digest,
-			CustomTag:         d.image.CustomTag,
-			GitShortCommitTag: d.image.GitShortCommitTag,
-			uuidTag:           d.image.uuidTag,
+		return images, nil
+	}
+	return d.uploadContainerImagesInParallel(imgBuilderPusher, buildArgsPerContainer)
+}
+
+// uploadContainerImagesInParallel builds and pushes the images of multiple containers at the same time,
+// while rendering a section with the latest build output of each container.
+// It returns an error for each container whose image failed to be built or pushed.
+func (d *workloadDeployer) uploadContainerImagesInParallel(imgBuilderPusher imageBuilderPusher, buildArgsPerContainer map[string]*dockerengine.BuildArguments) (map[string]ContainerImageIdentifier, error) {
+	names := make([]string, 0, len(buildArgsPerContainer))
+	for name := range buildArgsPerContainer {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sections := termprogress.NewSectionRenderer(names, termprogress.RenderOptions{})
+
+	var mu sync.Mutex
+	images := make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
+	var failed []string
+	var errs []error
+	g, ctx := errgroup.WithContext(context.Background())
+	g.Go(func() error {
+		_, err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(d.out), sections)
+		return err
+	})
+	g.Go(func() error {
+		defer sections.Close()
+		buildGroup := new(errgroup.Group)
+		buildGroup.SetLimit(maxParallelImageBuilds)
+		for _, name := range names {
+			name := name
+			buildGroup.Go(func() error {
+				sections.Start(name)
+				w := sections.Writer(name)
+				// The messages of the build are written to the section, otherwise they garble the rendered sections.
+				docker := dockerengine.New(exec.NewCmd(exec.Stdout(w), exec.Stderr(w))).WithLogWriter(w)
+				digest, err := imgBuilderPusher.BuildAndPush(docker, buildArgsPerContainer[name])
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					sections.Fail(name, err.Error())
+					failed = append(failed, name)
+					errs = append(errs, fmt.Errorf("build and push image of container %s: %w", name, err))
+					return nil
+				}
+				sections.Succeed(name)
+				images[name] = d.imageIdentifier(digest)
+				return nil
+			})
 		}
+		return buildGroup.Wait()
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("render image builds: %w", err)
 	}
-	return images, nil
+	if len(errs) == 0 {
+		return images, nil
+	}
+	// Only the last lines of output were rendered, so surface the full output of the failed builds.
+	sort.Strings(failed)
+	for _, name := range failed {
+		fmt.Fprintf(d.out, "\nOutput of building the image of container %s:\n%s", name, sections.Output(name))
+	}
+	return nil, fmt.Errorf("build and push images: %w", errors.Join(errs...))
+}
+
+// containerImageTag returns the tag of the image of a container in the repository of the workload.
+// The tags of the images of sidecars are prefixed with the container name so that they don't overwrite
+// the image of the main container, or each other's, when they're built and pushed at the same time.
+func containerImageTag(workload, container, tag string) string {
+	if tag == "" || container == workload {
+		return tag
+	}
+	return fmt.Sprintf("%s-%s", container, tag)
+}
+
+func (d *workloadDeployer) imageIdentifier(digest string) ContainerImageIdentifier {
+	return ContainerImageIdentifier{
+		Digest:            digest,
+		CustomTag:         d.image.CustomTag,
+		GitShortCommitTag: d.image.GitShortCommitTag,
+		uuidTag:           d.image.uuidTag,
+	}
+}
+
+// multiPlatformBuilder is implemented by the manifests of workloads whose images can be built for multiple platforms.
+type multiPlatformBuilder interface {
+	ContainerPlatforms() []string
 }
 
 func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
@@ -377,11 +484,15 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 	if !ok {
 		return nil, fmt.Errorf("%s does not have required methods BuildArgs() and ContainerPlatform()", name)
 	}
-	var tags []string
-	tags = append(tags, imageTagLatest, img.ReferenceTag())
+	var platforms []string
+	if mp, ok := unmarshaledManifest.(multiPlatformBuilder); ok {
+		platforms = mp.ContainerPlatforms()
+	}
 	args := mf.BuildArgs(workspacePath)
 	dArgs := make(map[string]*dockerengine.BuildArguments, len(args))
 	for k, v := range args {
+		// The reference tag comes first as the digest of the image is inspected by its first tag.
+		tags := []string{containerImageTag(name, k, img.ReferenceTag()), containerImageTag(name, k, imageTagLatest)}
 		dArgs[k] = &dockerengine.BuildArguments{
 			Dockerfile: aws.StringValue(v.Dockerfile),
 			Context:    aws.StringValue(v.Context),
@@ -389,6 +500,7 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 			CacheFrom:  v.CacheFrom,
 			Target:     aws.StringValue(v.Target),
 			Platform:   mf.ContainerPlatform(),
+			Platforms:  platforms,
 			Tags:       tags,
 		}
 	}
@@ -438,6 +550,51 @@ func (d *workloadDeployer) uploadArtifacts() (*UploadArtifactsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	out, err := d.uploadStackArtifacts()
+	if err != nil {
+		return nil, err
+	}
+	out.ImageDigests = imageDigests
+	return out, nil
+}
+
+// UploadPreviewArtifacts uploads the artifacts that the template of the workload references, so that the deployment
+// can be previewed before it is confirmed. Container images aren't built nor pushed: they are referenced by their tag instead.
+func (d *workloadDeployer) UploadPreviewArtifacts() (*UploadArtifactsOutput, error) {
+	images, err := d.previewContainerImages()
+	if err != nil {
+		return nil, err
+	}
+	out, err := d.uploadStackArtifacts()
+	if err != nil {
+		return nil, err
+	}
+	out.ImageDigests = images
+	return out, nil
+}
+
+// previewContainerImages returns the images that would be built for each container, without building them.
+func (d *workloadDeployer) previewContainerImages() (map[string]ContainerImageIdentifier, error) {
+	required, err := manifest.DockerfileBuildRequired(d.mft)
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		return nil, nil
+	}
+	buildArgsPerContainer, err := buildArgsPerContainer(d.name, d.workspacePath, d.image, d.mft)
+	if err != nil {
+		return nil, err
+	}
+	images := make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
+	for name := range buildArgsPerContainer {
+		images[name] = d.imageIdentifier("")
+	}
+	return images, nil
+}
+
+// uploadStackArtifacts uploads the env file, the addons template and the custom resources of the workload.
+func (d *workloadDeployer) uploadStackArtifacts() (*UploadArtifactsOutput, error) {
 	s3Artifacts, err := d.uploadArtifactsToS3(&uploadArtifactsToS3Input{
 		fs:       d.fs,
 		uploader: d.s3Client,
@@ -447,9 +604,8 @@ func (d *workloadDeployer) uploadArtifacts() (*UploadArtifactsOutput, error) {
 	}
 
 	out := &UploadArtifactsOutput{
-		ImageDigests: imageDigests,
-		EnvFileARN:   s3Artifacts.envFileARN,
-		AddonsURL:    s3Artifacts.addonsURL,
+		EnvFileARN: s3Artifacts.envFileARN,
+		AddonsURL:  s3Artifacts.addonsURL,
 	}
 	crs, err := d.customResources(d.templateFS)
 	if err != nil {
@@ -563,7 +719,7 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	for container, img := range in.ImageDigests {
 		images[container] = stack.ECRImage{
 			RepoURL:  d.resources.RepositoryURLs[d.name],
-			ImageTag: img.customOrGitTag(),
+			ImageTag: containerImageTag(d.name, container, img.customOrGitTag()),
 			Digest:   img.Digest,
 		}
 	}
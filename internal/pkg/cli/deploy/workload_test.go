@@ -10,6 +10,7 @@ import (
 	"io"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/override"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -50,6 +52,7 @@ type deployMocks struct {
 	mockEnvVersionGetter       *mocks.MockversionGetter
 	mockFileReader             *mocks.MockfileReader
 	mockValidator              *mocks.MockaliasCertValidator
+	mockRevisionRecorder       *mocks.MockrevisionRecorder
 }
 
 type mockTemplateFS struct {
@@ -101,9 +104,21 @@ func (m *mockTopicLister) ListSNSTopics(_, _ string) ([]deploy.Topic, error) {
 	return m.topics, m.err
 }
 
+// fakeFileWriter is a termprogress.FileWriter that buffers the output in memory.
+type fakeFileWriter struct {
+	bytes.Buffer
+}
+
+// Fd returns a dummy file descriptor.
+func (*fakeFileWriter) Fd() uintptr {
+	return 0
+}
+
 type mockWorkloadMft struct {
 	fileName      string
 	buildRequired bool
+	platforms     []string
+	containers    []string // Names of the containers to build, defaults to the main container only.
 }
 
 func (m *mockWorkloadMft) EnvFile() string {
@@ -115,10 +130,20 @@ func (m *mockWorkloadMft) BuildRequired() (bool, error) {
 }
 
 func (m *mockWorkloadMft) BuildArgs(rootDirectory string) map[string]*manifest.DockerBuildArgs {
+	containers := m.containers
+	if len(containers) == 0 {
+		containers = []string{"mockWkld"}
+	}
 	args := make(map[string]*manifest.DockerBuildArgs)
-	args["mockWkld"] = &manifest.DockerBuildArgs{
-		Dockerfile: aws.String("mockDockerfile"),
-		Context:    aws.String("mockContext"),
+	for _, name := range containers {
+		dockerfile := "mockDockerfile"
+		if name != "mockWkld" {
+			dockerfile = name + "/Dockerfile"
+		}
+		args[name] = &manifest.DockerBuildArgs{
+			Dockerfile: aws.String(dockerfile),
+			Context:    aws.String("mockContext"),
+		}
 	}
 	return args
 }
@@ -127,6 +152,10 @@ func (m *mockWorkloadMft) ContainerPlatform() string {
 	return "mockContainerPlatform"
 }
 
+func (m *mockWorkloadMft) ContainerPlatforms() []string {
+	return m.platforms
+}
+
 // stubCloudFormationStack implements the cloudformation.StackConfiguration interface.
 type stubCloudFormationStack struct{}
 
@@ -179,6 +208,8 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inRegion        string
 		inMockUserTag   string
 		inMockGitTag    string
+		inPlatforms     []string
+		inContainers    []string
 
 		mock                func(t *testing.T, m *deployMocks)
 		mockServiceDeployer func(deployer *workloadDeployer) artifactsUploader
@@ -189,6 +220,8 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		wantImages        map[string]ContainerImageIdentifier
 		wantBuildRequired bool
 		wantErr           error
+		wantErrContains   []string
+		wantErrCount      int // Number of errors joined in the returned error.
 	}{
 		"error if failed to build and push image": {
 			inBuildRequired: true,
@@ -198,7 +231,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Dockerfile: "mockDockerfile",
 					Context:    "mockContext",
 					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "v1.0"},
+					Tags:       []string{"v1.0", "latest"},
 				}).Return("", mockError)
 			},
 			wantErr: fmt.Errorf("build and push image: some error"),
@@ -212,7 +245,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Dockerfile: "mockDockerfile",
 					Context:    "mockContext",
 					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "v1.0"},
+					Tags:       []string{"v1.0", "latest"},
 				}).Return("mockDigest", nil)
 				m.mockAddons = nil
 			},
@@ -226,6 +259,90 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			},
 		},
 
+		"build and push multi-platform image successfully": {
+			inBuildRequired: true,
+			inMockUserTag:   "v1.0",
+			inPlatforms:     []string{"linux/amd64", "linux/arm64"},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Platforms:  []string{"linux/amd64", "linux/arm64"},
+					Tags:       []string{"v1.0", "latest"},
+				}).Return("mockManifestListDigest", nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:    "mockManifestListDigest",
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+			},
+		},
+		"build and push the images of multiple containers in parallel successfully": {
+			inBuildRequired: true,
+			inMockUserTag:   "v1.0",
+			inContainers:    []string{"mockWkld", "nginx"},
+			mock: func(t *testing.T, m *deployMocks) {
+				// Both images are tagged in the same local image store before the digest of either is inspected,
+				// so a digest is only right if each image is inspected by a tag that the other one doesn't have.
+				var mu sync.Mutex
+				var built sync.WaitGroup
+				built.Add(2)
+				images := make(map[string]string)
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).DoAndReturn(func(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+					mu.Lock()
+					for _, tag := range args.Tags {
+						images[tag] = "digestOf" + args.Dockerfile
+					}
+					mu.Unlock()
+					built.Done()
+					built.Wait()
+
+					mu.Lock()
+					defer mu.Unlock()
+					return images[args.Tags[0]], nil
+				}).Times(2)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:    "digestOfmockDockerfile",
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+				"nginx": {
+					Digest:    "digestOfnginx/Dockerfile",
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+			},
+		},
+		"error with every failed build if building the images of multiple containers fails": {
+			inBuildRequired: true,
+			inMockUserTag:   "v1.0",
+			inContainers:    []string{"mockWkld", "nginx", "envoy"},
+			mock: func(t *testing.T, m *deployMocks) {
+				var mu sync.Mutex
+				builds := 0
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).DoAndReturn(func(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					builds++
+					if builds == 1 {
+						return "mockDigest", nil
+					}
+					return "", mockError
+				}).Times(3)
+			},
+			wantErrContains: []string{
+				"build and push images: ",
+				"some error",
+			},
+			wantErrCount: 2,
+		},
 		"build and push image with gitshortcommit successfully": {
 			inBuildRequired: true,
 			inMockGitTag:    "gitTag",
@@ -234,7 +351,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Dockerfile: "mockDockerfile",
 					Context:    "mockContext",
 					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", "gitTag"},
+					Tags:       []string{"gitTag", "latest"},
 				}).Return("mockDigest", nil)
 				m.mockAddons = nil
 			},
@@ -253,7 +370,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Dockerfile: "mockDockerfile",
 					Context:    "mockContext",
 					Platform:   "mockContainerPlatform",
-					Tags:       []string{"latest", mockUUID},
+					Tags:       []string{mockUUID, "latest"},
 				}).Return("mockDigest", nil)
 				m.mockAddons = nil
 			},
@@ -530,7 +647,10 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				mft: &mockWorkloadMft{
 					fileName:      tc.inEnvFile,
 					buildRequired: tc.inBuildRequired,
+					platforms:     tc.inPlatforms,
+					containers:    tc.inContainers,
 				},
+				out:                &fakeFileWriter{},
 				fs:                 m.mockFileReader,
 				s3Client:           m.mockUploader,
 				imageBuilderPusher: m.mockImageBuilderPusher,
@@ -555,6 +675,14 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else if tc.wantErrContains != nil {
+				require.Error(t, gotErr)
+				for _, msg := range tc.wantErrContains {
+					require.Contains(t, gotErr.Error(), msg)
+				}
+				var joined interface{ Unwrap() []error }
+				require.True(t, errors.As(gotErr, &joined))
+				require.Len(t, joined.Unwrap(), tc.wantErrCount)
 			} else {
 				require.NoError(t, gotErr)
 				require.Equal(t, tc.wantAddonsURL, got.AddonsURL)
@@ -565,6 +693,107 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeployer_UploadPreviewArtifacts(t *testing.T) {
+	const (
+		mockName     = "mockWkld"
+		mockS3Bucket = "mockBucket"
+		mockUUID     = "31323334-3536-4738-b930-313233333435"
+	)
+	mockAddonPath := fmt.Sprintf("%s/%s/%s/%s.yml", "manual", "addons", mockName, "1307990e6ba5ca145eb35e99182a9bec46531bc54ddf656a602c780fa0240dee")
+	mockError := errors.New("some error")
+	tests := map[string]struct {
+		inBuildRequired bool
+		inContainers    []string
+
+		mock func(m *deployMocks)
+
+		wantAddonsURL string
+		wantImages    map[string]ContainerImageIdentifier
+		wantErr       error
+	}{
+		"should reference the images of every container by their tag without building them": {
+			inBuildRequired: true,
+			inContainers:    []string{"nginx", "mockWkld"},
+			mock: func(m *deployMocks) {
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				"nginx": {
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+				mockName: {
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+			},
+		},
+		"should not reference any image if the workload doesn't build one": {
+			mock: func(m *deployMocks) {
+				m.mockAddons.EXPECT().Package(gomock.Any()).Return(nil)
+				m.mockAddons.EXPECT().Template().Return("some data", nil)
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, mockAddonPath, gomock.Any()).Return("mockAddonsURL", nil)
+			},
+			wantAddonsURL: "mockAddonsURL",
+		},
+		"should return an error if the addons can't be uploaded": {
+			mock: func(m *deployMocks) {
+				m.mockAddons.EXPECT().Package(gomock.Any()).Return(mockError)
+			},
+			wantErr: fmt.Errorf("package addons: %w", mockError),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployMocks{
+				mockUploader:           mocks.NewMockuploader(ctrl),
+				mockAddons:             mocks.NewMockstackBuilder(ctrl),
+				mockImageBuilderPusher: mocks.NewMockimageBuilderPusher(ctrl),
+			}
+			tc.mock(m)
+			deployer := &workloadDeployer{
+				name: mockName,
+				env: &config.Environment{
+					Name: "test",
+				},
+				resources: &stack.AppRegionalResources{
+					S3Bucket: mockS3Bucket,
+				},
+				image: ContainerImageIdentifier{
+					CustomTag: "v1.0",
+					uuidTag:   mockUUID,
+				},
+				mft: &mockWorkloadMft{
+					buildRequired: tc.inBuildRequired,
+					containers:    tc.inContainers,
+				},
+				s3Client:           m.mockUploader,
+				imageBuilderPusher: m.mockImageBuilderPusher,
+				templateFS:         fakeTemplateFS(),
+				customResources: func(fs template.Reader) ([]*customresource.CustomResource, error) {
+					return nil, nil
+				},
+			}
+			if m.mockAddons != nil {
+				deployer.addons = m.mockAddons
+			}
+
+			got, err := deployer.UploadPreviewArtifacts()
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantAddonsURL, got.AddonsURL)
+			require.Equal(t, tc.wantImages, got.ImageDigests)
+		})
+	}
+}
+
 func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockError := errors.New("some error")
 	const (
@@ -1005,6 +1234,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
 					Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
 					Return(time.Time{}, mockError)
 			},
@@ -1024,6 +1254,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
 					Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
 					Return(mockAfterTime, nil)
 			},
@@ -1089,6 +1320,22 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
+			},
+		},
+		"success even if fail to record the revision": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(mockError)
 			},
 		},
 		"success": {
@@ -1113,6 +1360,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and alb certs imported": {
@@ -1137,6 +1385,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 			},
 		},
 		"success with only cdn certs imported": {
@@ -1160,6 +1409,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, []string{mockCDNCertARN}).Return(nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and domain imported": {
@@ -1188,6 +1438,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(mockEnvName, mockName, gomock.Any()).Return(nil)
 			},
 		},
 		"success with force update": {
@@ -1227,6 +1478,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
 				mockValidator:              mocks.NewMockaliasCertValidator(ctrl),
+				mockRevisionRecorder:       mocks.NewMockrevisionRecorder(ctrl),
 			}
 			tc.mock(m)
 
@@ -1252,6 +1504,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 					newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 						return m.mockServiceForceUpdater
 					},
+					revisions: m.mockRevisionRecorder,
 					now: func() time.Time {
 						return mockNowTime
 					},
@@ -1306,3 +1559,32 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 		})
 	}
 }
+
+func Test_containerImageTag(t *testing.T) {
+	testCases := map[string]struct {
+		inContainer string
+		inTag       string
+
+		wanted string
+	}{
+		"the main container keeps the tag": {
+			inContainer: "mockWkld",
+			inTag:       "v1.0",
+			wanted:      "v1.0",
+		},
+		"a sidecar prefixes the tag with its name": {
+			inContainer: "nginx",
+			inTag:       "v1.0",
+			wanted:      "nginx-v1.0",
+		},
+		"an empty tag stays empty": {
+			inContainer: "nginx",
+			wanted:      "",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, containerImageTag("mockWkld", tc.inContainer, tc.inTag))
+		})
+	}
+}
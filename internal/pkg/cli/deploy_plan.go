@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// deploymentPlan is the order in which a set of workloads are deployed.
+type deploymentPlan struct {
+	stages       [][]string          // Workloads grouped by stage, workloads in the same stage can be deployed in parallel.
+	dependencies map[string][]string // Workloads that must be deployed before a workload.
+}
+
+// deploymentDependencyConfig holds the fields of a workload manifest that determine its deployment dependencies.
+type deploymentDependencyConfig struct {
+	dependsOn    []string // Names of the workloads listed in "depends_on".
+	publishers   []string // Names of the workloads publishing the topics that the workload subscribes to.
+	connectAlias string   // Service Connect alias of the workload, empty if other workloads can't connect to it.
+	variables    []string // Plain values of the environment variables of the main container.
+}
+
+// newDeploymentPlan returns the stages in which the workloads should be deployed given their manifests.
+// A workload is deployed after:
+//  1. the workloads listed in its "depends_on" field.
+//  2. the workloads publishing to the topics that it subscribes to.
+//  3. the workloads whose Service Connect alias is the host of one of its environment variables.
+//
+// Dependencies on workloads that are not part of the deployment are ignored.
+func newDeploymentPlan(names []string, mfts map[string]any) (*deploymentPlan, error) {
+	configs := make(map[string]deploymentDependencyConfig, len(names))
+	for _, name := range names {
+		configs[name] = newDeploymentDependencyConfig(name, mfts[name])
+	}
+
+	digraph := graph.New(names...)
+	plan := &deploymentPlan{
+		dependencies: make(map[string][]string),
+	}
+	addDependency := func(from, to string) {
+		if from == to {
+			return
+		}
+		if _, ok := configs[from]; !ok {
+			return
+		}
+		for _, dependency := range plan.dependencies[to] {
+			if dependency == from {
+				return
+			}
+		}
+		digraph.Add(graph.Edge[string]{From: from, To: to})
+		plan.dependencies[to] = append(plan.dependencies[to], from)
+	}
+	for _, name := range names {
+		cfg := configs[name]
+		for _, dependency := range cfg.dependsOn {
+			addDependency(dependency, name)
+		}
+		for _, publisher := range cfg.publishers {
+			addDependency(publisher, name)
+		}
+		for _, server := range names {
+			alias := configs[server].connectAlias
+			if alias == "" {
+				continue
+			}
+			for _, value := range cfg.variables {
+				if hostOf(value) == alias {
+					addDependency(server, name)
+					break
+				}
+			}
+		}
+	}
+
+	topo, err := graph.TopologicalOrder(digraph)
+	if err != nil {
+		return nil, fmt.Errorf("determine deployment order: %w", err)
+	}
+	for _, name := range names {
+		rank, _ := topo.Rank(name)
+		for len(plan.stages) <= rank {
+			plan.stages = append(plan.stages, nil)
+		}
+		plan.stages[rank] = append(plan.stages[rank], name)
+	}
+	return plan, nil
+}
+
+// workloads returns the names of the workloads in the order they are deployed.
+func (p *deploymentPlan) workloads() []string {
+	var names []string
+	for _, stage := range p.stages {
+		names = append(names, stage...)
+	}
+	return names
+}
+
+func newDeploymentDependencyConfig(name string, mft any) deploymentDependencyConfig {
+	var cfg deploymentDependencyConfig
+	switch m := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		cfg.dependsOn = m.DependsOn
+		cfg.variables = plainVariables(m.TaskConfig.Variables)
+		if m.Network.Connect.Enabled() {
+			cfg.connectAlias = connectAlias(name, m.Network.Connect)
+		}
+	case *manifest.BackendService:
+		cfg.dependsOn = m.DependsOn
+		cfg.variables = plainVariables(m.TaskConfig.Variables)
+		if _, ok := m.Port(); ok && m.Network.Connect.Enabled() {
+			cfg.connectAlias = connectAlias(name, m.Network.Connect)
+		}
+	case *manifest.WorkerService:
+		cfg.dependsOn = m.DependsOn
+		cfg.variables = plainVariables(m.TaskConfig.Variables)
+		for _, subscription := range m.Subscriptions() {
+			cfg.publishers = append(cfg.publishers, aws.StringValue(subscription.Service))
+		}
+	case *manifest.RequestDrivenWebService:
+		cfg.dependsOn = m.DependsOn
+	case *manifest.StaticSite:
+		cfg.dependsOn = m.DependsOn
+	case *manifest.ScheduledJob:
+		cfg.dependsOn = m.DependsOn
+	}
+	return cfg
+}
+
+func connectAlias(name string, connect manifest.ServiceConnectBoolOrArgs) string {
+	if connect.Alias != nil {
+		return aws.StringValue(connect.Alias)
+	}
+	return name
+}
+
+func plainVariables(variables map[string]manifest.Variable) []string {
+	var values []string
+	for _, v := range variables {
+		if v.Plain != nil {
+			values = append(values, aws.StringValue(v.Plain))
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// hostOf returns the host of an environment variable value such as "http://api:8080/", "api:8080" or "api".
+func hostOf(value string) string {
+	if u, err := url.Parse(value); err == nil && u.Host != "" {
+		return u.Hostname()
+	}
+	host, _, _ := strings.Cut(value, ":")
+	return host
+}
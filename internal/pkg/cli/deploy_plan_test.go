@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewDeploymentPlan(t *testing.T) {
+	backend := func(name string, alias *string) *manifest.BackendService {
+		mft := &manifest.BackendService{}
+		mft.Name = aws.String(name)
+		mft.ImageConfig.Port = aws.Uint16(8080)
+		mft.Network.Connect = manifest.ServiceConnectBoolOrArgs{
+			EnableServiceConnect: aws.Bool(true),
+		}
+		if alias != nil {
+			mft.Network.Connect = manifest.ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: manifest.ServiceConnectArgs{
+					Alias: alias,
+				},
+			}
+		}
+		return mft
+	}
+	frontend := func(name string, vars map[string]string, dependsOn ...string) *manifest.LoadBalancedWebService {
+		mft := &manifest.LoadBalancedWebService{}
+		mft.Name = aws.String(name)
+		mft.DependsOn = dependsOn
+		raw, err := yaml.Marshal(vars)
+		require.NoError(t, err)
+		require.NoError(t, yaml.Unmarshal(raw, &mft.TaskConfig.Variables))
+		return mft
+	}
+	worker := func(name string, publishers ...string) *manifest.WorkerService {
+		mft := &manifest.WorkerService{}
+		mft.Name = aws.String(name)
+		for _, publisher := range publishers {
+			mft.Subscribe.Topics = append(mft.Subscribe.Topics, manifest.TopicSubscription{
+				Name:    aws.String("events"),
+				Service: aws.String(publisher),
+			})
+		}
+		return mft
+	}
+
+	testCases := map[string]struct {
+		inNames []string
+		inMfts  map[string]any
+
+		wantedStages       [][]string
+		wantedDependencies map[string][]string
+		wantedErr          string
+	}{
+		"should deploy all workloads in a single stage if they are independent": {
+			inNames: []string{"api", "fe"},
+			inMfts: map[string]any{
+				"api": backend("api", nil),
+				"fe":  frontend("fe", map[string]string{"LOG_LEVEL": "debug"}),
+			},
+			wantedStages:       [][]string{{"api", "fe"}},
+			wantedDependencies: map[string][]string{},
+		},
+		"should deploy a workload after the Service Connect server it calls": {
+			inNames: []string{"fe", "api", "db"},
+			inMfts: map[string]any{
+				"fe":  frontend("fe", map[string]string{"API_URL": "http://api:8080/v1", "DB": "database:5432"}),
+				"api": backend("api", nil),
+				"db":  backend("db", aws.String("database")),
+			},
+			wantedStages: [][]string{{"api", "db"}, {"fe"}},
+			wantedDependencies: map[string][]string{
+				"fe": {"api", "db"},
+			},
+		},
+		"should deploy a workload after its publishers and the workloads it depends on": {
+			inNames: []string{"worker", "api", "fe"},
+			inMfts: map[string]any{
+				"worker": worker("worker", "fe", "fe", "orders"),
+				"api":    backend("api", nil),
+				"fe":     frontend("fe", nil, "api"),
+			},
+			wantedStages: [][]string{{"api"}, {"fe"}, {"worker"}},
+			wantedDependencies: map[string][]string{
+				"fe":     {"api"},
+				"worker": {"fe"},
+			},
+		},
+		"should return an error if the dependencies form a cycle": {
+			inNames: []string{"fe", "admin"},
+			inMfts: map[string]any{
+				"fe":    frontend("fe", nil, "admin"),
+				"admin": frontend("admin", nil, "fe"),
+			},
+			wantedErr: "determine deployment order: graph contains a cycle",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			plan, err := newDeploymentPlan(tc.inNames, tc.inMfts)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.ErrorContains(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStages, plan.stages)
+			require.Equal(t, tc.wantedDependencies, plan.dependencies)
+		})
+	}
+}
+
+func TestHostOf(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"url":           {in: "http://api:8080/v1", wanted: "api"},
+		"host and port": {in: "api:8080", wanted: "api"},
+		"host":          {in: "api", wanted: "api"},
+		"empty":         {in: "", wanted: ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hostOf(tc.in))
+		})
+	}
+}
@@ -5,10 +5,13 @@ package cli
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -25,8 +28,9 @@ func TestDeployOpts_Run(t *testing.T) {
 		Type: "Scheduled Job",
 	}
 	testCases := map[string]struct {
-		inAppName string
-		inName    string
+		inAppName   string
+		inName      string
+		inNewCmdErr error
 
 		wantedErr string
 
@@ -49,6 +53,18 @@ func TestDeployOpts_Run(t *testing.T) {
 				m.EXPECT().GetWorkload("app", "fe").Return(&mockWl, nil)
 			},
 		},
+		"errors if the workload type is unrecognized": {
+			inAppName:   "app",
+			inNewCmdErr: errors.New(`unrecognized workload type "Unknown"`),
+			wantedErr:   `deploy fe: unrecognized workload type "Unknown"`,
+			mockSel: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Workload("Select a service or job in your workspace", "").Return("fe", nil)
+			},
+			mockActionCommand: func(m *mocks.MockactionCommand) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload("app", "fe").Return(&mockWl, nil)
+			},
+		},
 		"errors correctly if job returned": {
 			inAppName: "app",
 			wantedErr: "ask job deploy: some error",
@@ -142,16 +158,22 @@ func TestDeployOpts_Run(t *testing.T) {
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
 				},
-				deployWkld: mockCmd,
-				sel:        mockSel,
-				store:      mockStore,
+				sel:   mockSel,
+				store: mockStore,
 
-				setupDeployCmd: func(o *deployOpts, wlType string) {},
+				newWkldDeployCmd: func(vars deployWkldVars, wlType string, output termprogress.FileWriter, diagnostics io.Writer) (actionCommand, error) {
+					if tc.inNewCmdErr != nil {
+						return nil, tc.inNewCmdErr
+					}
+					return mockCmd, nil
+				},
 			}
 
 			// WHEN
@@ -164,3 +186,189 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+type deployMultipleMocks struct {
+	sel   *mocks.MockwsSelector
+	store *mocks.Mockstore
+	ws    *mocks.MockwsWlDirReader
+	cmds  map[string]*mocks.MockactionCommand
+}
+
+func TestDeployOpts_RunMultiple(t *testing.T) {
+	const (
+		apiManifest = `name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+network:
+  connect: true
+`
+		feManifest = `name: fe
+type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+variables:
+  API_URL: http://api:8080
+`
+		workerManifest = `name: worker
+type: Worker Service
+image:
+  build: Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+`
+	)
+	mockErr := errors.New("some error")
+	workloads := map[string]*config.Workload{
+		"api":    {Name: "api", Type: "Backend Service"},
+		"fe":     {Name: "fe", Type: "Load Balanced Web Service"},
+		"worker": {Name: "worker", Type: "Worker Service"},
+	}
+	expectLoad := func(m deployMultipleMocks, manifests map[string]string) {
+		for name, mft := range manifests {
+			m.store.EXPECT().GetWorkload("phonetool", name).Return(workloads[name], nil)
+			m.ws.EXPECT().ReadWorkloadManifest(name).Return([]byte(mft), nil)
+			m.cmds[name].EXPECT().Ask().Return(nil)
+			m.cmds[name].EXPECT().Validate().Return(nil)
+		}
+	}
+	testCases := map[string]struct {
+		inNames []string
+		inAll   bool
+		inEnv   string
+
+		setupMocks func(m deployMultipleMocks)
+
+		wantedErr string
+	}{
+		"should return an error if there are no workloads in the workspace": {
+			inAll: true,
+			setupMocks: func(m deployMultipleMocks) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+			},
+			wantedErr: "no services or jobs found in the workspace",
+		},
+		"should return an error if fail to select an environment": {
+			inNames: []string{"api", "fe"},
+			setupMocks: func(m deployMultipleMocks) {
+				m.sel.EXPECT().Environment("Select an environment", "", "phonetool").Return("", mockErr)
+			},
+			wantedErr: "select environment: some error",
+		},
+		"should return an error if a workload fails validation": {
+			inNames: []string{"api", "fe"},
+			inEnv:   "test",
+			setupMocks: func(m deployMultipleMocks) {
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(workloads["api"], nil)
+				m.cmds["api"].EXPECT().Ask().Return(nil)
+				m.cmds["api"].EXPECT().Validate().Return(mockErr)
+			},
+			wantedErr: "validate svc deploy of api: some error",
+		},
+		"should return an error if the workloads depend on each other": {
+			inNames: []string{"api", "fe"},
+			inEnv:   "test",
+			setupMocks: func(m deployMultipleMocks) {
+				expectLoad(m, map[string]string{
+					"api": apiManifest + "depends_on: [fe]\n",
+					"fe":  feManifest,
+				})
+			},
+			wantedErr: "determine deployment order: graph contains a cycle",
+		},
+		"should deploy the dependencies of a workload before the workload": {
+			inAll: true,
+			setupMocks: func(m deployMultipleMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "fe", "worker"}, nil)
+				m.sel.EXPECT().Environment("Select an environment", "", "phonetool").Return("test", nil)
+				expectLoad(m, map[string]string{
+					"api":    apiManifest,
+					"fe":     feManifest,
+					"worker": workerManifest,
+				})
+				api := m.cmds["api"].EXPECT().Execute().Return(nil)
+				m.cmds["fe"].EXPECT().Execute().Return(nil).After(api)
+				m.cmds["worker"].EXPECT().Execute().Return(nil).After(api)
+				for _, cmd := range m.cmds {
+					cmd.EXPECT().RecommendActions().Return(nil)
+				}
+			},
+		},
+		"should skip the workloads whose dependencies failed to deploy": {
+			inNames: []string{"api", "fe", "worker"},
+			inEnv:   "test",
+			setupMocks: func(m deployMultipleMocks) {
+				expectLoad(m, map[string]string{
+					"api":    apiManifest,
+					"fe":     feManifest,
+					"worker": workerManifest + "depends_on: [fe]\n",
+				})
+				api := m.cmds["api"].EXPECT().Execute().Return(nil)
+				m.cmds["fe"].EXPECT().Execute().Return(mockErr).After(api)
+				m.cmds["api"].EXPECT().RecommendActions().Return(nil)
+			},
+			wantedErr: "deploy fe: some error\nskip deployment of worker: dependency fe was not deployed",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deployMultipleMocks{
+				sel:   mocks.NewMockwsSelector(ctrl),
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsWlDirReader(ctrl),
+				cmds: map[string]*mocks.MockactionCommand{
+					"api":    mocks.NewMockactionCommand(ctrl),
+					"fe":     mocks.NewMockactionCommand(ctrl),
+					"worker": mocks.NewMockactionCommand(ctrl),
+				},
+			}
+			tc.setupMocks(m)
+			mockInterpolator := mocks.NewMockinterpolator(ctrl)
+			mockInterpolator.EXPECT().Interpolate(gomock.Any()).DoAndReturn(func(s string) (string, error) {
+				return s, nil
+			}).AnyTimes()
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "phonetool",
+						envName: tc.inEnv,
+					},
+					workloadNames: tc.inNames,
+					deployAll:     tc.inAll,
+				},
+				sel:       m.sel,
+				store:     m.store,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(app, env string) interpolator {
+					return mockInterpolator
+				},
+				laneWriter: discardFile{},
+				newWkldDeployCmd: func(vars deployWkldVars, wlType string, output termprogress.FileWriter, diagnostics io.Writer) (actionCommand, error) {
+					require.Equal(t, "test", vars.envName)
+					require.Equal(t, discardFile{}, output)
+					require.NotNil(t, diagnostics)
+					return m.cmds[vars.name], nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.ErrorContains(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	// driftExitCode is the exit code of the drift commands when resources drifted,
+	// so that CI jobs can tell drift apart from failures to detect it.
+	driftExitCode = 2
+	// driftUndetectedExitCode is the exit code of the drift commands when the drift of a stack could not be determined.
+	driftUndetectedExitCode = 3
+
+	fmtDriftDetectStart    = "Detecting drift of %s."
+	fmtDriftDetectFailed   = "Failed to detect drift of %s.\n"
+	fmtDriftDetectComplete = "Detected drift of %s.\n"
+)
+
+type errStackDrifted struct {
+	resource string // Description of the drifted resource, such as "environment test".
+}
+
+func (e *errStackDrifted) Error() string {
+	return fmt.Sprintf("%s drifted from its deployed CloudFormation template", e.resource)
+}
+
+// ExitCode returns the exit code of the drift commands when the stack drifted.
+func (e *errStackDrifted) ExitCode() int {
+	return driftExitCode
+}
+
+type errStackDriftUndetected struct {
+	resource string   // Description of the resource, such as "environment test".
+	stacks   []string // Names of the stacks whose drift status is unknown.
+}
+
+func (e *errStackDriftUndetected) Error() string {
+	return fmt.Sprintf("could not determine the drift of %s: drift detection did not complete for stacks %s", e.resource, strings.Join(e.stacks, ", "))
+}
+
+// ExitCode returns the exit code of the drift commands when the drift of a stack could not be determined.
+func (e *errStackDriftUndetected) ExitCode() int {
+	return driftUndetectedExitCode
+}
+
+// detectDrift starts drift detection on the stacks described by d, renders the report to w,
+// and returns an errStackDrifted error if any resource drifted, or an errStackDriftUndetected error
+// if the drift status of a stack could not be determined.
+func detectDrift(d stackDriftDescriber, prog progress, w io.Writer, resource string, shouldOutputJSON bool) error {
+	prog.Start(fmt.Sprintf(fmtDriftDetectStart, resource))
+	report, err := d.Describe()
+	if err != nil {
+		prog.Stop(log.Serrorf(fmtDriftDetectFailed, resource))
+		return fmt.Errorf("describe drift of %s: %w", resource, err)
+	}
+	prog.Stop(log.Ssuccessf(fmtDriftDetectComplete, resource))
+	if shouldOutputJSON {
+		data, err := report.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, data)
+	} else {
+		fmt.Fprint(w, report.HumanString())
+	}
+	if report.HasDrift() {
+		return &errStackDrifted{resource: resource}
+	}
+	if stacks := report.UndetectedStacks(); len(stacks) > 0 {
+		return &errStackDriftUndetected{
+			resource: resource,
+			stacks:   stacks,
+		}
+	}
+	return nil
+}
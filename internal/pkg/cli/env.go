@@ -42,6 +42,7 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvListCmd())
 	cmd.AddCommand(buildEnvDeleteCmd())
 	cmd.AddCommand(buildEnvShowCmd())
+	cmd.AddCommand(buildEnvDriftCmd())
 	cmd.AddCommand(buildEnvUpgradeCmd())
 	cmd.AddCommand(buildEnvDeployCmd())
 	cmd.AddCommand(buildEnvPkgCmd())
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -32,6 +33,8 @@ type deployEnvVars struct {
 	name            string
 	forceNewUpdate  bool
 	disableRollback bool
+	showDiff        bool
+	skipDiffPrompt  bool
 }
 
 type deployEnvOpts struct {
@@ -42,7 +45,8 @@ type deployEnvOpts struct {
 	sessionProvider *sessions.Provider
 
 	// Dependencies to ask.
-	sel wsEnvironmentSelector
+	sel    wsEnvironmentSelector
+	prompt prompter
 
 	// Dependencies to execute.
 	fs              afero.Fs
@@ -50,6 +54,8 @@ type deployEnvOpts struct {
 	identity        identityService
 	newInterpolator func(app, env string) interpolator
 	newEnvDeployer  func() (envDeployer, error)
+	diffWriter      io.Writer
+	isTerminal      bool // Whether the standard input is a terminal that can answer the confirmation of the diff.
 
 	// Cached variables.
 	targetApp *config.Application
@@ -68,17 +74,21 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	prompter := prompt.New()
 	opts := &deployEnvOpts{
 		deployEnvVars: vars,
 
 		store:           store,
 		sessionProvider: sessProvider,
-		sel:             selector.NewLocalEnvironmentSelector(prompt.New(), store, ws),
+		sel:             selector.NewLocalEnvironmentSelector(prompter, store, ws),
+		prompt:          prompter,
 
 		fs:              fs,
 		ws:              ws,
 		identity:        identity.New(defaultSess),
 		newInterpolator: newManifestInterpolator,
+		diffWriter:      os.Stdout,
+		isTerminal:      isStdinTerminal(),
 	}
 	opts.newEnvDeployer = func() (envDeployer, error) {
 		return newEnvDeployer(opts, ws)
@@ -95,7 +105,7 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 	if err != nil {
 		return nil, err
 	}
-	ovrdr, err := deploy.NewOverrider(opts.ws.EnvOverridesPath(), env.App, env.Name, opts.fs, opts.sessionProvider)
+	ovrdr, err := deploy.NewOverrider(opts.ws.EnvOverridesPath(), env.App, env.Name, opts.fs, opts.sessionProvider, log.DiagnosticWriter)
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +119,9 @@ func newEnvDeployer(opts *deployEnvOpts, ws deploy.WorkspaceAddonsReaderPathGett
 	})
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error for any invalid optional flags.
 func (o *deployEnvOpts) Validate() error {
-	return nil
+	return validateDiffFlags(o.showDiff, o.skipDiffPrompt, o.isTerminal)
 }
 
 // Ask prompts for and validates any required flags.
@@ -151,7 +161,7 @@ func (o *deployEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("upload artifacts for environment %s: %w", o.name, err)
 	}
-	if err := deployer.DeployEnvironment(&deploy.DeployEnvironmentInput{
+	deployInput := &deploy.DeployEnvironmentInput{
 		RootUserARN:         caller.RootUserARN,
 		AddonsURL:           artifacts.AddonsURL,
 		CustomResourcesURLs: artifacts.CustomResourceURLs,
@@ -160,7 +170,13 @@ func (o *deployEnvOpts) Execute() error {
 		RawManifest:         rawMft,
 		PermissionsBoundary: o.targetApp.PermissionsBoundary,
 		DisableRollback:     o.disableRollback,
-	}); err != nil {
+	}
+	if o.showDiff {
+		if err := o.confirmDiff(deployer, deployInput); err != nil {
+			return err
+		}
+	}
+	if err := deployer.DeployEnvironment(deployInput); err != nil {
 		var errEmptyChangeSet *awscfn.ErrChangeSetEmpty
 		if errors.As(err, &errEmptyChangeSet) {
 			log.Errorf(`Your update does not introduce immediate resource changes. 
@@ -185,6 +201,33 @@ After fixing the deployment, you can:
 	return nil
 }
 
+func (o *deployEnvOpts) confirmDiff(deployer envDeployer, in *deploy.DeployEnvironmentInput) error {
+	tpl, err := deployer.GenerateCloudFormationTemplate(in)
+	if err != nil {
+		return fmt.Errorf("generate the template for environment %s: %w", o.name, err)
+	}
+	templateDiff, err := deployer.DeployDiff(tpl.Template)
+	if err != nil {
+		return fmt.Errorf("compute the diff for environment %s: %w", o.name, err)
+	}
+	changes, err := deployer.PreviewChangeSet(in)
+	if err != nil {
+		return err
+	}
+	writeDeployDiff(o.diffWriter, templateDiff, changes)
+	if o.skipDiffPrompt {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeployDiffConfirmPrompt, color.HighlightUserInput(o.name)), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm deployment of environment %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errors.New("env deploy cancelled - no changes made")
+	}
+	return nil
+}
+
 func environmentManifest(envName string, rawMft []byte, transformer interpolator) (*manifest.Environment, error) {
 	interpolated, err := transformer.Interpolate(string(rawMft))
 	if err != nil {
@@ -282,7 +325,9 @@ func buildEnvDeployCmd() *cobra.Command {
 		Long:  "Deploys an environment to an application.",
 		Example: `
 Deploy an environment named "test".
-/code $copilot env deploy --name test`,
+/code $copilot env deploy --name test
+Show the changes to the environment's stack before deploying.
+/code $copilot env deploy --name test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvDeployOpts(vars)
 			if err != nil {
@@ -295,5 +340,7 @@ Deploy an environment named "test".
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceEnvDeployFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipDiffPrompt, yesFlag, false, diffYesFlagDescription)
 	return cmd
 }
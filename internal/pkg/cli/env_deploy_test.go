@@ -5,6 +5,7 @@ package cli
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,6 +24,48 @@ type deployEnvAskMocks struct {
 	store *mocks.Mockstore
 }
 
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inShowDiff       bool
+		inSkipDiffPrompt bool
+		inIsTerminal     bool
+
+		wantedErr error
+	}{
+		"success with --diff in a terminal": {
+			inShowDiff:   true,
+			inIsTerminal: true,
+		},
+		"success with --diff and --yes outside of a terminal": {
+			inShowDiff:       true,
+			inSkipDiffPrompt: true,
+		},
+		"error if --diff can't be confirmed outside of a terminal": {
+			inShowDiff: true,
+			wantedErr:  errors.New("--diff requires a terminal to confirm the deployment, specify --yes to deploy without confirming"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					showDiff:       tc.inShowDiff,
+					skipDiffPrompt: tc.inSkipDiffPrompt,
+				},
+				isTerminal: tc.inIsTerminal,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestDeployEnvOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
@@ -142,12 +185,16 @@ type deployEnvExecuteMocks struct {
 	deployer     *mocks.MockenvDeployer
 	identity     *mocks.MockidentityService
 	interpolator *mocks.Mockinterpolator
+	prompter     *mocks.Mockprompter
 }
 
 func TestDeployEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		unmarshalManifest func(in []byte) (*manifest.Environment, error)
+		inShowDiff        bool
+		inSkipDiffPrompt  bool
 		setUpMocks        func(m *deployEnvExecuteMocks)
+		wantedDiff        string
 		wantedErr         error
 	}{
 		"fail to read manifest": {
@@ -216,6 +263,77 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("deploy environment mockEnv: some error"),
 		},
+		"fail to compute the diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mockTemplate").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("compute the diff for environment mockEnv: some error"),
+		},
+		"do not deploy if the diff is not confirmed": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mockTemplate").Return("~ Resources:\n", nil)
+				m.deployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("", nil)
+				m.prompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedDiff: "~ Resources:\n\n",
+			wantedErr:  errors.New("env deploy cancelled - no changes made"),
+		},
+		"deploy after the diff is confirmed": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mockTemplate").Return("", nil)
+				m.deployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("mockChanges\n", nil)
+				m.prompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+			wantedDiff: "No changes to the CloudFormation template.\n\nResource changes:\nmockChanges\n\n",
+		},
+		"deploy without confirming the diff with --yes": {
+			inShowDiff:       true,
+			inSkipDiffPrompt: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mockTemplate").Return("", nil)
+				m.deployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("", nil)
+				m.prompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+			wantedDiff: "No changes to the CloudFormation template.\n\n",
+		},
 		"success": {
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
@@ -256,14 +374,20 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				deployer:     mocks.NewMockenvDeployer(ctrl),
 				identity:     mocks.NewMockidentityService(ctrl),
 				interpolator: mocks.NewMockinterpolator(ctrl),
+				prompter:     mocks.NewMockprompter(ctrl),
 			}
 			tc.setUpMocks(m)
+			diff := new(strings.Builder)
 			opts := deployEnvOpts{
 				deployEnvVars: deployEnvVars{
-					name: "mockEnv",
+					name:           "mockEnv",
+					showDiff:       tc.inShowDiff,
+					skipDiffPrompt: tc.inSkipDiffPrompt,
 				},
-				ws:       m.ws,
-				identity: m.identity,
+				ws:         m.ws,
+				identity:   m.identity,
+				prompt:     m.prompter,
+				diffWriter: diff,
 				newEnvDeployer: func() (envDeployer, error) {
 					return m.deployer, nil
 				},
@@ -284,6 +408,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tc.wantedDiff, diff.String())
 		})
 	}
 }
@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftAppNamePrompt = "Which application is the environment in?"
+	envDriftNamePrompt    = "Which environment of %s would you like to detect drift for?"
+	envDriftHelpPrompt    = "Copilot compares the resources of the environment with its deployed CloudFormation templates."
+)
+
+type envDriftVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type envDriftOpts struct {
+	envDriftVars
+
+	w                  io.Writer
+	store              store
+	sel                configSelector
+	prog               progress
+	describer          stackDriftDescriber
+	initDriftDescriber func() error
+}
+
+func newEnvDriftOpts(vars envDriftVars) (*envDriftOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+
+	opts := &envDriftOpts{
+		envDriftVars: vars,
+		w:            log.OutputWriter,
+		store:        store,
+		sel:          selector.NewConfigSelector(prompt.New(), store),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initDriftDescriber = func() error {
+		d, err := describe.NewStackDriftDescriber(describe.NewStackDriftConfig{
+			App:         opts.appName,
+			Env:         opts.name,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return fmt.Errorf("create drift describer for environment %s in application %s: %w", opts.name, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *envDriftOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *envDriftOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute detects drift on the environment stack and its nested stacks, and prints the drifted resources.
+func (o *envDriftOpts) Execute() error {
+	if err := o.initDriftDescriber(); err != nil {
+		return err
+	}
+	return detectDrift(o.describer, o.prog, o.w, fmt.Sprintf("environment %s", o.name), o.shouldOutputJSON)
+}
+
+func (o *envDriftOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envDriftAppNamePrompt, envShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *envDriftOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.appName)), envDriftHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvDriftCmd builds the command for detecting drift of an environment.
+func buildEnvDriftCmd() *cobra.Command {
+	vars := envDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of an environment that were changed outside of CloudFormation.",
+		Long: `Detects resources of an environment that were changed outside of CloudFormation.
+Exits with code 2 if any resource drifted from its deployed template.`,
+
+		Example: `
+  Show the drifted resources of the "test" environment.
+  /code $ copilot env drift -n test
+  Fail a CI job if the "prod" environment drifted.
+  /code $ copilot env drift -a myapp -n prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"prompts for application and environment": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				sel.EXPECT().Application(envDriftAppNamePrompt, envShowAppNameHelpPrompt).Return("phonetool", nil)
+				sel.EXPECT().Environment(gomock.Any(), envDriftHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"validates the flags": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"returns error if environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"returns error if fail to select environment": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				sel.EXPECT().Environment(gomock.Any(), envDriftHelpPrompt, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(store, sel)
+
+			opts := &envDriftOpts{
+				envDriftVars: envDriftVars{
+					appName: tc.inApp,
+					name:    tc.inEnv,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.name)
+		})
+	}
+}
+
+func TestEnvDrift_Execute(t *testing.T) {
+	inSyncReport := &describe.StackDriftReport{
+		Stacks: []describe.StackDrift{
+			{Name: "phonetool-test", Status: "IN_SYNC"},
+		},
+	}
+	driftedReport := &describe.StackDriftReport{
+		Stacks: []describe.StackDrift{
+			{
+				Name:   "phonetool-test",
+				Status: "DRIFTED",
+				Resources: []*stack.ResourceDrift{
+					{
+						LogicalID: "EnvironmentSecurityGroup",
+						Type:      "AWS::EC2::SecurityGroup",
+						Status:    "MODIFIED",
+					},
+				},
+			},
+		},
+	}
+	undetectedReport := &describe.StackDriftReport{
+		Stacks: []describe.StackDrift{
+			{Name: "phonetool-test", Status: "IN_SYNC"},
+			{Name: "phonetool-test-AddonsStack-1ABCDEF", Status: "UNKNOWN", Reason: "Failed to detect drift on resource"},
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress)
+
+		wantedContent  string
+		wantedExitCode int
+		wantedError    error
+	}{
+		"returns error if fail to describe drift": {
+			setupMocks: func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {
+				prog.EXPECT().Start("Detecting drift of environment test.")
+				d.EXPECT().Describe().Return(nil, errors.New("some error"))
+				prog.EXPECT().Stop(log.Serrorf("Failed to detect drift of environment test.\n"))
+			},
+			wantedError: errors.New("describe drift of environment test: some error"),
+		},
+		"prints the report of stacks in sync": {
+			setupMocks: func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {
+				prog.EXPECT().Start("Detecting drift of environment test.")
+				d.EXPECT().Describe().Return(inSyncReport, nil)
+				prog.EXPECT().Stop(log.Ssuccessf("Detected drift of environment test.\n"))
+			},
+			wantedContent: inSyncReport.HumanString(),
+		},
+		"prints the report in JSON and exits with a non-zero code if stacks drifted": {
+			inJSON: true,
+			setupMocks: func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {
+				prog.EXPECT().Start("Detecting drift of environment test.")
+				d.EXPECT().Describe().Return(driftedReport, nil)
+				prog.EXPECT().Stop(log.Ssuccessf("Detected drift of environment test.\n"))
+			},
+			wantedContent:  `{"stacks":[{"name":"phonetool-test","status":"DRIFTED","resources":[{"logicalID":"EnvironmentSecurityGroup","physicalID":"","type":"AWS::EC2::SecurityGroup","status":"MODIFIED"}]}]}` + "\n",
+			wantedExitCode: 2,
+			wantedError:    errors.New("environment test drifted from its deployed CloudFormation template"),
+		},
+		"prints the report and exits with a distinct code if the drift of a stack could not be determined": {
+			setupMocks: func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {
+				prog.EXPECT().Start("Detecting drift of environment test.")
+				d.EXPECT().Describe().Return(undetectedReport, nil)
+				prog.EXPECT().Stop(log.Ssuccessf("Detected drift of environment test.\n"))
+			},
+			wantedContent:  undetectedReport.HumanString(),
+			wantedExitCode: 3,
+			wantedError:    errors.New("could not determine the drift of environment test: drift detection did not complete for stacks phonetool-test-AddonsStack-1ABCDEF"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := mocks.NewMockstackDriftDescriber(ctrl)
+			prog := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(d, prog)
+			b := &bytes.Buffer{}
+
+			opts := &envDriftOpts{
+				envDriftVars: envDriftVars{
+					appName:          "phonetool",
+					name:             "test",
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:                  b,
+				prog:               prog,
+				describer:          d,
+				initDriftDescriber: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedExitCode != 0 {
+				var exitCodeErr interface{ ExitCode() int }
+				require.ErrorAs(t, err, &exitCodeErr)
+				require.Equal(t, tc.wantedExitCode, exitCodeErr.ExitCode())
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
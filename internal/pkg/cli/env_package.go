@@ -53,6 +53,12 @@ func (df discardFile) Close() error {
 	return nil // noop
 }
 
+// Fd returns a dummy value, like cursor.fakeFileWriter, so that discardFile can be used in place of a terminal
+// without moving the cursor of the actual terminal.
+func (df discardFile) Fd() uintptr {
+	return 0
+}
+
 type packageEnvOpts struct {
 	packageEnvVars
 
@@ -113,7 +119,7 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		if err != nil {
 			return nil, err
 		}
-		ovrdr, err := deploy.NewOverrider(ws.EnvOverridesPath(), envCfg.App, envCfg.Name, fs, sessProvider)
+		ovrdr, err := deploy.NewOverrider(ws.EnvOverridesPath(), envCfg.App, envCfg.Name, fs, sessProvider, log.DiagnosticWriter)
 		if err != nil {
 			return nil, err
 		}
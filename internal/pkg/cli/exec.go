@@ -3,8 +3,21 @@
 
 package cli
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
 const (
 	defaultCommand = "/bin/sh"
+
+	// minEnvVersionForPortForward is the first environment version whose manager role can start port forwarding sessions.
+	minEnvVersionForPortForward = "v1.18.0"
 )
 
 type execVars struct {
@@ -14,5 +27,68 @@ type execVars struct {
 	command          string
 	taskID           string
 	containerName    string
+	portForward      string // Formatted as <local port>:<remote port>.
+	remoteHost       string
+	allTasks         bool
+	shouldOutputJSON bool
 	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
 }
+
+func (v execVars) validatePortForward() error {
+	if v.portForward == "" {
+		if v.remoteHost != "" {
+			return fmt.Errorf("--%s must be specified with --%s", portForwardFlag, remoteHostFlag)
+		}
+		return nil
+	}
+	_, _, err := parsePortForward(v.portForward)
+	return err
+}
+
+// validatePortForwardEnvCompatible returns an error if the manager role of the environment can't start port forwarding sessions.
+func (v execVars) validatePortForwardEnvCompatible(newChecker func() (versionCompatibilityChecker, error), feature string) error {
+	checker, err := newChecker()
+	if err != nil {
+		return err
+	}
+	return validateMinEnvVersion(nil, checker, v.appName, v.envName, minEnvVersionForPortForward, feature)
+}
+
+// forwardPort forwards a local port through the container of the task until the session is terminated.
+func (v execVars) forwardPort(forwarder ecsPortForwarder, cluster, taskID, container string) error {
+	localPort, remotePort, err := parsePortForward(v.portForward)
+	if err != nil {
+		return err
+	}
+	target := fmt.Sprintf("port %s of container %s", remotePort, color.HighlightUserInput(container))
+	if v.remoteHost != "" {
+		target = fmt.Sprintf("%s through container %s", color.HighlightUserInput(fmt.Sprintf("%s:%s", v.remoteHost, remotePort)), color.HighlightUserInput(container))
+	}
+	log.Infof("Forward %s to %s in task %s. Press Ctrl+C to stop.\n",
+		color.HighlightUserInput(fmt.Sprintf("localhost:%s", localPort)), target, color.HighlightResource(taskID))
+	if err := forwarder.ForwardPort(awsecs.ForwardPortInput{
+		Cluster:    cluster,
+		Task:       taskID,
+		Container:  container,
+		LocalPort:  localPort,
+		RemotePort: remotePort,
+		RemoteHost: v.remoteHost,
+	}); err != nil {
+		return fmt.Errorf("forward local port %s to container %s: %w", localPort, container, err)
+	}
+	return nil
+}
+
+// parsePortForward parses a port mapping formatted as <local port>:<remote port>.
+func parsePortForward(mapping string) (localPort, remotePort string, err error) {
+	ports := strings.Split(mapping, ":")
+	if len(ports) != 2 {
+		return "", "", fmt.Errorf(`--%s %q must be formatted as "<local port>:<remote port>"`, portForwardFlag, mapping)
+	}
+	for _, port := range ports {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return "", "", fmt.Errorf("invalid port %q in --%s: must be a number between 1 and 65535", port, portForwardFlag)
+		}
+	}
+	return ports[0], ports[1], nil
+}
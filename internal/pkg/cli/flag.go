@@ -29,6 +29,7 @@ const (
 	allFlag          = "all"
 	forceFlag        = "force"
 	noRollbackFlag   = "no-rollback"
+	diffFlag         = "diff"
 	manifestFlag     = "manifest"
 	resourceTagsFlag = "resource-tags"
 
@@ -52,9 +53,20 @@ const (
 	logGroupFlag                = "log-group"
 	containerLogFlag            = "container"
 	includeStateMachineLogsFlag = "include-state-machine"
+	filterPatternFlag           = "filter-pattern"
+	logsQueryFlag               = "query"
+	waitFlag                    = "wait"
+	showLogsFlag                = "logs"
 	resourcesFlag               = "resources"
 	taskIDFlag                  = "task-id"
 	containerFlag               = "container"
+	portForwardFlag             = "port-forward"
+	remoteHostFlag              = "remote-host"
+	allTasksFlag                = "all-tasks"
+	toRevisionFlag              = "to"
+	minCountFlag                = "min"
+	maxCountFlag                = "max"
+	writeManifestFlag           = "write-manifest"
 
 	// Flags for CI/CD.
 	githubURLFlag         = "github-url"
@@ -63,6 +75,9 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	pipelineTypeFlag      = "pipeline-type"
+	stageFlag             = "stage"
+	commentFlag           = "comment"
+	watchFlag             = "watch"
 
 	// Flags for ls.
 	localFlag = "local"
@@ -80,6 +95,8 @@ const (
 	storageRDSEngineFlag               = "engine"
 	storageRDSInitialDBFlag            = "initial-db"
 	storageRDSParameterGroupFlag       = "parameter-group"
+	storageRedisEngineFlag             = "cache-engine"
+	storageRedisModeFlag               = "cache-mode"
 
 	// Flags for one-off tasks.
 	taskGroupNameFlag            = "task-group-name"
@@ -102,6 +119,7 @@ const (
 	generateCommandFlag          = "generate-cmd"
 	osFlag                       = "platform-os"
 	archFlag                     = "platform-arch"
+	buildPlatformsFlag           = "build-platforms"
 
 	// Flags for environment configurations.
 	vpcIDFlag                      = "import-vpc-id"
@@ -123,10 +141,12 @@ const (
 	sessionTokenFlag    = "aws-session-token"
 	regionFlag          = "region"
 
-	// Flags for creating secrets.
+	// Flags for managing secrets.
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
+	decryptFlag       = "decrypt"
+	secretStoreFlag   = "store"
 
 	// Other.
 	svcPortFlag             = "port"
@@ -209,8 +229,10 @@ Cannot be specified with --%s or --%s.`, appFlag, envFlag)
 Cannot be specified with --%s, --%s or --%s.`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
 	taskAppFlagDescription = fmt.Sprintf(`Optional. Name of the application.
 Cannot be specified with --%s, --%s or --%s.`, taskDefaultFlag, subnetsFlag, securityGroupsFlag)
-	osFlagDescription   = fmt.Sprintf(`Optional. Operating system of the task. Must be specified along with '%s'.`, archFlag)
-	archFlagDescription = fmt.Sprintf(`Optional. Architecture of the task. Must be specified along with '%s'.`, osFlag)
+	osFlagDescription             = fmt.Sprintf(`Optional. Operating system of the task. Must be specified along with '%s'.`, archFlag)
+	archFlagDescription           = fmt.Sprintf(`Optional. Architecture of the task. Must be specified along with '%s'.`, osFlag)
+	buildPlatformsFlagDescription = fmt.Sprintf(`Optional. Platforms to build a multi-platform image for with docker buildx.
+For example: linux/amd64,linux/arm64. The task runs on the first platform unless '%s' and '%s' are specified.`, osFlag, archFlag)
 
 	secretNameFlagDescription = fmt.Sprintf(`The name of the secret.
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
@@ -220,6 +242,7 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
+For an S3 object or an ECR image, the s3://{bucket}/{key} or {registry}/{repository}:{tag} URL.
 Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
@@ -233,6 +256,7 @@ const (
 	svcFlagDescription          = "Name of the service."
 	jobFlagDescription          = "Name of the job."
 	workloadFlagDescription     = "Name of the service or job."
+	workloadsFlagDescription    = "Names of the services or jobs."
 	nameFlagDescription         = "Name of the service, job, or task group."
 	yesFlagDescription          = "Skips confirmation prompt."
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
@@ -241,11 +265,16 @@ Allows you to categorize resources.`
 	// Deployment.
 	deployTestFlagDescription = `Deploy your service or job to a "test" environment.`
 	forceFlagDescription      = "Optional. Force a new service deployment using the existing image."
+	deployAllFlagDescription  = "Optional. Deploy all services and jobs in the workspace."
 	noRollbackFlagDescription = `Optional. Disable automatic stack 
 rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
 	forceEnvDeployFlagDescription = "Optional. Force update the environment stack template."
+	diffFlagDescription           = `Optional. Show the differences against the deployed
+CloudFormation template and the resources that will change,
+then prompt for confirmation before deploying.`
+	diffYesFlagDescription = "Optional. Skip the confirmation of --diff and deploy."
 
 	// Operational.
 	jsonFlagDescription = "Optional. Output in JSON format."
@@ -264,8 +293,23 @@ Defaults to all logs. Only one of start-time / since may be used.`
 Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
+	jobHistoryLastFlagDescription          = "Optional. The number of most recent executions of the job to show."
+	svcDeploymentsLastFlagDescription      = "Optional. The number of most recent deployments of the service to show."
+	toRevisionFlagDescription              = "Revision of the service to roll back to, as listed by svc deployments."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
 	containerLogFlagDescription            = "Optional. Return only logs from a specific container."
+	filterPatternFlagDescription           = `Optional. Only return log events that match a CloudWatch Logs filter pattern.
+The events are filtered by CloudWatch Logs before they are downloaded,
+and the earliest matching events in the time range are returned up to the limit.`
+	logsQueryFlagDescription = `Optional. Run a CloudWatch Logs Insights query against the log group
+and print the resulting rows. Searches the last hour unless any time filtering
+flags are set. Cannot be used with --follow or --filter-pattern.`
+	jobRunCommandFlagDescription = `Optional. The command that overrides the default command of the job's
+main container for this execution.`
+	waitFlagDescription = `Optional. Wait for the execution of the job to stop and render its state transitions.
+Exits with an error if the execution fails, times out or is aborted.`
+	showLogsFlagDescription = `Optional. Print the logs of the job's tasks once the execution stops.
+Must be used with --wait.`
 
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
@@ -282,6 +326,21 @@ output the manifest file used for that deployment.`
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	portForwardFlagDescription = `Optional. Forward a local port to a port of the container instead of running a command.
+Specified as <local port>:<remote port>, for example "8080:80".`
+	remoteHostFlagDescription = `Optional. Forward the local port to a host reachable from the container, such as a database endpoint.
+Must be specified with --port-forward.`
+	allTasksFlagDescription = `Optional. Run the command non-interactively in every running task of the service
+and collect the output of each task. Must be specified with --command.`
+	execJSONFlagDescription = "Optional. Output the results of --all-tasks in JSON format."
+
+	svcScaleCountFlagDescription = `Optional. The number of tasks the service should run.
+Cannot be used with services that scale automatically.`
+	svcScaleMinFlagDescription = `Optional. The minimum number of tasks, or instances for a Request-Driven Web Service,
+that the service should scale in to.`
+	svcScaleMaxFlagDescription = `Optional. The maximum number of tasks, or instances for a Request-Driven Web Service,
+that the service should scale out to.`
+	writeManifestFlagDescription = "Optional. Write the new values to the environment override of the service's manifest."
 
 	// Build.
 	imageTagFlagDescription     = `Optional. The container image tag.`
@@ -290,12 +349,17 @@ Uploaded asset locations are filled in the template configuration.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 
 	// CI/CD.
-	pipelineFlagDescription          = "Name of the pipeline."
-	githubURLFlagDescription         = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
-	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
-	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
-	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
-	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	pipelineFlagDescription              = "Name of the pipeline."
+	githubURLFlagDescription             = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
+	githubAccessTokenFlagDescription     = "GitHub personal access token for your repository."
+	gitBranchFlagDescription             = "Branch used to trigger your pipeline."
+	pipelineEnvsFlagDescription          = "Environments to add to the pipeline."
+	pipelineTypeFlagDescription          = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	pipelineRetryStageFlagDescription    = "Name of the failed stage to retry."
+	pipelineApprovalStageFlagDescription = "Name of the stage waiting for approval."
+	pipelineCommentFlagDescription       = "Optional. Comment to record with the approval or rejection."
+	pipelineWatchFlagDescription         = `Optional. Watch the execution of the pipeline until it finishes.
+Exits with an error if the execution fails or is stopped.`
 
 	// Storage.
 	storageFlagDescription             = "Name of the storage resource to create."
@@ -314,6 +378,10 @@ Must be either "v1" or "v2".`
 Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRedisEngineFlagDescription       = `The engine used by the cache.
+Must be either "Redis" or "Valkey".`
+	storageRedisModeFlagDescription = `Whether to deploy a replication group or a serverless cache.
+Must be either "cluster" or "serverless".`
 
 	// One-off tasks.
 	countFlagDescription         = "Optional. The number of tasks to set up."
@@ -381,6 +449,11 @@ AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021
 are also accepted.`
 	upgradeAllEnvsDescription          = "Optional. Upgrade all environments."
 	secretOverwriteFlagDescription     = "Optional. Whether to overwrite an existing secret."
+	secretFlagDescription              = "Name of the secret."
+	secretDecryptFlagDescription       = "Optional. Show the decrypted value of the secret in each environment."
+	secretStoreFlagDescription         = `Optional. Where to store the secret. Must be one of "ssm" or "secretsmanager".`
+	secretDiffEnvsFlagDescription      = "Optional. Environments to compare. Defaults to every environment of the application."
+	secretRmAllFlagDescription         = "Optional. Delete the secret from every environment of the application."
 	permissionsBoundaryFlagDescription = `Optional. The name of an existing IAM policy with which to set a
 permissions boundary for all roles generated within the application.`
 	prodEnvFlagDescription = "If the environment contains production services."
@@ -10,6 +10,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -20,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -329,6 +332,8 @@ type wsEnvironmentReader interface {
 type wsPipelineReader interface {
 	wsPipelineGetter
 	relPath
+	manifestReader
+	ZipCopilotDir() ([]byte, error)
 }
 
 type wsPipelineGetter interface {
@@ -349,6 +354,11 @@ type uploader interface {
 	Upload(bucket, key string, data io.Reader) (string, error)
 }
 
+type pipelineSourceBucketDescriber interface {
+	IsBucketVersioned(bucket string) (bool, error)
+	IsEventBridgeEnabled(bucket string) (bool, error)
+}
+
 type bucketEmptier interface {
 	EmptyBucket(bucket string) error
 }
@@ -402,6 +412,15 @@ type appResourcesGetter interface {
 	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
 }
 
+type serviceStackDeployer interface {
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+}
+
+type revisionStore interface {
+	Get(env, workload string, number int) (*revision.Revision, error)
+	Record(env, workload string, rev *revision.Revision) error
+}
+
 type taskDeployer interface {
 	DeployTask(input *deploy.CreateTaskResourcesInput, opts ...awscloudformation.StackOption) error
 	GetTaskStack(taskName string) (*deploy.TaskStackInfo, error)
@@ -442,6 +461,10 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type stackDriftDescriber interface {
+	Describe() (*describe.StackDriftReport, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
@@ -466,6 +489,24 @@ type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
 
+type pipelineStateGetter interface {
+	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+}
+
+type pipelineExecutionStarter interface {
+	StartPipelineExecution(pipelineName string) (string, error)
+}
+
+type pipelineStageRetrier interface {
+	pipelineStateGetter
+	RetryFailedActions(pipelineName, stageName, executionID string) (string, error)
+}
+
+type pipelineApprover interface {
+	pipelineStateGetter
+	PutApprovalResult(pipelineName, stageName string, action codepipeline.StageAction, approved bool, summary string) error
+}
+
 type deployedPipelineLister interface {
 	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
 }
@@ -585,6 +626,11 @@ type apprunnerServiceDescriber interface {
 
 type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
+	ExecuteCommandWithOutput(in awsecs.ExecuteCommandInput, out io.Writer) error
+}
+
+type ecsPortForwarder interface {
+	ForwardPort(in awsecs.ForwardPortInput) error
 }
 
 type ssmPluginManager interface {
@@ -625,6 +671,24 @@ type dockerEngine interface {
 	GetPlatform() (string, string, error)
 }
 
+type localContainerRunner interface {
+	dockerEngine
+	Build(in *dockerengine.BuildArguments) error
+	CreateNetwork(name string) error
+	NetworkExists(name string) (bool, error)
+	RemoveNetwork(name string) error
+	Run(in *dockerengine.RunOptions) error
+	Stop(containerName string) error
+}
+
+type secretValueGetter interface {
+	GetSecretValue(name string) (string, error)
+}
+
+type serviceDiscoveryEndpointGetter interface {
+	ServiceDiscoveryEndpoint() (string, error)
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -641,16 +705,58 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretsManagerSecretPutter interface {
+	PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error)
+}
+
+type secretParameterDeleter interface {
+	DeleteSecret(name string) error
+}
+
+type secretDescriber interface {
+	DescribeSecret(name string, decrypt bool) (describe.HumanJSONStringer, error)
+}
+
+type secretsDiffer interface {
+	Diff() (describe.HumanJSONStringer, error)
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
 
+type ecsServiceScaler interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+	UpdateServiceDesiredCount(app, env, svc string, count int64) error
+}
+
+type ecsScalableTargetUpdater interface {
+	ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error)
+	UpdateECSServiceScalableTarget(cluster, service string, target aas.ScalableTarget) error
+}
+
+type apprunnerAutoScalingUpdater interface {
+	ServiceAutoScalingConfiguration(svcARN string) (*apprunner.AutoScalingConfiguration, error)
+	CreateAutoScalingConfiguration(in apprunner.CreateAutoScalingConfigurationInput) (*apprunner.AutoScalingConfiguration, error)
+	UpdateServiceAutoScalingConfiguration(svcARN, configARN string) error
+}
+
+type wsWorkloadManifestOverwriter interface {
+	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
+	OverwriteWorkloadManifest(mft workspace.WorkloadManifest, name string) (string, error)
+}
+
 type interpolator interface {
 	Interpolate(s string) (string, error)
 }
 
 type workloadDeployer interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
+	UploadPreviewArtifacts() (*clideploy.UploadArtifactsOutput, error)
+	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
+		*clideploy.GenerateCloudFormationTemplateOutput, error)
+	DeployDiff(template string) (string, error)
+	PreviewChangeSet(in *clideploy.DeployWorkloadInput) (string, error)
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 	IsServiceAvailableInRegion(region string) (bool, error)
 }
@@ -663,10 +769,13 @@ type workloadStackGenerator interface {
 }
 
 type runner interface {
-	Run() error
+	Run() (string, error)
 }
 
 type envDeployer interface {
+	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (*clideploy.GenerateCloudFormationTemplateOutput, error)
+	DeployDiff(template string) (string, error)
+	PreviewChangeSet(in *clideploy.DeployEnvironmentInput) (string, error)
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
 	Validate(*manifest.Environment) error
 	UploadArtifacts() (*clideploy.UploadEnvArtifactsOutput, error)
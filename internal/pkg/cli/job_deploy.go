@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -14,7 +15,6 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
-	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +25,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -43,6 +45,8 @@ type deployJobOpts struct {
 	envFeaturesDescriber versionCompatibilityChecker
 	sel                  wsSelector
 	gitShortCommit       string
+	output               termprogress.FileWriter // Where the progress of the deployment is written, defaults to stderr.
+	diagnostics          io.Writer               // Where the messages of the deployment are written, defaults to the diagnostic writer.
 
 	// cached variables
 	targetApp         *config.Application
@@ -87,7 +91,7 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider, log.WriterOrDiagnostic(o.diagnostics))
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +110,8 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 		RawMft:           raw,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		Output:           o.output,
+		DiagnosticWriter: o.diagnostics,
 	}
 	var deployer workloadDeployer
 	switch t := content.(type) {
@@ -151,6 +157,7 @@ func (o *deployJobOpts) Ask() error {
 
 // Execute builds and pushes the container image for the job.
 func (o *deployJobOpts) Execute() error {
+	logger := log.New(log.WriterOrDiagnostic(o.diagnostics))
 	if !o.clientConfigured {
 		if err := o.configureClients(); err != nil {
 			return err
@@ -182,7 +189,7 @@ func (o *deployJobOpts) Execute() error {
 	}
 
 	if !serviceInRegion {
-		log.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
+		logger.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
 `, o.targetEnv.Region)
 	}
 	uploadOut, err := deployer.UploadArtifacts()
@@ -205,7 +212,7 @@ func (o *deployJobOpts) Execute() error {
 		if o.disableRollback {
 			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.name)
 			rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stackName, o.targetEnv.ExecutionRoleARN)
-			log.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can visit the AWS console to inspect the errors.
+			logger.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can visit the AWS console to inspect the errors.
 After fixing the deployment, you can:
 1. Run %s to rollback the deployment.
 2. Run %s to make a new deployment.
@@ -213,7 +220,7 @@ After fixing the deployment, you can:
 		}
 		return fmt.Errorf("deploy job %s to environment %s: %w", o.name, o.envName, err)
 	}
-	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
+	logger.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
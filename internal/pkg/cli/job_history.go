@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobHistoryNamePrompt     = "Which job's history would you like to show?"
+	jobHistoryNameHelpPrompt = "The recent executions of the indicated deployed job will be shown."
+
+	defaultJobHistoryExecutionLimit = 10
+)
+
+type jobHistoryVars struct {
+	shouldOutputJSON bool
+	name             string
+	envName          string
+	appName          string
+	last             int // The number of most recent executions to show.
+}
+
+type jobHistoryOpts struct {
+	jobHistoryVars
+
+	w                    io.Writer
+	store                store
+	sel                  deploySelector
+	historyDescriber     describer
+	initHistoryDescriber func(*jobHistoryOpts) error // Overridden in tests.
+
+	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
+}
+
+func newJobHistoryOpts(vars jobHistoryVars) (*jobHistoryOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job history"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &jobHistoryOpts{
+		jobHistoryVars: vars,
+		w:              log.OutputWriter,
+		store:          configStore,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initHistoryDescriber: func(o *jobHistoryOpts) error {
+			d, err := describe.NewJobHistoryDescriber(&describe.NewJobHistoryConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Job:         o.name,
+				Limit:       o.last,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create history describer for job %s in application %s: %w", o.name, o.appName, err)
+			}
+			o.historyDescriber = d
+			return nil
+		},
+	}
+	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		return envDescriber, nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *jobHistoryOpts) Validate() error {
+	if o.last <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", lastFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *jobHistoryOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskJobEnvName()
+}
+
+// Execute displays the recent executions of the job.
+func (o *jobHistoryOpts) Execute() error {
+	if err := o.validateEnvCompatible(); err != nil {
+		return err
+	}
+	if err := o.initHistoryDescriber(o); err != nil {
+		return err
+	}
+	history, err := o.historyDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe history of job %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := history.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, history.HumanString())
+	}
+	return nil
+}
+
+// validateEnvCompatible returns an error if the environment manager role can't list the executions of the job.
+func (o *jobHistoryOpts) validateEnvCompatible() error {
+	const minEnvVersionForJobHistory = "v1.16.0"
+	envStack, err := o.newEnvCompatibilityChecker()
+	if err != nil {
+		return err
+	}
+	return validateMinEnvVersion(nil, envStack, o.appName, o.envName, minEnvVersionForJobHistory, "job history")
+}
+
+func (o *jobHistoryOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(jobAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobHistoryOpts) validateAndAskJobEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedJob, err := o.sel.DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// buildJobHistoryCmd builds the command for showing the recent executions of a deployed job.
+func buildJobHistoryCmd() *cobra.Command {
+	vars := jobHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the recent executions of a deployed job.",
+		Long: `Shows the recent executions of a deployed job.
+Each execution is listed with its start time, duration, status, failure cause and the ID of its ECS task.`,
+		Example: `
+  Shows the 10 most recent executions of the job "my-job" in environment "test".
+  /code $ copilot job history -n my-job -e test
+  Shows the last 3 executions of the job in JSON format.
+  /code $ copilot job history -n my-job -e test --last 3 --json
+  Displays the logs of a failed execution using the task ID from the history.
+  /code $ copilot job logs -n my-job -e test --tasks 709c7ea`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.last, lastFlag, defaultJobHistoryExecutionLimit, jobHistoryLastFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
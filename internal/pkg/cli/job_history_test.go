@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobHistory_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputLast int
+
+		wantedError error
+	}{
+		"errors if --last is not positive": {
+			inputLast:   0,
+			wantedError: errors.New("--last must be greater than 0"),
+		},
+		"success": {
+			inputLast: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					last: tc.inputLast,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type jobHistoryAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestJobHistory_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		setupMocks func(m jobHistoryAskMock)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"validate app env and job with all flags passed in": {
+			inputApp: "phonetool",
+			inputJob: "report",
+			inputEnv: "test",
+			setupMocks: func(m jobHistoryAskMock) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"prompt for app, env and job": {
+			setupMocks: func(m jobHistoryAskMock) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"errors if failed to select application": {
+			setupMocks: func(m jobHistoryAskMock) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, wkldAppNameHelpPrompt).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed job": {
+			inputApp: "phonetool",
+			setupMocks: func(m jobHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("select deployed jobs for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobHistoryAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName: tc.inputApp,
+					envName: tc.inputEnv,
+					name:    tc.inputJob,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedJob, opts.name)
+		})
+	}
+}
+
+func TestJobHistory_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	history := &describe.JobHistory{
+		Executions: []describe.JobExecution{
+			{
+				Name:      "nightly",
+				Status:    "SUCCEEDED",
+				StartedAt: time.Date(2022, time.March, 1, 2, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		envVersion       string
+		setupMocks       func(m *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the environment can't list the executions of the job": {
+			envVersion:  "v1.15.0",
+			setupMocks:  func(m *mocks.Mockdescriber) {},
+			wantedError: fmt.Errorf(`environment "test" is on version "v1.15.0" which does not support the "job history" feature`),
+		},
+		"errors if failed to describe the history of the job": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe history of job report: some error"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(history, nil)
+			},
+			wantedContent: `{"executions":[{"name":"nightly","status":"SUCCEEDED","startedAt":"2022-03-01T02:00:00Z"}]}
+`,
+		},
+		"success with human output": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(history, nil)
+			},
+			wantedContent: history.HumanString(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			mockEnvChecker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envVersion := "v1.16.0"
+			if tc.envVersion != "" {
+				envVersion = tc.envVersion
+			}
+			mockEnvChecker.EXPECT().Version().Return(envVersion, nil)
+
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName:          "phonetool",
+					envName:          "test",
+					name:             "report",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				historyDescriber:     mockDescriber,
+				initHistoryDescriber: func(*jobHistoryOpts) error { return nil },
+				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
+					return mockEnvChecker, nil
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -140,7 +140,7 @@ func (o *jobLogsOpts) Validate() error {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
 
-	return nil
+	return o.validateFilters()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -180,6 +180,8 @@ func (o *jobLogsOpts) Execute() error {
 		OnEvents:                eventsWriter,
 		LogStreamLimit:          logStreamLimit,
 		IncludeStateMachineLogs: o.includeStateMachineLogs,
+		FilterPattern:           o.filterPattern,
+		Query:                   o.query,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for job %s: %w", o.name, err)
@@ -250,7 +252,11 @@ func buildJobLogsCmd() *cobra.Command {
   Displays logs in real time.
   /code $ copilot job logs --follow
   Displays container logs and state machine execution logs from the last execution.
-  /code $ copilot job logs --include-state-machine --last 1`,
+  /code $ copilot job logs --include-state-machine --last 1
+  Displays the logs of the last execution that contain "ERROR".
+  /code $ copilot job logs --filter-pattern ERROR
+  Displays the 20 most recent log events that contain "ERROR" in the last week.
+  /code $ copilot job logs --since 168h --query "fields @timestamp, @message | filter @message like /ERROR/ | sort @timestamp desc | limit 20"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobLogOpts(vars)
 			if err != nil {
@@ -271,6 +277,8 @@ func buildJobLogsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.last, lastFlag, 1, lastFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().BoolVar(&vars.includeStateMachineLogs, includeStateMachineLogsFlag, false, includeStateMachineLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, filterPatternFlag, "", filterPatternFlagDescription)
+	cmd.Flags().StringVar(&vars.query, logsQueryFlag, "", logsQueryFlagDescription)
 
 	// There's no way to associate a specific execution with a task without parsing the logs of every state machine invocation.
 	cmd.MarkFlagsMutuallyExclusive(includeStateMachineLogsFlag, tasksFlag)
@@ -38,6 +38,7 @@ func TestJobLogs_Validate(t *testing.T) {
 		inputEndTime      string
 		inputSince        time.Duration
 		inputStateMachine bool
+		inputQuery        string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -132,6 +133,14 @@ func TestJobLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
 		},
+		"returns error if both follow and query flags are defined": {
+			inputFollow: true,
+			inputQuery:  "stats count(*)",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --follow and --query"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -153,6 +162,7 @@ func TestJobLogs_Validate(t *testing.T) {
 						since:          tc.inputSince,
 						name:           tc.inputSvc,
 						appName:        tc.inputApp,
+						query:          tc.inputQuery,
 					},
 					includeStateMachineLogs: tc.inputStateMachine,
 					last:                    tc.inputLast,
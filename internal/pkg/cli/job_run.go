@@ -4,31 +4,48 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/google/shlex"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 type jobRunVars struct {
 	appName string
 	envName string
 	jobName string
+
+	envVars map[string]string
+	secrets map[string]string
+	command string
+
+	wait     bool
+	showLogs bool
 }
 
 type jobRunOpts struct {
@@ -44,6 +61,10 @@ type jobRunOpts struct {
 
 	newRunner                  func() (runner, error)
 	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
+	newJobLogger               func() (logEventsWriter, error)
+	// waitForExecution renders the execution until it stops and returns its final description.
+	waitForExecution func(executionARN string) (*stream.StateMachineExecution, error)
+	now              func() time.Time
 }
 
 func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
@@ -68,6 +89,7 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 		ws:          ws,
 
 		sessProvider: sessProvider,
+		now:          time.Now,
 	}
 	opts.newRunner = func() (runner, error) {
 		sess, err := opts.envSession()
@@ -75,13 +97,20 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 			return nil, err
 		}
 
+		overrides, err := opts.overrides()
+		if err != nil {
+			return nil, err
+		}
 		return jobrunner.New(&jobrunner.Config{
 			App: opts.appName,
 			Env: opts.envName,
 			Job: opts.jobName,
 
+			Overrides: overrides,
+
 			CFN:          cloudformation.New(sess),
 			StateMachine: stepfunctions.New(sess),
+			ECS:          awsecs.New(sess),
 		}), nil
 	}
 	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
@@ -95,12 +124,30 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 		}
 		return envDescriber, nil
 	}
+	opts.newJobLogger = func() (logEventsWriter, error) {
+		sess, err := opts.envSession()
+		if err != nil {
+			return nil, err
+		}
+		return logging.NewJobLogger(&logging.NewWorkloadLoggerOpts{
+			Sess: sess,
+			App:  opts.appName,
+			Env:  opts.envName,
+			Name: opts.jobName,
+		}), nil
+	}
+	opts.waitForExecution = opts.renderExecution
 	return opts, nil
 }
 
-// Validate is a no-op for this command.
-// it's a no-op because all 3 flags are required, and `Validate` only validate optional flags.
+// Validate returns an error if the values provided by flags are invalid.
 func (o *jobRunOpts) Validate() error {
+	if o.showLogs && !o.wait {
+		return fmt.Errorf("--%s must be used with --%s", showLogsFlag, waitFlag)
+	}
+	if _, err := o.overrides(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -127,13 +174,109 @@ func (o *jobRunOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	if err := runner.Run(); err != nil {
+	startTime := o.now()
+	executionARN, err := runner.Run()
+	if err != nil {
 		return fmt.Errorf("execute job %q: %w", o.jobName, err)
 	}
 	log.Successf("Invoked job %q successfully\n", o.jobName)
+	if !o.wait {
+		return nil
+	}
+
+	execution, err := o.waitForExecution(executionARN)
+	if err != nil {
+		return fmt.Errorf("wait for execution of job %q: %w", o.jobName, err)
+	}
+	if o.showLogs {
+		if err := o.writeTaskLogs(execution.TaskARNs, startTime); err != nil {
+			return err
+		}
+	}
+	if execution.Status != sfn.ExecutionStatusSucceeded {
+		return &errJobExecutionFailed{
+			job:    o.jobName,
+			status: execution.Status,
+			cause:  execution.Cause,
+		}
+	}
+	log.Successf("Execution of job %q succeeded.\n", o.jobName)
 	return nil
 }
 
+// renderExecution streams the state transitions of the execution to the diagnostic writer until it stops.
+func (o *jobRunOpts) renderExecution(executionARN string) (*stream.StateMachineExecution, error) {
+	sess, err := o.envSession()
+	if err != nil {
+		return nil, err
+	}
+	streamer := stream.NewStateMachineExecutionStreamer(stepfunctions.New(sess), executionARN)
+	renderer := termprogress.ListeningStateMachineExecutionRenderer(streamer, termprogress.RenderOptions{})
+
+	// Keep track of the latest description to report the final status of the execution.
+	var last stream.StateMachineExecution
+	descriptions := streamer.Subscribe()
+	g, ctx := errgroup.WithContext(context.Background())
+	g.Go(func() error {
+		for desc := range descriptions {
+			last = desc
+		}
+		return nil
+	})
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		_, err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(os.Stderr), renderer)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &last, nil
+}
+
+func (o *jobRunOpts) writeTaskLogs(taskARNs []string, startTime time.Time) error {
+	if len(taskARNs) == 0 {
+		log.Infof("No tasks were started by the execution of job %q.\n", o.jobName)
+		return nil
+	}
+	var taskIDs []string
+	for _, arn := range taskARNs {
+		id, err := awsecs.TaskID(arn)
+		if err != nil {
+			return err
+		}
+		taskIDs = append(taskIDs, id)
+	}
+	logger, err := o.newJobLogger()
+	if err != nil {
+		return err
+	}
+	if err := logger.WriteLogEvents(logging.WriteLogEventsOpts{
+		StartTime:      aws.Int64(startTime.UnixMilli()),
+		TaskIDs:        taskIDs,
+		LogStreamLimit: len(taskIDs),
+		OnEvents:       logging.WriteHumanLogs,
+	}); err != nil {
+		return fmt.Errorf("write logs of job %q: %w", o.jobName, err)
+	}
+	return nil
+}
+
+// overrides returns the runtime overrides of the execution given by flags.
+func (o *jobRunOpts) overrides() (jobrunner.Overrides, error) {
+	command, err := shlex.Split(o.command)
+	if err != nil {
+		return jobrunner.Overrides{}, fmt.Errorf("split command %s into tokens using shell-style rules: %w", o.command, err)
+	}
+	return jobrunner.Overrides{
+		EnvVars: o.envVars,
+		Secrets: o.secrets,
+		Command: command,
+	}, nil
+}
+
 func (o *jobRunOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.configStore.GetApplication(o.appName)
@@ -204,7 +347,33 @@ func (o *jobRunOpts) validateEnvCompatible() error {
 	if err != nil {
 		return err
 	}
-	return validateMinEnvVersion(o.ws, envStack, o.appName, o.envName, "v1.12.0", "job run")
+	const (
+		minEnvVersionForJobRun        = "v1.12.0"
+		minEnvVersionForJobRunWait    = "v1.14.0" // The environment manager role can describe the executions of the state machine.
+		minEnvVersionForJobRunSecrets = "v1.15.0" // The environment manager role can register task definitions.
+	)
+	minEnvVersion, feature := minEnvVersionForJobRun, "job run"
+	if o.wait {
+		minEnvVersion, feature = minEnvVersionForJobRunWait, fmt.Sprintf("job run --%s", waitFlag)
+	}
+	if len(o.secrets) > 0 {
+		minEnvVersion, feature = minEnvVersionForJobRunSecrets, fmt.Sprintf("job run --%s", secretsFlag)
+	}
+	return validateMinEnvVersion(o.ws, envStack, o.appName, o.envName, minEnvVersion, feature)
+}
+
+type errJobExecutionFailed struct {
+	job    string
+	status string
+	cause  string
+}
+
+func (e *errJobExecutionFailed) Error() string {
+	msg := fmt.Sprintf("execution of job %q ended with status %s", e.job, e.status)
+	if e.cause == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, e.cause)
 }
 
 func buildJobRunCmd() *cobra.Command {
@@ -216,7 +385,11 @@ func buildJobRunCmd() *cobra.Command {
 		Long:  "Invoke a job in an environment.",
 		Example: `
   Run a job named "report-gen" in an application named "report" within a "test" environment
-  /code $ copilot job run -a report -n report-gen -e test`,
+  /code $ copilot job run -a report -n report-gen -e test
+  Run the job for a specific date with an overridden environment variable and command
+  /code $ copilot job run -n report-gen -e test --env-vars REPORT_DATE=2022-03-01 --command "python report.py --tenant acme"
+  Run the job, wait for it to finish and print the logs of its tasks
+  /code $ copilot job run -n report-gen -e test --wait --logs`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobRunOpts(vars)
 			if err != nil {
@@ -228,5 +401,10 @@ func buildJobRunCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, envVarsFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.secrets, secretsFlag, nil, secretsFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, "", jobRunCommandFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, waitFlagDescription)
+	cmd.Flags().BoolVar(&vars.showLogs, showLogsFlag, false, showLogsFlagDescription)
 	return cmd
 }
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +24,46 @@ type jobRunMock struct {
 	sel         *mocks.MockconfigSelector
 }
 
+func TestJobRun_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars      jobRunVars
+		wantedError error
+	}{
+		"valid without flags": {},
+		"valid to print logs while waiting": {
+			inVars: jobRunVars{wait: true, showLogs: true},
+		},
+		"error if logs are requested without waiting": {
+			inVars:      jobRunVars{showLogs: true},
+			wantedError: errors.New("--logs must be used with --wait"),
+		},
+		"valid with runtime overrides": {
+			inVars: jobRunVars{
+				envVars: map[string]string{"REPORT_DATE": "2022-03-01"},
+				secrets: map[string]string{"DB_PASS": "/copilot/app/test/secrets/db"},
+				command: `python report.py --tenant "acme corp"`,
+			},
+		},
+		"error if the command cannot be split into tokens": {
+			inVars:      jobRunVars{command: `python "report.py`},
+			wantedError: errors.New(`split command python "report.py into tokens using shell-style rules: EOF found when expecting closing quote`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobRunOpts{jobRunVars: tc.inVars}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestJobRun_Ask(t *testing.T) {
 	const (
 		inputApp = "my-app"
@@ -164,13 +209,19 @@ func TestJobRun_Execute(t *testing.T) {
 		jobName        string
 		mockjobRunner  func(ctrl *gomock.Controller) runner
 		mockEnvChecker func(ctrl *gomock.Controller) versionCompatibilityChecker
+		wait           bool
+		showLogs       bool
+		secrets        map[string]string
+		execution      *stream.StateMachineExecution
+		executionErr   error
+		mockLogger     func(ctrl *gomock.Controller) logEventsWriter
 		wantedError    error
 	}{
 		"successfully invoke job": {
 			jobName: "mockJob",
 			mockjobRunner: func(ctrl *gomock.Controller) runner {
 				m := mocks.NewMockrunner(ctrl)
-				m.EXPECT().Run().Return(nil)
+				m.EXPECT().Run().Return("mockExecutionARN", nil)
 				return m
 			},
 			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
@@ -183,7 +234,7 @@ func TestJobRun_Execute(t *testing.T) {
 			jobName: "mockJob",
 			mockjobRunner: func(ctrl *gomock.Controller) runner {
 				m := mocks.NewMockrunner(ctrl)
-				m.EXPECT().Run().Return(errors.New("some error"))
+				m.EXPECT().Run().Return("", errors.New("some error"))
 				return m
 			},
 			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
@@ -193,6 +244,93 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`execute job "mockJob": some error`),
 		},
+		"should return a wrapped error when the execution cannot be waited for": {
+			jobName: "mockJob",
+			wait:    true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return("mockExecutionARN", nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.14.0", nil)
+				return m
+			},
+			executionErr: errors.New("some error"),
+			wantedError:  errors.New(`wait for execution of job "mockJob": some error`),
+		},
+		"successfully wait for the execution to succeed": {
+			jobName: "mockJob",
+			wait:    true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return("mockExecutionARN", nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.14.0", nil)
+				return m
+			},
+			execution: &stream.StateMachineExecution{Status: sfn.ExecutionStatusSucceeded},
+		},
+		"should write the task logs and return an error when the execution fails": {
+			jobName:  "mockJob",
+			wait:     true,
+			showLogs: true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return("mockExecutionARN", nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.14.0", nil)
+				return m
+			},
+			execution: &stream.StateMachineExecution{
+				Status:   sfn.ExecutionStatusFailed,
+				Cause:    "Essential container in task exited",
+				TaskARNs: []string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"},
+			},
+			mockLogger: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					require.Equal(t, []string{"4082490ee6c245e09d2145010aa1ba8d"}, opts.TaskIDs)
+					require.Equal(t, 1, opts.LogStreamLimit)
+					require.Equal(t, aws.Int64(0), opts.StartTime)
+					return nil
+				})
+				return m
+			},
+			wantedError: errors.New(`execution of job "mockJob" ended with status FAILED: Essential container in task exited`),
+		},
+		"should return a wrapped error when the task logs cannot be written": {
+			jobName:  "mockJob",
+			wait:     true,
+			showLogs: true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().Run().Return("mockExecutionARN", nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.14.0", nil)
+				return m
+			},
+			execution: &stream.StateMachineExecution{
+				Status:   sfn.ExecutionStatusSucceeded,
+				TaskARNs: []string{"arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"},
+			},
+			mockLogger: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New(`write logs of job "mockJob": some error`),
+		},
 		"should return a wrapped error when environment version cannot be retrieved": {
 			appName: "finance",
 			envName: "test",
@@ -221,6 +359,36 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: errors.New(`environment "test" is on version "v1.11.0" which does not support the "job run" feature`),
 		},
+		"should return an error when environment template version is below v1.14.0 and the execution is waited for": {
+			appName: "finance",
+			envName: "test",
+			jobName: "report",
+			wait:    true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				return nil
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.13.0", nil)
+				return m
+			},
+			wantedError: errors.New(`environment "test" is on version "v1.13.0" which does not support the "job run --wait" feature`),
+		},
+		"should return an error when environment template version is below v1.15.0 and secrets are overridden": {
+			appName: "finance",
+			envName: "test",
+			jobName: "report",
+			secrets: map[string]string{"DB_PASS": "/copilot/finance/test/secrets/db"},
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				return nil
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.14.0", nil)
+				return m
+			},
+			wantedError: errors.New(`environment "test" is on version "v1.14.0" which does not support the "job run --secrets" feature`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -233,6 +401,10 @@ func TestJobRun_Execute(t *testing.T) {
 					appName: tc.appName,
 					envName: tc.envName,
 					jobName: tc.jobName,
+
+					wait:     tc.wait,
+					showLogs: tc.showLogs,
+					secrets:  tc.secrets,
 				},
 				newRunner: func() (runner, error) {
 					return tc.mockjobRunner(ctrl), nil
@@ -240,6 +412,16 @@ func TestJobRun_Execute(t *testing.T) {
 				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
 					return tc.mockEnvChecker(ctrl), nil
 				},
+				newJobLogger: func() (logEventsWriter, error) {
+					return tc.mockLogger(ctrl), nil
+				},
+				waitForExecution: func(executionARN string) (*stream.StateMachineExecution, error) {
+					require.Equal(t, "mockExecutionARN", executionARN)
+					return tc.execution, tc.executionErr
+				},
+				now: func() time.Time {
+					return time.Unix(0, 0)
+				},
 			}
 
 			err := jobRunOpts.Execute()
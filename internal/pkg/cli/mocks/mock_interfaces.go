@@ -10,6 +10,8 @@ import (
 	reflect "reflect"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -21,6 +23,7 @@ import (
 	deploy0 "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	revision "github.com/aws/copilot-cli/internal/pkg/deploy/revision"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -3482,6 +3485,21 @@ func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
 }
 
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsPipelineReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadWorkloadManifest), name)
+}
+
 // Rel mocks base method.
 func (m *MockwsPipelineReader) Rel(path string) (string, error) {
 	m.ctrl.T.Helper()
@@ -3497,6 +3515,21 @@ func (mr *MockwsPipelineReaderMockRecorder) Rel(path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineReader)(nil).Rel), path)
 }
 
+// ZipCopilotDir mocks base method.
+func (m *MockwsPipelineReader) ZipCopilotDir() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZipCopilotDir")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZipCopilotDir indicates an expected call of ZipCopilotDir.
+func (mr *MockwsPipelineReaderMockRecorder) ZipCopilotDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZipCopilotDir", reflect.TypeOf((*MockwsPipelineReader)(nil).ZipCopilotDir))
+}
+
 // MockwsPipelineGetter is a mock of wsPipelineGetter interface.
 type MockwsPipelineGetter struct {
 	ctrl     *gomock.Controller
@@ -3679,6 +3712,59 @@ func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
 }
 
+// MockpipelineSourceBucketDescriber is a mock of pipelineSourceBucketDescriber interface.
+type MockpipelineSourceBucketDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineSourceBucketDescriberMockRecorder
+}
+
+// MockpipelineSourceBucketDescriberMockRecorder is the mock recorder for MockpipelineSourceBucketDescriber.
+type MockpipelineSourceBucketDescriberMockRecorder struct {
+	mock *MockpipelineSourceBucketDescriber
+}
+
+// NewMockpipelineSourceBucketDescriber creates a new mock instance.
+func NewMockpipelineSourceBucketDescriber(ctrl *gomock.Controller) *MockpipelineSourceBucketDescriber {
+	mock := &MockpipelineSourceBucketDescriber{ctrl: ctrl}
+	mock.recorder = &MockpipelineSourceBucketDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineSourceBucketDescriber) EXPECT() *MockpipelineSourceBucketDescriberMockRecorder {
+	return m.recorder
+}
+
+// IsBucketVersioned mocks base method.
+func (m *MockpipelineSourceBucketDescriber) IsBucketVersioned(bucket string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBucketVersioned", bucket)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBucketVersioned indicates an expected call of IsBucketVersioned.
+func (mr *MockpipelineSourceBucketDescriberMockRecorder) IsBucketVersioned(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBucketVersioned", reflect.TypeOf((*MockpipelineSourceBucketDescriber)(nil).IsBucketVersioned), bucket)
+}
+
+// IsEventBridgeEnabled mocks base method.
+func (m *MockpipelineSourceBucketDescriber) IsEventBridgeEnabled(bucket string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEventBridgeEnabled", bucket)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEventBridgeEnabled indicates an expected call of IsEventBridgeEnabled.
+func (mr *MockpipelineSourceBucketDescriberMockRecorder) IsEventBridgeEnabled(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEventBridgeEnabled", reflect.TypeOf((*MockpipelineSourceBucketDescriber)(nil).IsEventBridgeEnabled), bucket)
+}
+
 // MockbucketEmptier is a mock of bucketEmptier interface.
 type MockbucketEmptier struct {
 	ctrl     *gomock.Controller
@@ -4243,6 +4329,100 @@ func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
 }
 
+// MockserviceStackDeployer is a mock of serviceStackDeployer interface.
+type MockserviceStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceStackDeployerMockRecorder
+}
+
+// MockserviceStackDeployerMockRecorder is the mock recorder for MockserviceStackDeployer.
+type MockserviceStackDeployerMockRecorder struct {
+	mock *MockserviceStackDeployer
+}
+
+// NewMockserviceStackDeployer creates a new mock instance.
+func NewMockserviceStackDeployer(ctrl *gomock.Controller) *MockserviceStackDeployer {
+	mock := &MockserviceStackDeployer{ctrl: ctrl}
+	mock.recorder = &MockserviceStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceStackDeployer) EXPECT() *MockserviceStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MockserviceStackDeployer) DeployService(conf cloudformation0.StackConfiguration, bucketName string, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MockserviceStackDeployerMockRecorder) DeployService(conf, bucketName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceStackDeployer)(nil).DeployService), varargs...)
+}
+
+// MockrevisionStore is a mock of revisionStore interface.
+type MockrevisionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevisionStoreMockRecorder
+}
+
+// MockrevisionStoreMockRecorder is the mock recorder for MockrevisionStore.
+type MockrevisionStoreMockRecorder struct {
+	mock *MockrevisionStore
+}
+
+// NewMockrevisionStore creates a new mock instance.
+func NewMockrevisionStore(ctrl *gomock.Controller) *MockrevisionStore {
+	mock := &MockrevisionStore{ctrl: ctrl}
+	mock.recorder = &MockrevisionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevisionStore) EXPECT() *MockrevisionStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockrevisionStore) Get(env, workload string, number int) (*revision.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", env, workload, number)
+	ret0, _ := ret[0].(*revision.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrevisionStoreMockRecorder) Get(env, workload, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrevisionStore)(nil).Get), env, workload, number)
+}
+
+// Record mocks base method.
+func (m *MockrevisionStore) Record(env, workload string, rev *revision.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", env, workload, rev)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockrevisionStoreMockRecorder) Record(env, workload, rev interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockrevisionStore)(nil).Record), env, workload, rev)
+}
+
 // MocktaskDeployer is a mock of taskDeployer interface.
 type MocktaskDeployer struct {
 	ctrl     *gomock.Controller
@@ -4880,6 +5060,44 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockstackDriftDescriber is a mock of stackDriftDescriber interface.
+type MockstackDriftDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDescriberMockRecorder
+}
+
+// MockstackDriftDescriberMockRecorder is the mock recorder for MockstackDriftDescriber.
+type MockstackDriftDescriberMockRecorder struct {
+	mock *MockstackDriftDescriber
+}
+
+// NewMockstackDriftDescriber creates a new mock instance.
+func NewMockstackDriftDescriber(ctrl *gomock.Controller) *MockstackDriftDescriber {
+	mock := &MockstackDriftDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDescriber) EXPECT() *MockstackDriftDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDriftDescriber) Describe() (*describe.StackDriftReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.StackDriftReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDriftDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDriftDescriber)(nil).Describe))
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -5128,6 +5346,187 @@ func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
 
+// MockpipelineStateGetter is a mock of pipelineStateGetter interface.
+type MockpipelineStateGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStateGetterMockRecorder
+}
+
+// MockpipelineStateGetterMockRecorder is the mock recorder for MockpipelineStateGetter.
+type MockpipelineStateGetterMockRecorder struct {
+	mock *MockpipelineStateGetter
+}
+
+// NewMockpipelineStateGetter creates a new mock instance.
+func NewMockpipelineStateGetter(ctrl *gomock.Controller) *MockpipelineStateGetter {
+	mock := &MockpipelineStateGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineStateGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineStateGetter) EXPECT() *MockpipelineStateGetterMockRecorder {
+	return m.recorder
+}
+
+// GetPipelineState mocks base method.
+func (m *MockpipelineStateGetter) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineState indicates an expected call of GetPipelineState.
+func (mr *MockpipelineStateGetterMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStateGetter)(nil).GetPipelineState), pipelineName)
+}
+
+// MockpipelineExecutionStarter is a mock of pipelineExecutionStarter interface.
+type MockpipelineExecutionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineExecutionStarterMockRecorder
+}
+
+// MockpipelineExecutionStarterMockRecorder is the mock recorder for MockpipelineExecutionStarter.
+type MockpipelineExecutionStarterMockRecorder struct {
+	mock *MockpipelineExecutionStarter
+}
+
+// NewMockpipelineExecutionStarter creates a new mock instance.
+func NewMockpipelineExecutionStarter(ctrl *gomock.Controller) *MockpipelineExecutionStarter {
+	mock := &MockpipelineExecutionStarter{ctrl: ctrl}
+	mock.recorder = &MockpipelineExecutionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineExecutionStarter) EXPECT() *MockpipelineExecutionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartPipelineExecution mocks base method.
+func (m *MockpipelineExecutionStarter) StartPipelineExecution(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPipelineExecution", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPipelineExecution indicates an expected call of StartPipelineExecution.
+func (mr *MockpipelineExecutionStarterMockRecorder) StartPipelineExecution(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPipelineExecution", reflect.TypeOf((*MockpipelineExecutionStarter)(nil).StartPipelineExecution), pipelineName)
+}
+
+// MockpipelineStageRetrier is a mock of pipelineStageRetrier interface.
+type MockpipelineStageRetrier struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStageRetrierMockRecorder
+}
+
+// MockpipelineStageRetrierMockRecorder is the mock recorder for MockpipelineStageRetrier.
+type MockpipelineStageRetrierMockRecorder struct {
+	mock *MockpipelineStageRetrier
+}
+
+// NewMockpipelineStageRetrier creates a new mock instance.
+func NewMockpipelineStageRetrier(ctrl *gomock.Controller) *MockpipelineStageRetrier {
+	mock := &MockpipelineStageRetrier{ctrl: ctrl}
+	mock.recorder = &MockpipelineStageRetrierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineStageRetrier) EXPECT() *MockpipelineStageRetrierMockRecorder {
+	return m.recorder
+}
+
+// GetPipelineState mocks base method.
+func (m *MockpipelineStageRetrier) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineState indicates an expected call of GetPipelineState.
+func (mr *MockpipelineStageRetrierMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStageRetrier)(nil).GetPipelineState), pipelineName)
+}
+
+// RetryFailedActions mocks base method.
+func (m *MockpipelineStageRetrier) RetryFailedActions(pipelineName, stageName, executionID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailedActions", pipelineName, stageName, executionID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryFailedActions indicates an expected call of RetryFailedActions.
+func (mr *MockpipelineStageRetrierMockRecorder) RetryFailedActions(pipelineName, stageName, executionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedActions", reflect.TypeOf((*MockpipelineStageRetrier)(nil).RetryFailedActions), pipelineName, stageName, executionID)
+}
+
+// MockpipelineApprover is a mock of pipelineApprover interface.
+type MockpipelineApprover struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineApproverMockRecorder
+}
+
+// MockpipelineApproverMockRecorder is the mock recorder for MockpipelineApprover.
+type MockpipelineApproverMockRecorder struct {
+	mock *MockpipelineApprover
+}
+
+// NewMockpipelineApprover creates a new mock instance.
+func NewMockpipelineApprover(ctrl *gomock.Controller) *MockpipelineApprover {
+	mock := &MockpipelineApprover{ctrl: ctrl}
+	mock.recorder = &MockpipelineApproverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineApprover) EXPECT() *MockpipelineApproverMockRecorder {
+	return m.recorder
+}
+
+// GetPipelineState mocks base method.
+func (m *MockpipelineApprover) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineState indicates an expected call of GetPipelineState.
+func (mr *MockpipelineApproverMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineApprover)(nil).GetPipelineState), pipelineName)
+}
+
+// PutApprovalResult mocks base method.
+func (m *MockpipelineApprover) PutApprovalResult(pipelineName, stageName string, action codepipeline.StageAction, approved bool, summary string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutApprovalResult", pipelineName, stageName, action, approved, summary)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutApprovalResult indicates an expected call of PutApprovalResult.
+func (mr *MockpipelineApproverMockRecorder) PutApprovalResult(pipelineName, stageName, action, approved, summary interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutApprovalResult", reflect.TypeOf((*MockpipelineApprover)(nil).PutApprovalResult), pipelineName, stageName, action, approved, summary)
+}
+
 // MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
 type MockdeployedPipelineLister struct {
 	ctrl     *gomock.Controller
@@ -6433,6 +6832,57 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// ExecuteCommandWithOutput mocks base method.
+func (m *MockecsCommandExecutor) ExecuteCommandWithOutput(in ecs.ExecuteCommandInput, out io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommandWithOutput", in, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteCommandWithOutput indicates an expected call of ExecuteCommandWithOutput.
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommandWithOutput(in, out interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommandWithOutput", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommandWithOutput), in, out)
+}
+
+// MockecsPortForwarder is a mock of ecsPortForwarder interface.
+type MockecsPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsPortForwarderMockRecorder
+}
+
+// MockecsPortForwarderMockRecorder is the mock recorder for MockecsPortForwarder.
+type MockecsPortForwarderMockRecorder struct {
+	mock *MockecsPortForwarder
+}
+
+// NewMockecsPortForwarder creates a new mock instance.
+func NewMockecsPortForwarder(ctrl *gomock.Controller) *MockecsPortForwarder {
+	mock := &MockecsPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockecsPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsPortForwarder) EXPECT() *MockecsPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// ForwardPort mocks base method.
+func (m *MockecsPortForwarder) ForwardPort(in ecs.ForwardPortInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardPort", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForwardPort indicates an expected call of ForwardPort.
+func (mr *MockecsPortForwarderMockRecorder) ForwardPort(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardPort", reflect.TypeOf((*MockecsPortForwarder)(nil).ForwardPort), in)
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -6824,14 +7274,228 @@ func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
 }
 
-// Mockcodestar is a mock of codestar interface.
-type Mockcodestar struct {
+// MocklocalContainerRunner is a mock of localContainerRunner interface.
+type MocklocalContainerRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockcodestarMockRecorder
+	recorder *MocklocalContainerRunnerMockRecorder
 }
 
-// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
-type MockcodestarMockRecorder struct {
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner.
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance.
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MocklocalContainerRunner) Build(in *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MocklocalContainerRunnerMockRecorder) Build(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), in)
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MocklocalContainerRunner) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MocklocalContainerRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MocklocalContainerRunner)(nil).CheckDockerEngineRunning))
+}
+
+// CreateNetwork mocks base method.
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// GetPlatform mocks base method.
+func (m *MocklocalContainerRunner) GetPlatform() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatform")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlatform indicates an expected call of GetPlatform.
+func (mr *MocklocalContainerRunnerMockRecorder) GetPlatform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MocklocalContainerRunner)(nil).GetPlatform))
+}
+
+// NetworkExists mocks base method.
+func (m *MocklocalContainerRunner) NetworkExists(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkExists", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkExists indicates an expected call of NetworkExists.
+func (mr *MocklocalContainerRunnerMockRecorder) NetworkExists(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkExists", reflect.TypeOf((*MocklocalContainerRunner)(nil).NetworkExists), name)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MocklocalContainerRunner) RemoveNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveNetwork), name)
+}
+
+// Run mocks base method.
+func (m *MocklocalContainerRunner) Run(in *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocklocalContainerRunnerMockRecorder) Run(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklocalContainerRunner)(nil).Run), in)
+}
+
+// Stop mocks base method.
+func (m *MocklocalContainerRunner) Stop(containerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", containerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MocklocalContainerRunnerMockRecorder) Stop(containerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MocklocalContainerRunner)(nil).Stop), containerName)
+}
+
+// MocksecretValueGetter is a mock of secretValueGetter interface.
+type MocksecretValueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretValueGetterMockRecorder
+}
+
+// MocksecretValueGetterMockRecorder is the mock recorder for MocksecretValueGetter.
+type MocksecretValueGetterMockRecorder struct {
+	mock *MocksecretValueGetter
+}
+
+// NewMocksecretValueGetter creates a new mock instance.
+func NewMocksecretValueGetter(ctrl *gomock.Controller) *MocksecretValueGetter {
+	mock := &MocksecretValueGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretValueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretValueGetter) EXPECT() *MocksecretValueGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MocksecretValueGetter) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretValueGetterMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretValueGetter)(nil).GetSecretValue), name)
+}
+
+// MockserviceDiscoveryEndpointGetter is a mock of serviceDiscoveryEndpointGetter interface.
+type MockserviceDiscoveryEndpointGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDiscoveryEndpointGetterMockRecorder
+}
+
+// MockserviceDiscoveryEndpointGetterMockRecorder is the mock recorder for MockserviceDiscoveryEndpointGetter.
+type MockserviceDiscoveryEndpointGetterMockRecorder struct {
+	mock *MockserviceDiscoveryEndpointGetter
+}
+
+// NewMockserviceDiscoveryEndpointGetter creates a new mock instance.
+func NewMockserviceDiscoveryEndpointGetter(ctrl *gomock.Controller) *MockserviceDiscoveryEndpointGetter {
+	mock := &MockserviceDiscoveryEndpointGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceDiscoveryEndpointGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDiscoveryEndpointGetter) EXPECT() *MockserviceDiscoveryEndpointGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceDiscoveryEndpoint mocks base method.
+func (m *MockserviceDiscoveryEndpointGetter) ServiceDiscoveryEndpoint() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceDiscoveryEndpoint")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceDiscoveryEndpoint indicates an expected call of ServiceDiscoveryEndpoint.
+func (mr *MockserviceDiscoveryEndpointGetterMockRecorder) ServiceDiscoveryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockserviceDiscoveryEndpointGetter)(nil).ServiceDiscoveryEndpoint))
+}
+
+// Mockcodestar is a mock of codestar interface.
+type Mockcodestar struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodestarMockRecorder
+}
+
+// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
+type MockcodestarMockRecorder struct {
 	mock *Mockcodestar
 }
 
@@ -6976,6 +7640,157 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretsManagerSecretPutter is a mock of secretsManagerSecretPutter interface.
+type MocksecretsManagerSecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretPutterMockRecorder
+}
+
+// MocksecretsManagerSecretPutterMockRecorder is the mock recorder for MocksecretsManagerSecretPutter.
+type MocksecretsManagerSecretPutterMockRecorder struct {
+	mock *MocksecretsManagerSecretPutter
+}
+
+// NewMocksecretsManagerSecretPutter creates a new mock instance.
+func NewMocksecretsManagerSecretPutter(ctrl *gomock.Controller) *MocksecretsManagerSecretPutter {
+	mock := &MocksecretsManagerSecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretPutter) EXPECT() *MocksecretsManagerSecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretsManagerSecretPutter) PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*secretsmanager.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretsManagerSecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretsManagerSecretPutter)(nil).PutSecret), in)
+}
+
+// MocksecretParameterDeleter is a mock of secretParameterDeleter interface.
+type MocksecretParameterDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretParameterDeleterMockRecorder
+}
+
+// MocksecretParameterDeleterMockRecorder is the mock recorder for MocksecretParameterDeleter.
+type MocksecretParameterDeleterMockRecorder struct {
+	mock *MocksecretParameterDeleter
+}
+
+// NewMocksecretParameterDeleter creates a new mock instance.
+func NewMocksecretParameterDeleter(ctrl *gomock.Controller) *MocksecretParameterDeleter {
+	mock := &MocksecretParameterDeleter{ctrl: ctrl}
+	mock.recorder = &MocksecretParameterDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretParameterDeleter) EXPECT() *MocksecretParameterDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MocksecretParameterDeleter) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MocksecretParameterDeleterMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretParameterDeleter)(nil).DeleteSecret), name)
+}
+
+// MocksecretDescriber is a mock of secretDescriber interface.
+type MocksecretDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretDescriberMockRecorder
+}
+
+// MocksecretDescriberMockRecorder is the mock recorder for MocksecretDescriber.
+type MocksecretDescriberMockRecorder struct {
+	mock *MocksecretDescriber
+}
+
+// NewMocksecretDescriber creates a new mock instance.
+func NewMocksecretDescriber(ctrl *gomock.Controller) *MocksecretDescriber {
+	mock := &MocksecretDescriber{ctrl: ctrl}
+	mock.recorder = &MocksecretDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretDescriber) EXPECT() *MocksecretDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeSecret mocks base method.
+func (m *MocksecretDescriber) DescribeSecret(name string, decrypt bool) (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", name, decrypt)
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MocksecretDescriberMockRecorder) DescribeSecret(name, decrypt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MocksecretDescriber)(nil).DescribeSecret), name, decrypt)
+}
+
+// MocksecretsDiffer is a mock of secretsDiffer interface.
+type MocksecretsDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsDifferMockRecorder
+}
+
+// MocksecretsDifferMockRecorder is the mock recorder for MocksecretsDiffer.
+type MocksecretsDifferMockRecorder struct {
+	mock *MocksecretsDiffer
+}
+
+// NewMocksecretsDiffer creates a new mock instance.
+func NewMocksecretsDiffer(ctrl *gomock.Controller) *MocksecretsDiffer {
+	mock := &MocksecretsDiffer{ctrl: ctrl}
+	mock.recorder = &MocksecretsDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsDiffer) EXPECT() *MocksecretsDifferMockRecorder {
+	return m.recorder
+}
+
+// Diff mocks base method.
+func (m *MocksecretsDiffer) Diff() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *MocksecretsDifferMockRecorder) Diff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MocksecretsDiffer)(nil).Diff))
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -7013,6 +7828,230 @@ func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
+// MockecsServiceScaler is a mock of ecsServiceScaler interface.
+type MockecsServiceScaler struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceScalerMockRecorder
+}
+
+// MockecsServiceScalerMockRecorder is the mock recorder for MockecsServiceScaler.
+type MockecsServiceScalerMockRecorder struct {
+	mock *MockecsServiceScaler
+}
+
+// NewMockecsServiceScaler creates a new mock instance.
+func NewMockecsServiceScaler(ctrl *gomock.Controller) *MockecsServiceScaler {
+	mock := &MockecsServiceScaler{ctrl: ctrl}
+	mock.recorder = &MockecsServiceScalerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceScaler) EXPECT() *MockecsServiceScalerMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockecsServiceScaler) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsServiceScalerMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceScaler)(nil).Service), app, env, svc)
+}
+
+// UpdateServiceDesiredCount mocks base method.
+func (m *MockecsServiceScaler) UpdateServiceDesiredCount(app, env, svc string, count int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceDesiredCount", app, env, svc, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceDesiredCount indicates an expected call of UpdateServiceDesiredCount.
+func (mr *MockecsServiceScalerMockRecorder) UpdateServiceDesiredCount(app, env, svc, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceDesiredCount", reflect.TypeOf((*MockecsServiceScaler)(nil).UpdateServiceDesiredCount), app, env, svc, count)
+}
+
+// MockecsScalableTargetUpdater is a mock of ecsScalableTargetUpdater interface.
+type MockecsScalableTargetUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsScalableTargetUpdaterMockRecorder
+}
+
+// MockecsScalableTargetUpdaterMockRecorder is the mock recorder for MockecsScalableTargetUpdater.
+type MockecsScalableTargetUpdaterMockRecorder struct {
+	mock *MockecsScalableTargetUpdater
+}
+
+// NewMockecsScalableTargetUpdater creates a new mock instance.
+func NewMockecsScalableTargetUpdater(ctrl *gomock.Controller) *MockecsScalableTargetUpdater {
+	mock := &MockecsScalableTargetUpdater{ctrl: ctrl}
+	mock.recorder = &MockecsScalableTargetUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsScalableTargetUpdater) EXPECT() *MockecsScalableTargetUpdaterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method.
+func (m *MockecsScalableTargetUpdater) ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", cluster, service)
+	ret0, _ := ret[0].(*aas.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget.
+func (mr *MockecsScalableTargetUpdaterMockRecorder) ECSServiceScalableTarget(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockecsScalableTargetUpdater)(nil).ECSServiceScalableTarget), cluster, service)
+}
+
+// UpdateECSServiceScalableTarget mocks base method.
+func (m *MockecsScalableTargetUpdater) UpdateECSServiceScalableTarget(cluster, service string, target aas.ScalableTarget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateECSServiceScalableTarget", cluster, service, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateECSServiceScalableTarget indicates an expected call of UpdateECSServiceScalableTarget.
+func (mr *MockecsScalableTargetUpdaterMockRecorder) UpdateECSServiceScalableTarget(cluster, service, target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateECSServiceScalableTarget", reflect.TypeOf((*MockecsScalableTargetUpdater)(nil).UpdateECSServiceScalableTarget), cluster, service, target)
+}
+
+// MockapprunnerAutoScalingUpdater is a mock of apprunnerAutoScalingUpdater interface.
+type MockapprunnerAutoScalingUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockapprunnerAutoScalingUpdaterMockRecorder
+}
+
+// MockapprunnerAutoScalingUpdaterMockRecorder is the mock recorder for MockapprunnerAutoScalingUpdater.
+type MockapprunnerAutoScalingUpdaterMockRecorder struct {
+	mock *MockapprunnerAutoScalingUpdater
+}
+
+// NewMockapprunnerAutoScalingUpdater creates a new mock instance.
+func NewMockapprunnerAutoScalingUpdater(ctrl *gomock.Controller) *MockapprunnerAutoScalingUpdater {
+	mock := &MockapprunnerAutoScalingUpdater{ctrl: ctrl}
+	mock.recorder = &MockapprunnerAutoScalingUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapprunnerAutoScalingUpdater) EXPECT() *MockapprunnerAutoScalingUpdaterMockRecorder {
+	return m.recorder
+}
+
+// CreateAutoScalingConfiguration mocks base method.
+func (m *MockapprunnerAutoScalingUpdater) CreateAutoScalingConfiguration(in apprunner.CreateAutoScalingConfigurationInput) (*apprunner.AutoScalingConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScalingConfiguration", in)
+	ret0, _ := ret[0].(*apprunner.AutoScalingConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScalingConfiguration indicates an expected call of CreateAutoScalingConfiguration.
+func (mr *MockapprunnerAutoScalingUpdaterMockRecorder) CreateAutoScalingConfiguration(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalingConfiguration", reflect.TypeOf((*MockapprunnerAutoScalingUpdater)(nil).CreateAutoScalingConfiguration), in)
+}
+
+// ServiceAutoScalingConfiguration mocks base method.
+func (m *MockapprunnerAutoScalingUpdater) ServiceAutoScalingConfiguration(svcARN string) (*apprunner.AutoScalingConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceAutoScalingConfiguration", svcARN)
+	ret0, _ := ret[0].(*apprunner.AutoScalingConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceAutoScalingConfiguration indicates an expected call of ServiceAutoScalingConfiguration.
+func (mr *MockapprunnerAutoScalingUpdaterMockRecorder) ServiceAutoScalingConfiguration(svcARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceAutoScalingConfiguration", reflect.TypeOf((*MockapprunnerAutoScalingUpdater)(nil).ServiceAutoScalingConfiguration), svcARN)
+}
+
+// UpdateServiceAutoScalingConfiguration mocks base method.
+func (m *MockapprunnerAutoScalingUpdater) UpdateServiceAutoScalingConfiguration(svcARN, configARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceAutoScalingConfiguration", svcARN, configARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceAutoScalingConfiguration indicates an expected call of UpdateServiceAutoScalingConfiguration.
+func (mr *MockapprunnerAutoScalingUpdaterMockRecorder) UpdateServiceAutoScalingConfiguration(svcARN, configARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAutoScalingConfiguration", reflect.TypeOf((*MockapprunnerAutoScalingUpdater)(nil).UpdateServiceAutoScalingConfiguration), svcARN, configARN)
+}
+
+// MockwsWorkloadManifestOverwriter is a mock of wsWorkloadManifestOverwriter interface.
+type MockwsWorkloadManifestOverwriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadManifestOverwriterMockRecorder
+}
+
+// MockwsWorkloadManifestOverwriterMockRecorder is the mock recorder for MockwsWorkloadManifestOverwriter.
+type MockwsWorkloadManifestOverwriterMockRecorder struct {
+	mock *MockwsWorkloadManifestOverwriter
+}
+
+// NewMockwsWorkloadManifestOverwriter creates a new mock instance.
+func NewMockwsWorkloadManifestOverwriter(ctrl *gomock.Controller) *MockwsWorkloadManifestOverwriter {
+	mock := &MockwsWorkloadManifestOverwriter{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadManifestOverwriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadManifestOverwriter) EXPECT() *MockwsWorkloadManifestOverwriterMockRecorder {
+	return m.recorder
+}
+
+// OverwriteWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestOverwriter) OverwriteWorkloadManifest(mft workspace.WorkloadManifest, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteWorkloadManifest", mft, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteWorkloadManifest indicates an expected call of OverwriteWorkloadManifest.
+func (mr *MockwsWorkloadManifestOverwriterMockRecorder) OverwriteWorkloadManifest(mft, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestOverwriter)(nil).OverwriteWorkloadManifest), mft, name)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestOverwriter) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadManifestOverwriterMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestOverwriter)(nil).ReadWorkloadManifest), name)
+}
+
 // Mockinterpolator is a mock of interpolator interface.
 type Mockinterpolator struct {
 	ctrl     *gomock.Controller
@@ -7074,6 +8113,21 @@ func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 	return m.recorder
 }
 
+// DeployDiff mocks base method.
+func (m *MockworkloadDeployer) DeployDiff(template string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", template)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), template)
+}
+
 // DeployWorkload mocks base method.
 func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
 	m.ctrl.T.Helper()
@@ -7089,6 +8143,21 @@ func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
 // IsServiceAvailableInRegion mocks base method.
 func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -7104,6 +8173,21 @@ func (mr *MockworkloadDeployerMockRecorder) IsServiceAvailableInRegion(region in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceAvailableInRegion", reflect.TypeOf((*MockworkloadDeployer)(nil).IsServiceAvailableInRegion), region)
 }
 
+// PreviewChangeSet mocks base method.
+func (m *MockworkloadDeployer) PreviewChangeSet(in *deploy.DeployWorkloadInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewChangeSet", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewChangeSet indicates an expected call of PreviewChangeSet.
+func (mr *MockworkloadDeployerMockRecorder) PreviewChangeSet(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewChangeSet", reflect.TypeOf((*MockworkloadDeployer)(nil).PreviewChangeSet), in)
+}
+
 // UploadArtifacts mocks base method.
 func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
@@ -7119,6 +8203,21 @@ func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
+// UploadPreviewArtifacts mocks base method.
+func (m *MockworkloadDeployer) UploadPreviewArtifacts() (*deploy.UploadArtifactsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPreviewArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPreviewArtifacts indicates an expected call of UploadPreviewArtifacts.
+func (mr *MockworkloadDeployerMockRecorder) UploadPreviewArtifacts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPreviewArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadPreviewArtifacts))
+}
+
 // MockworkloadStackGenerator is a mock of workloadStackGenerator interface.
 type MockworkloadStackGenerator struct {
 	ctrl     *gomock.Controller
@@ -7211,11 +8310,12 @@ func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
 }
 
 // Run mocks base method.
-func (m *Mockrunner) Run() error {
+func (m *Mockrunner) Run() (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Run indicates an expected call of Run.
@@ -7247,6 +8347,21 @@ func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 	return m.recorder
 }
 
+// DeployDiff mocks base method.
+func (m *MockenvDeployer) DeployDiff(template string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", template)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockenvDeployerMockRecorder) DeployDiff(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), template)
+}
+
 // DeployEnvironment mocks base method.
 func (m *MockenvDeployer) DeployEnvironment(in *deploy.DeployEnvironmentInput) error {
 	m.ctrl.T.Helper()
@@ -7261,6 +8376,36 @@ func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
 }
 
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockenvDeployer) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
+// PreviewChangeSet mocks base method.
+func (m *MockenvDeployer) PreviewChangeSet(in *deploy.DeployEnvironmentInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewChangeSet", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewChangeSet indicates an expected call of PreviewChangeSet.
+func (mr *MockenvDeployerMockRecorder) PreviewChangeSet(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewChangeSet", reflect.TypeOf((*MockenvDeployer)(nil).PreviewChangeSet), in)
+}
+
 // UploadArtifacts mocks base method.
 func (m *MockenvDeployer) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
 	m.ctrl.T.Helper()
@@ -25,6 +25,10 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
 	cmd.AddCommand(buildPipelineListCmd())
+	cmd.AddCommand(buildPipelineRunCmd())
+	cmd.AddCommand(buildPipelineRetryCmd())
+	cmd.AddCommand(buildPipelineApproveCmd())
+	cmd.AddCommand(buildPipelineRejectCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtPipelineApprovalAppNamePrompt  = "Which application's pipeline would you like to %s?"
+	pipelineApprovalAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineApprovalPrompt      = "Which pipeline of %s would you like to %s?"
+	fmtPipelineApprovalStagePrompt = "Which stage of %s would you like to %s?"
+)
+
+type pipelineApproveVars struct {
+	appName  string
+	name     string
+	stage    string
+	comment  string
+	watch    bool
+	approved bool // Whether the stage is approved or rejected.
+}
+
+type pipelineApproveOpts struct {
+	pipelineApproveVars
+
+	store                  store
+	codepipeline           pipelineApprover
+	sel                    codePipelineSelector
+	prompt                 prompter
+	deployedPipelineLister deployedPipelineLister
+	// watchExecution renders the pipeline execution until it stops and returns its final description.
+	watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+	targetStage    *codepipeline.StageState
+	targetAction   codepipeline.StageAction
+}
+
+func newPipelineApproveOpts(vars pipelineApproveVars) (*pipelineApproveOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras(fmt.Sprintf("pipeline %s", vars.verb()))).Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	client := codepipeline.New(sess)
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	prompter := prompt.New()
+	return &pipelineApproveOpts{
+		pipelineApproveVars:    vars,
+		store:                  store,
+		codepipeline:           client,
+		sel:                    selector.NewAppPipelineSelector(prompter, store, pipelineLister),
+		prompt:                 prompter,
+		deployedPipelineLister: pipelineLister,
+		watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+			return renderPipelineExecution(client, pipelineName, executionID)
+		},
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineApproveOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineApproveOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskPipeline(); err != nil {
+		return err
+	}
+	return o.validateOrAskStage()
+}
+
+// Execute approves or rejects the stage, and watches the execution of an approved stage until it stops if requested.
+func (o *pipelineApproveOpts) Execute() error {
+	if err := o.codepipeline.PutApprovalResult(o.targetPipeline.ResourceName, o.stage, o.targetAction, o.approved, o.comment); err != nil {
+		return fmt.Errorf("%s stage %s of pipeline %s: %w", o.verb(), o.stage, o.name, err)
+	}
+	if o.approved {
+		log.Successf("Approved stage %s of pipeline %s.\n", color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+	} else {
+		log.Successf("Rejected stage %s of pipeline %s.\n", color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+	}
+	if !o.watch {
+		return nil
+	}
+	return waitForPipelineExecution(o.watchExecution, *o.targetPipeline, o.targetStage.ExecutionID)
+}
+
+func (o *pipelineApproveOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(fmt.Sprintf(fmtPipelineApprovalAppNamePrompt, o.verb()), pipelineApprovalAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *pipelineApproveOpts) validateOrAskPipeline() error {
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineApprovalPrompt, color.HighlightUserInput(o.appName), o.verb()), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+func (o *pipelineApproveOpts) validateOrAskStage() error {
+	state, err := o.codepipeline.GetPipelineState(o.targetPipeline.ResourceName)
+	if err != nil {
+		return fmt.Errorf("get state of pipeline %s: %w", o.name, err)
+	}
+	pending := make(map[string]*codepipeline.StageState)
+	var names []string
+	for _, stage := range state.StageStates {
+		if _, ok := stage.PendingApproval(); !ok {
+			continue
+		}
+		pending[stage.StageName] = stage
+		names = append(names, stage.StageName)
+	}
+	if o.stage == "" {
+		if len(names) == 0 {
+			return fmt.Errorf("no stages are waiting for approval in pipeline %s", o.name)
+		}
+		name, err := selectPipelineStage(o.prompt, fmt.Sprintf(fmtPipelineApprovalStagePrompt, color.HighlightUserInput(o.name), o.verb()), names)
+		if err != nil {
+			return err
+		}
+		o.stage = name
+	}
+	stage, ok := pending[o.stage]
+	if !ok {
+		return fmt.Errorf("stage %s of pipeline %s is not waiting for approval", o.stage, o.name)
+	}
+	o.targetStage = stage
+	o.targetAction, _ = stage.PendingApproval()
+	return nil
+}
+
+func (v pipelineApproveVars) verb() string {
+	if v.approved {
+		return "approve"
+	}
+	return "reject"
+}
+
+// buildPipelineApproveCmd builds the command for approving a stage of a deployed pipeline.
+func buildPipelineApproveCmd() *cobra.Command {
+	vars := pipelineApproveVars{
+		approved: true,
+	}
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approves a pipeline stage that requires approval.",
+		Long:  "Approves the manual approval action of a pipeline stage so that the release proceeds.",
+
+		Example: `
+  Approves the promotion to the "prod" environment in the pipeline "my-repo-my-branch".
+  /code $ copilot pipeline approve -n my-repo-my-branch --stage DeployTo-prod --comment "Verified in test."`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineApproveOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addPipelineApprovalFlags(cmd, &vars)
+	return cmd
+}
+
+// buildPipelineRejectCmd builds the command for rejecting a stage of a deployed pipeline.
+func buildPipelineRejectCmd() *cobra.Command {
+	vars := pipelineApproveVars{
+		approved: false,
+	}
+	cmd := &cobra.Command{
+		Use:   "reject",
+		Short: "Rejects a pipeline stage that requires approval.",
+		Long:  "Rejects the manual approval action of a pipeline stage so that the execution stops.",
+
+		Example: `
+  Rejects the promotion to the "prod" environment in the pipeline "my-repo-my-branch".
+  /code $ copilot pipeline reject -n my-repo-my-branch --stage DeployTo-prod --comment "Integration tests are flaky."`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineApproveOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addPipelineApprovalFlags(cmd, &vars)
+	return cmd
+}
+
+func addPipelineApprovalFlags(cmd *cobra.Command, vars *pipelineApproveVars) {
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", pipelineApprovalStageFlagDescription)
+	cmd.Flags().StringVar(&vars.comment, commentFlag, "", pipelineCommentFlagDescription)
+	if vars.approved {
+		// A rejection stops the execution, so there is nothing to watch.
+		cmd.Flags().BoolVar(&vars.watch, watchFlag, false, pipelineWatchFlagDescription)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineApproveMocks struct {
+	store                  *mocks.Mockstore
+	codepipeline           *mocks.MockpipelineApprover
+	sel                    *mocks.MockcodePipelineSelector
+	prompt                 *mocks.Mockprompter
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+}
+
+func TestPipelineApproveOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "my-pipeline"
+		mockResourceName = "pipeline-dinder-my-pipeline-RANDOM"
+	)
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		ResourceName: mockResourceName,
+		Name:         mockPipelineName,
+	}
+	mockApproval := codepipeline.StageAction{
+		Name:          "ApprovePromotionTo-prod",
+		Status:        "InProgress",
+		ApprovalToken: "approval-token",
+	}
+	pendingProd := &codepipeline.StageState{
+		StageName:   "DeployTo-prod",
+		Actions:     []codepipeline.StageAction{mockApproval},
+		ExecutionID: "execution-id",
+	}
+	inProgressTest := &codepipeline.StageState{
+		StageName:   "DeployTo-test",
+		Actions:     []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "InProgress"}},
+		ExecutionID: "execution-id",
+	}
+	validFlags := func(m pipelineApproveMocks) {
+		m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+		m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+	}
+
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inStage        string
+		inApproved     bool
+		setupMocks     func(m pipelineApproveMocks)
+
+		wantedStage  string
+		wantedAction codepipeline.StageAction
+		wantedErr    error
+	}{
+		"errors if fail to select app name": {
+			inApproved: true,
+			setupMocks: func(m pipelineApproveMocks) {
+				m.sel.EXPECT().Application("Which application's pipeline would you like to approve?", gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+		"errors if the stage flag is not waiting for approval": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "DeployTo-test",
+			setupMocks: func(m pipelineApproveMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{inProgressTest, pendingProd},
+				}, nil)
+			},
+			wantedErr: errors.New("stage DeployTo-test of pipeline my-pipeline is not waiting for approval"),
+		},
+		"errors if no stages are waiting for approval": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineApproveMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{inProgressTest},
+				}, nil)
+			},
+			wantedErr: errors.New("no stages are waiting for approval in pipeline my-pipeline"),
+		},
+		"validates the stage flag": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "DeployTo-prod",
+			setupMocks: func(m pipelineApproveMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{inProgressTest, pendingProd},
+				}, nil)
+			},
+			wantedStage:  "DeployTo-prod",
+			wantedAction: mockApproval,
+		},
+		"defaults to the only stage waiting for approval": {
+			setupMocks: func(m pipelineApproveMocks) {
+				m.sel.EXPECT().Application("Which application's pipeline would you like to reject?", gomock.Any()).Return(mockAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{inProgressTest, pendingProd},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedStage:  "DeployTo-prod",
+			wantedAction: mockApproval,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineApproveMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				codepipeline:           mocks.NewMockpipelineApprover(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				prompt:                 mocks.NewMockprompter(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineApproveOpts{
+				pipelineApproveVars: pipelineApproveVars{
+					appName:  tc.inAppName,
+					name:     tc.inPipelineName,
+					stage:    tc.inStage,
+					approved: tc.inApproved,
+				},
+				store:                  m.store,
+				codepipeline:           m.codepipeline,
+				sel:                    m.sel,
+				prompt:                 m.prompt,
+				deployedPipelineLister: m.deployedPipelineLister,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStage, opts.stage)
+			require.Equal(t, tc.wantedAction, opts.targetAction)
+		})
+	}
+}
+
+func TestPipelineApproveOpts_Execute(t *testing.T) {
+	mockPipeline := &deploy.Pipeline{
+		AppName:      "dinder",
+		ResourceName: "pipeline-dinder-my-pipeline-RANDOM",
+		Name:         "my-pipeline",
+	}
+	mockApproval := codepipeline.StageAction{
+		Name:          "ApprovePromotionTo-prod",
+		Status:        "InProgress",
+		ApprovalToken: "approval-token",
+	}
+	mockStage := &codepipeline.StageState{
+		StageName:   "DeployTo-prod",
+		Actions:     []codepipeline.StageAction{mockApproval},
+		ExecutionID: "execution-id",
+	}
+
+	testCases := map[string]struct {
+		inApproved     bool
+		inWatch        bool
+		setupMocks     func(m pipelineApproveMocks)
+		watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+		wantedErr error
+	}{
+		"errors if the approval result can't be recorded": {
+			inApproved: true,
+			setupMocks: func(m pipelineApproveMocks) {
+				m.codepipeline.EXPECT().PutApprovalResult("pipeline-dinder-my-pipeline-RANDOM", "DeployTo-prod", mockApproval, true, "lgtm").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("approve stage DeployTo-prod of pipeline my-pipeline: some error"),
+		},
+		"rejects the stage without watching the execution": {
+			setupMocks: func(m pipelineApproveMocks) {
+				m.codepipeline.EXPECT().PutApprovalResult("pipeline-dinder-my-pipeline-RANDOM", "DeployTo-prod", mockApproval, false, "lgtm").Return(nil)
+			},
+		},
+		"approves the stage and watches the execution of the stage": {
+			inApproved: true,
+			inWatch:    true,
+			setupMocks: func(m pipelineApproveMocks) {
+				m.codepipeline.EXPECT().PutApprovalResult("pipeline-dinder-my-pipeline-RANDOM", "DeployTo-prod", mockApproval, true, "lgtm").Return(nil)
+			},
+			watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+				require.Equal(t, "pipeline-dinder-my-pipeline-RANDOM", pipelineName)
+				require.Equal(t, "execution-id", executionID)
+				return &stream.PipelineExecution{Status: "Succeeded"}, nil
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineApproveMocks{
+				codepipeline: mocks.NewMockpipelineApprover(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineApproveOpts{
+				pipelineApproveVars: pipelineApproveVars{
+					name:     "my-pipeline",
+					stage:    "DeployTo-prod",
+					comment:  "lgtm",
+					watch:    tc.inWatch,
+					approved: tc.inApproved,
+				},
+				codepipeline:   m.codepipeline,
+				watchExecution: tc.watchExecution,
+				targetPipeline: mockPipeline,
+				targetStage:    mockStage,
+				targetAction:   mockApproval,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,12 +19,14 @@ import (
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/list"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -75,6 +77,8 @@ type deployPipelineOpts struct {
 	store                           store
 	ws                              wsPipelineReader
 	codestar                        codestar
+	uploader                        uploader
+	sourceBucket                    pipelineSourceBucketDescriber
 	newSvcListCmd                   func(io.Writer, string) cmd
 	newJobListCmd                   func(io.Writer, string) cmd
 	configureDeployedPipelineLister func() deployedPipelineLister
@@ -117,6 +121,8 @@ func newDeployPipelineOpts(vars deployPipelineVars) (*deployPipelineOpts, error)
 		prompt:             prompter,
 		sel:                selector.NewWsPipelineSelector(prompter, ws),
 		codestar:           cs.New(defaultSession),
+		uploader:           s3.New(defaultSession),
+		sourceBucket:       s3.New(defaultSession),
 		newSvcListCmd: func(w io.Writer, appName string) cmd {
 			return &listSvcOpts{
 				listWkldVars: listWkldVars{
@@ -246,6 +252,19 @@ func (o *deployPipelineOpts) Execute() error {
 	if err = build.Init(pipeline.Build, filepath.Dir(relPath)); err != nil {
 		return err
 	}
+	switch src := source.(type) {
+	case *deploy.S3Source:
+		if err := o.validateS3SourceBucket(src.Bucket); err != nil {
+			return err
+		}
+	case *deploy.ECRSource:
+		if err := o.validateECRSourceWorkloads(); err != nil {
+			return err
+		}
+		if err := o.uploadWorkspace(src, pipeline.Name); err != nil {
+			return err
+		}
+	}
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:             o.appName,
 		Name:                pipeline.Name,
@@ -337,6 +356,7 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 
 		var stg deploy.PipelineStage
 		stg.Init(env, &stage, workloads)
+		stg.SetPipelineLocation(o.app.AccountID, o.region)
 		stages = append(stages, stg)
 	}
 	return stages, nil
@@ -392,6 +412,72 @@ func (o *deployPipelineOpts) getBucketName() (string, error) {
 	return resources.S3Bucket, nil
 }
 
+// validateS3SourceBucket returns an error if the bucket can't start the pipeline when the source object is uploaded.
+func (o *deployPipelineOpts) validateS3SourceBucket(bucket string) error {
+	versioned, err := o.sourceBucket.IsBucketVersioned(bucket)
+	if err != nil {
+		return fmt.Errorf("check versioning of the source bucket: %w", err)
+	}
+	if !versioned {
+		return &errSourceBucketNotVersioned{bucket: bucket}
+	}
+	enabled, err := o.sourceBucket.IsEventBridgeEnabled(bucket)
+	if err != nil {
+		return fmt.Errorf("check notifications of the source bucket: %w", err)
+	}
+	if !enabled {
+		return &errSourceBucketEventBridgeDisabled{bucket: bucket}
+	}
+	return nil
+}
+
+// validateECRSourceWorkloads returns an error if a workload builds its image from a Dockerfile,
+// because the build stage of a pipeline with an ECR source only has the copilot/ directory of the workspace.
+func (o *deployPipelineOpts) validateECRSourceWorkloads() error {
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		raw, err := o.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return fmt.Errorf("read manifest of %s: %w", name, err)
+		}
+		mft, err := manifest.UnmarshalWorkload(raw)
+		if err != nil {
+			return fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+		}
+		required, err := manifest.DockerfileBuildRequired(mft.Manifest())
+		if err != nil {
+			return err
+		}
+		if required {
+			return &errECRSourceDockerfileBuild{workload: name}
+		}
+	}
+	return nil
+}
+
+// uploadWorkspace uploads the copilot/ directory of the workspace to the artifact bucket,
+// because the source artifact of an ECR source only contains the details of the image.
+func (o *deployPipelineOpts) uploadWorkspace(src *deploy.ECRSource, pipelineName string) error {
+	bucket, err := o.getBucketName()
+	if err != nil {
+		return err
+	}
+	content, err := o.ws.ZipCopilotDir()
+	if err != nil {
+		return fmt.Errorf("package workspace: %w", err)
+	}
+	key := artifactpath.PipelineWorkspace(pipelineName)
+	if _, err := o.uploader.Upload(bucket, key, bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("upload workspace to s3 bucket %s: %w", bucket, err)
+	}
+	src.WorkspaceBucket = bucket
+	src.WorkspaceObjectKey = key
+	return nil
+}
+
 func (o *deployPipelineOpts) shouldUpdate() (bool, error) {
 	if o.skipConfirmation {
 		return true, nil
@@ -442,6 +528,7 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 			}
 		}
 		o.prog.Stop(log.Ssuccessf(fmtPipelineDeployComplete, color.HighlightUserInput(o.pipeline.Name)))
+		logPipelineTriggerRules(o.pipeline.Name, in.Source)
 		return nil
 	}
 
@@ -459,9 +546,21 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 		return fmt.Errorf("update pipeline: %w", err)
 	}
 	o.prog.Stop(log.Ssuccessf(fmtPipelineDeployProposalComplete, color.HighlightUserInput(o.pipeline.Name)))
+	logPipelineTriggerRules(o.pipeline.Name, in.Source)
 	return nil
 }
 
+// logPipelineTriggerRules reports the pushes that start the pipeline if the source has trigger rules.
+func logPipelineTriggerRules(pipelineName string, source interface{}) {
+	src, ok := source.(interface {
+		TriggerRules() string
+	})
+	if !ok {
+		return
+	}
+	log.Infof("Pipeline %s runs on %s.\n", color.HighlightUserInput(pipelineName), src.TriggerRules())
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployPipelineOpts) RecommendedActions() []string {
 	return []string{
@@ -470,6 +569,53 @@ func (o *deployPipelineOpts) RecommendedActions() []string {
 	}
 }
 
+type errECRSourceDockerfileBuild struct {
+	workload string
+}
+
+func (e *errECRSourceDockerfileBuild) Error() string {
+	return fmt.Sprintf("workload %s builds its image from a Dockerfile, which is not available to a pipeline with an ECR source", e.workload)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errECRSourceDockerfileBuild) RecommendActions() string {
+	return fmt.Sprintf("Set %s in the manifest of %s to %s to deploy the image pushed to the ECR repository by its digest.",
+		color.HighlightCode("image.location"), e.workload, color.HighlightCode("${COPILOT_SOURCE_IMAGE_URI}"))
+}
+
+type errSourceBucketNotVersioned struct {
+	bucket string
+}
+
+func (e *errSourceBucketNotVersioned) Error() string {
+	return fmt.Sprintf("versioning is not enabled for the source bucket %q", e.bucket)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errSourceBucketNotVersioned) RecommendActions() string {
+	return fmt.Sprintf("The S3 source of a pipeline requires a versioned bucket. You can enable versioning by running %s.",
+		color.HighlightCode(fmt.Sprintf("aws s3api put-bucket-versioning --bucket %s --versioning-configuration Status=Enabled", e.bucket)))
+}
+
+type errSourceBucketEventBridgeDisabled struct {
+	bucket string
+}
+
+func (e *errSourceBucketEventBridgeDisabled) Error() string {
+	return fmt.Sprintf("the source bucket %q does not send notifications to Amazon EventBridge", e.bucket)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errSourceBucketEventBridgeDisabled) RecommendActions() string {
+	return fmt.Sprintf(`The pipeline is started by an EventBridge rule when the source object is uploaded.
+You can turn on the notifications in the "Properties" tab of the bucket in the Amazon S3 console, or by running %s.
+Note that the command replaces the existing notification configuration of the bucket.`,
+		color.HighlightCode(fmt.Sprintf(`aws s3api put-bucket-notification-configuration --bucket %s --notification-configuration '{"EventBridgeConfiguration": {}}'`, e.bucket)))
+}
+
 // BuildPipelineDeployCmd build the command for deploying a new pipeline or updating an existing pipeline.
 func buildPipelineDeployCmd() *cobra.Command {
 	vars := deployPipelineVars{}
@@ -29,6 +29,8 @@ type deployPipelineMocks struct {
 	ws                     *mocks.MockwsPipelineReader
 	actionCmd              *mocks.MockactionCommand
 	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	uploader               *mocks.Mockuploader
+	sourceBucket           *mocks.MockpipelineSourceBucketDescriber
 }
 
 func TestDeployPipelineOpts_Ask(t *testing.T) {
@@ -177,6 +179,12 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 		badPipelineName      = "pipeline-badgoose-honkpipes"
 		pipelineManifestPath = "someStuff/someMoreStuff/aws-copilot-sample-service/copilot/pipelines/pipepiper/manifest.yml"
 		relativePath         = "/copilot/pipelines/pipepiper/manifest.yml"
+
+		mockECRSourceWorkloadMft = `name: frontend
+type: Backend Service
+image:
+  location: ${COPILOT_SOURCE_IMAGE_URI}
+`
 	)
 	mockPipelineManifest := &manifest.Pipeline{
 		Name:    "pipepiper",
@@ -660,6 +668,261 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 
 			expectedError: fmt.Errorf("update pipeline: some error"),
 		},
+		"create and deploy pipeline with an ECR source by uploading the workspace": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "ECR",
+						Properties: map[string]interface{}{
+							"repository": "shared/frontend",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					// check if the pipeline has been deployed using a legacy naming.
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+
+					// validate the workloads.
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil),
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockECRSourceWorkloadMft), nil),
+
+					// upload the workspace.
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.ws.EXPECT().ZipCopilotDir().Return([]byte("zip"), nil),
+					m.uploader.EXPECT().Upload("someOtherBucket", "manual/pipelines/pipepiper/workspace.zip", gomock.Any()).Return("", nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).DoAndReturn(func(in *deploy.CreatePipelineInput, _ string) error {
+						src := in.Source.(*deploy.ECRSource)
+						if src.WorkspaceBucket != "someOtherBucket" || src.WorkspaceObjectKey != "manual/pipelines/pipepiper/workspace.zip" {
+							return fmt.Errorf("unexpected workspace location s3://%s/%s", src.WorkspaceBucket, src.WorkspaceObjectKey)
+						}
+						return nil
+					}),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+				)
+			},
+		},
+		"returns an error if the workspace of an ECR source can't be uploaded": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "ECR",
+						Properties: map[string]interface{}{
+							"repository": "shared/frontend",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil),
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockECRSourceWorkloadMft), nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.ws.EXPECT().ZipCopilotDir().Return([]byte("zip"), nil),
+					m.uploader.EXPECT().Upload("someOtherBucket", "manual/pipelines/pipepiper/workspace.zip", gomock.Any()).Return("", errors.New("some error")),
+				)
+			},
+			expectedError: fmt.Errorf("upload workspace to s3 bucket someOtherBucket: some error"),
+		},
+		"returns an error if a workload builds from a Dockerfile with an ECR source": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "ECR",
+						Properties: map[string]interface{}{
+							"repository": "shared/frontend",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil),
+					m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(mockECRSourceWorkloadMft), nil),
+					m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+`), nil),
+				)
+			},
+			expectedError: fmt.Errorf("workload api builds its image from a Dockerfile, which is not available to a pipeline with an ECR source"),
+		},
+		"returns an error if the bucket of an S3 source is not versioned": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "S3",
+						Properties: map[string]interface{}{
+							"bucket":     "releases",
+							"object_key": "frontend.zip",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.sourceBucket.EXPECT().IsBucketVersioned("releases").Return(false, nil),
+				)
+			},
+			expectedError: &errSourceBucketNotVersioned{bucket: "releases"},
+		},
+		"returns an error if the bucket of an S3 source doesn't send notifications to EventBridge": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "S3",
+						Properties: map[string]interface{}{
+							"bucket":     "releases",
+							"object_key": "frontend.zip",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.sourceBucket.EXPECT().IsBucketVersioned("releases").Return(true, nil),
+					m.sourceBucket.EXPECT().IsEventBridgeEnabled("releases").Return(false, nil),
+				)
+			},
+			expectedError: &errSourceBucketEventBridgeDisabled{bucket: "releases"},
+		},
+		"create and deploy pipeline with an S3 source": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "S3",
+						Properties: map[string]interface{}{
+							"bucket":     "releases",
+							"object_key": "frontend.zip",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestPath).Return(mockPipelineManifest, nil),
+					m.ws.EXPECT().Rel(pipelineManifestPath).Return(relativePath, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+					m.deployedPipelineLister.EXPECT().ListDeployedPipelines(appName).Return([]deploy.Pipeline{}, nil),
+					m.sourceBucket.EXPECT().IsBucketVersioned("releases").Return(true, nil),
+					m.sourceBucket.EXPECT().IsEventBridgeEnabled("releases").Return(true, nil),
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+				)
+			},
+		},
 		"update and deploy pipeline with specifying build property": {
 			inApp:     &app,
 			inAppName: appName,
@@ -740,6 +1003,8 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				ws:                     mockWorkspace,
 				actionCmd:              mockActionCmd,
 				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+				uploader:               mocks.NewMockuploader(ctrl),
+				sourceBucket:           mocks.NewMockpipelineSourceBucketDescriber(ctrl),
 			}
 
 			tc.callMocks(mocks)
@@ -751,6 +1016,8 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				},
 				pipelineDeployer: mockPipelineDeployer,
 				ws:               mockWorkspace,
+				uploader:         mocks.uploader,
+				sourceBucket:     mocks.sourceBucket,
 				app:              tc.inApp,
 				region:           tc.inRegion,
 				store:            mockStore,
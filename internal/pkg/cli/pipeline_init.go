@@ -77,6 +77,13 @@ const (
 	// For a Bitbucket repository.
 	bbURL        = "bitbucket.org"
 	fmtBBRepoURL = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For a GitLab repository.
+	glURL        = "gitlab.com"
+	fmtGLRepoURL = "https://%s/%s/%s" // Ex: "https://gitlab.com/repoOwner/repoName"
+	// For an object in an S3 bucket.
+	s3URLPrefix = "s3://" // Ex: "s3://bucketName/path/to/source.zip"
+	// For an image in an ECR repository.
+	ecrIdentifier = ".dkr.ecr." // Ex: "123456789012.dkr.ecr.us-west-2.amazonaws.com/repoName:tag"
 )
 
 const (
@@ -194,11 +201,13 @@ type initPipelineOpts struct {
 	pipelineLister deployedPipelineLister
 
 	// Outputs stored on successful actions.
-	secret    string
-	provider  string
-	repoName  string
-	repoOwner string
-	ccRegion  string
+	secret      string
+	provider    string
+	repoName    string
+	repoOwner   string
+	ccRegion    string
+	s3ObjectKey string // Key of the object for an S3 source, where repoName is the bucket.
+	imageTag    string // Tag of the image for an ECR source.
 
 	// Cached variables
 	wsAppName    string
@@ -270,7 +279,7 @@ func (o *initPipelineOpts) Ask() error {
 		return err
 	}
 
-	if o.repoBranch == "" {
+	if o.repoBranch == "" && o.isGitProvider() {
 		o.getBranch()
 	}
 
@@ -318,9 +327,23 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	deployAction := fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline deploy"))
+	switch o.provider {
+	case manifest.S3ProviderName:
+		return []string{
+			deployAction,
+			fmt.Sprintf("Upload a zip file of your workspace, including the %s directory, to %s to start your pipeline.",
+				color.HighlightResource("copilot/"), color.HighlightResource(fmt.Sprintf("%s%s/%s", s3URLPrefix, o.repoName, o.s3ObjectKey))),
+		}
+	case manifest.ECRProviderName:
+		return []string{
+			fmt.Sprintf("Set the %s of the workloads to deploy the pushed image with to %s.", color.HighlightCode("image.location"), color.HighlightCode("${COPILOT_SOURCE_IMAGE_URI}")),
+			deployAction,
+		}
+	}
 	return []string{
 		fmt.Sprintf("Commit and push the %s directory to your repository.", color.HighlightResource("copilot/")),
-		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline deploy")),
+		deployAction,
 	}
 }
 
@@ -386,6 +409,9 @@ func (o *initPipelineOpts) askPipelineName() error {
 
 	// Only show suggestion if [repo]-[branch] is a valid pipeline name.
 	suggestion := strings.ToLower(fmt.Sprintf("%s-%s", o.repoName, o.repoBranch))
+	if !o.isGitProvider() {
+		suggestion = strings.ToLower(strings.ReplaceAll(o.repoName, "/", "-"))
+	}
 	if err := validatePipelineName(suggestion, o.appName); err == nil {
 		promptOpts = append(promptOpts, prompt.WithDefaultInput(suggestion))
 	}
@@ -435,12 +461,23 @@ func (o *initPipelineOpts) askOrValidatePipelineType() error {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
+	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) &&
+		!isGitLabURL(url) && !strings.HasPrefix(url, s3URLPrefix) && !strings.Contains(url, ecrIdentifier) {
 		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"))
 	}
 	return nil
 }
 
+// isGitLabURL returns true if the url is a repository hosted on gitlab.com rather than on a self-managed GitLab instance.
+func isGitLabURL(url string) bool {
+	return strings.Contains(url, glURL+"/") || strings.Contains(url, glURL+":")
+}
+
+// isGitProvider returns true if the source of the pipeline is a git repository that has branches.
+func (o *initPipelineOpts) isGitProvider() bool {
+	return o.provider != manifest.S3ProviderName && o.provider != manifest.ECRProviderName
+}
+
 // To avoid duplicating calls to GetEnvironment, validate and get config in the same step.
 func (o *initPipelineOpts) validateEnvs() error {
 	var envConfigs []*config.Environment
@@ -475,6 +512,12 @@ func (o *initPipelineOpts) parseRepoDetails() error {
 		return o.parseCodeCommitRepoDetails()
 	case strings.Contains(o.repoURL, bbURL):
 		return o.parseBitbucketRepoDetails()
+	case isGitLabURL(o.repoURL):
+		return o.parseGitLabRepoDetails()
+	case strings.HasPrefix(o.repoURL, s3URLPrefix):
+		return o.parseS3ObjectDetails()
+	case strings.Contains(o.repoURL, ecrIdentifier):
+		return o.parseECRImageDetails()
 	default:
 		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(manifest.PipelineProviders, "or"))
 	}
@@ -551,6 +594,66 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseGitLabRepoDetails() error {
+	o.provider = manifest.GitLabProviderName
+	repoDetails, err := glRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.repoOwner = repoDetails.owner
+
+	return nil
+}
+
+func (o *initPipelineOpts) parseS3ObjectDetails() error {
+	o.provider = manifest.S3ProviderName
+	if o.repoBranch != "" {
+		return fmt.Errorf("cannot specify a git branch for the S3 object %s", o.repoURL)
+	}
+	objectDetails, err := s3ObjectURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = objectDetails.bucket
+	o.s3ObjectKey = objectDetails.key
+
+	return nil
+}
+
+func (o *initPipelineOpts) parseECRImageDetails() error {
+	o.provider = manifest.ECRProviderName
+	if o.repoBranch != "" {
+		return fmt.Errorf("cannot specify a git branch for the ECR image %s", o.repoURL)
+	}
+	imageDetails, err := ecrImageURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = imageDetails.name
+	o.imageTag = imageDetails.tag
+
+	// The ECR repository must be in the same region as the pipeline.
+	sess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("retrieve default session: %w", err)
+	}
+	region := aws.StringValue(sess.Config.Region)
+	if imageDetails.region != region {
+		return fmt.Errorf("repository %s is in %s, but app %s is in %s; they must be in the same region", o.repoName, imageDetails.region, o.appName, region)
+	}
+	// The ECR source action can't read repositories of other accounts either.
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if imageDetails.account != app.AccountID {
+		return fmt.Errorf("repository %s is in account %s, but app %s is in account %s; they must be in the same account", o.repoName, imageDetails.account, o.appName, app.AccountID)
+	}
+
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -597,6 +700,8 @@ func (o *initPipelineOpts) selectURL() error {
 // ssh		ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
 // bbhttps	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (fetch)
 // bbssh	ssh://git@bitbucket.org:teamsinspace/documentation-tests.git (fetch)
+// glhttps	https://gitlab.com/huanjani/aws-copilot-sample-service.git (fetch)
+// glssh	git@gitlab.com:huanjani/aws-copilot-sample-service.git (fetch)
 
 // parseGitRemoteResults returns just the trimmed middle column (url) of the `git remote -v` results,
 // and skips urls from unsupported sources.
@@ -605,7 +710,7 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) {
+		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) && !isGitLabURL(item) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -635,6 +740,26 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type glRepoURL string
+type glRepoDetails struct {
+	name  string
+	owner string
+}
+
+type s3ObjectURL string
+type s3ObjectDetails struct {
+	bucket string
+	key    string
+}
+
+type ecrImageURL string
+type ecrImageDetails struct {
+	name    string
+	tag     string
+	account string
+	region  string
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -707,6 +832,59 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// GitLab URLs, post-parseGitRemoteResults(), may look like:
+// https://gitlab.com/teamsinspace/documentation-tests
+// git@gitlab.com:teamsinspace/subgroup/documentation-tests
+// The owner of a repository in a subgroup is the full path of the subgroup.
+func (url glRepoURL) parse() (glRepoDetails, error) {
+	urlString := string(url)
+	regexPattern := regexp.MustCompile(`.*(gitlab.com)(:|\/)`)
+	parsedURL := strings.TrimPrefix(urlString, regexPattern.FindString(urlString))
+	parsedURL = strings.TrimSuffix(parsedURL, ".git")
+	idx := strings.LastIndex(parsedURL, "/")
+	if idx <= 0 || idx == len(parsedURL)-1 {
+		return glRepoDetails{}, fmt.Errorf("unable to parse the GitLab repository owner and name from %s: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`", url)
+	}
+	return glRepoDetails{
+		name:  parsedURL[idx+1:],
+		owner: parsedURL[:idx],
+	}, nil
+}
+
+// S3 URLs look like: s3://bucketName/path/to/source.zip
+func (url s3ObjectURL) parse() (s3ObjectDetails, error) {
+	bucket, key, ok := strings.Cut(strings.TrimPrefix(string(url), s3URLPrefix), "/")
+	if !ok || bucket == "" || key == "" || strings.HasSuffix(key, "/") {
+		return s3ObjectDetails{}, fmt.Errorf("unable to parse the S3 bucket and object key from %s: please pass the object URL with the format `--url s3://{bucket}/{key}`", url)
+	}
+	return s3ObjectDetails{
+		bucket: bucket,
+		key:    key,
+	}, nil
+}
+
+// ECR URLs look like: 123456789012.dkr.ecr.us-west-2.amazonaws.com/repoName:tag
+// The tag is optional.
+func (url ecrImageURL) parse() (ecrImageDetails, error) {
+	urlString := strings.TrimPrefix(string(url), "https://")
+	registry, image, ok := strings.Cut(urlString, "/")
+	if !ok || image == "" {
+		return ecrImageDetails{}, fmt.Errorf("unable to parse the ECR repository name from %s: please pass the image URL with the format `--url {account}.dkr.ecr.{region}.amazonaws.com/{repositoryName}:{tag}`", url)
+	}
+	// Ex: 123456789012.dkr.ecr.us-west-2.amazonaws.com
+	registryParts := strings.Split(registry, ".")
+	if len(registryParts) < 4 {
+		return ecrImageDetails{}, fmt.Errorf("unable to parse the AWS region from %s", url)
+	}
+	name, tag, _ := strings.Cut(image, ":")
+	return ecrImageDetails{
+		name:    name,
+		tag:     tag,
+		account: registryParts[0],
+		region:  registryParts[3],
+	}, nil
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -775,11 +953,13 @@ func (o *initPipelineOpts) createBuildspec(buildSpecTemplatePath string) error {
 		Version            string
 		ManifestPath       string
 		ArtifactBuckets    []artifactBucket
+		IsECRSource        bool
 	}{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
 		ManifestPath:       filepath.ToSlash(o.manifestPath), // The manifest path must be rendered in the buildspec with '/' instead of os-specific separator.
 		ArtifactBuckets:    artifactBuckets,
+		IsECRSource:        o.provider == manifest.ECRProviderName,
 	})
 	if err != nil {
 		return err
@@ -833,6 +1013,25 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.GitLabProviderName:
+		config = &manifest.GitLabProperties{
+			RepositoryURL: fmt.Sprintf(fmtGLRepoURL, glURL, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+		}
+	case manifest.S3ProviderName:
+		config = &manifest.S3Properties{
+			Bucket:    o.repoName,
+			ObjectKey: o.s3ObjectKey,
+		}
+	case manifest.ECRProviderName:
+		tag := o.imageTag
+		if tag == "" {
+			tag = "latest"
+		}
+		config = &manifest.ECRProperties{
+			Repository: o.repoName,
+			Tag:        tag,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
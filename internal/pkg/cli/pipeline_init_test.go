@@ -89,7 +89,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			setupMocks: func(m pipelineInitMocks) {
 				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
 			},
-			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit, Bitbucket, GitLab, S3 or ECR"),
 		},
 		"returns error when GitHub repository URL is of unknown format": {
 			inWsAppName: mockAppName,
@@ -136,6 +136,62 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			expectedError: errors.New("unable to parse the Bitbucket repository name from bitbucket.org"),
 		},
+		"returns error when a git branch is specified for an S3 object": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "s3://my-bucket/source.zip",
+			inGitBranch: "main",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+			},
+			expectedError: errors.New("cannot specify a git branch for the S3 object s3://my-bucket/source.zip"),
+		},
+		"returns error when ECR repository region does not match pipeline's region": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.sessProvider.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-east-1"),
+					},
+				}, nil)
+			},
+			expectedError: errors.New("repository frontend is in us-west-2, but app my-app is in us-east-1; they must be in the same region"),
+		},
+		"returns error when ECR repository account does not match the app's account": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
+					Name:      mockAppName,
+					AccountID: "210987654321",
+				}, nil).Times(2)
+				m.sessProvider.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				}, nil)
+			},
+			expectedError: errors.New("repository frontend is in account 123456789012, but app my-app is in account 210987654321; they must be in the same account"),
+		},
+		"does not detect the local branch of an S3 source": {
+			inWsAppName:    mockAppName,
+			inRepoURL:      "s3://my-bucket/source.zip",
+			inEnvironments: []string{"test"},
+			inName:         wantedName,
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(
+					&config.Environment{
+						Name: "test",
+					}, nil)
+				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
+				m.workspace.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{}, nil)
+			},
+			expectedBranch: "",
+		},
 		"successfully detects local branch and sets it": {
 			inWsAppName:    mockAppName,
 			inRepoURL:      "git@github.com:badgoose/goose.git",
@@ -249,7 +305,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
 
-			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit, Bitbucket, GitLab, S3 or ECR"),
 		},
 		"passed-in invalid environments": {
 			inWsAppName:    mockAppName,
@@ -914,12 +970,13 @@ koke	git://github.com/koke/grit.git (push)
 https	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (fetch)
 fed	codecommit::us-west-2://aws-sample (fetch)
 ssh	ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
-bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)`,
+bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)
+gl	git@gitlab.com:huanjani/aws-copilot-sample-service.git (push)`,
 
-			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service"},
+			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service", "git@gitlab.com:huanjani/aws-copilot-sample-service"},
 		},
-		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket URL": {
-			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
+		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket or GitLab URL": {
+			inRemoteResult: `badgoose	verybad@gitlab.company.com/whatever (fetch)`,
 
 			expectedURLs: []string{},
 		},
@@ -1074,3 +1131,138 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineGLRepoURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURL glRepoURL
+
+		expectedDetails glRepoDetails
+		expectedError   error
+	}{
+		"successfully parses https url": {
+			inRepoURL: "https://gitlab.com/huanjani/aws-copilot-sample-service",
+
+			expectedDetails: glRepoDetails{
+				name:  "aws-copilot-sample-service",
+				owner: "huanjani",
+			},
+		},
+		"successfully parses ssh url of a repository in a subgroup": {
+			inRepoURL: "git@gitlab.com:huanjani/samples/aws-copilot-sample-service.git",
+
+			expectedDetails: glRepoDetails{
+				name:  "aws-copilot-sample-service",
+				owner: "huanjani/samples",
+			},
+		},
+		"returns error if the owner is missing": {
+			inRepoURL: "https://gitlab.com/aws-copilot-sample-service",
+
+			expectedError: errors.New("unable to parse the GitLab repository owner and name from https://gitlab.com/aws-copilot-sample-service: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := glRepoURL.parse(tc.inRepoURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
+
+func TestInitPipelineS3ObjectURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inURL s3ObjectURL
+
+		expectedDetails s3ObjectDetails
+		expectedError   error
+	}{
+		"successfully parses url": {
+			inURL: "s3://my-bucket/source/frontend.zip",
+
+			expectedDetails: s3ObjectDetails{
+				bucket: "my-bucket",
+				key:    "source/frontend.zip",
+			},
+		},
+		"returns error if the key is missing": {
+			inURL: "s3://my-bucket/",
+
+			expectedError: errors.New("unable to parse the S3 bucket and object key from s3://my-bucket/: please pass the object URL with the format `--url s3://{bucket}/{key}`"),
+		},
+		"returns error if the key is a prefix": {
+			inURL: "s3://my-bucket/source/",
+
+			expectedError: errors.New("unable to parse the S3 bucket and object key from s3://my-bucket/source/: please pass the object URL with the format `--url s3://{bucket}/{key}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := s3ObjectURL.parse(tc.inURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
+
+func TestInitPipelineECRImageURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inURL ecrImageURL
+
+		expectedDetails ecrImageDetails
+		expectedError   error
+	}{
+		"successfully parses url with a tag": {
+			inURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/shared/frontend:release",
+
+			expectedDetails: ecrImageDetails{
+				name:    "shared/frontend",
+				tag:     "release",
+				account: "123456789012",
+				region:  "us-west-2",
+			},
+		},
+		"successfully parses url without a tag": {
+			inURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend",
+
+			expectedDetails: ecrImageDetails{
+				name:    "frontend",
+				account: "123456789012",
+				region:  "us-west-2",
+			},
+		},
+		"returns error if the repository is missing": {
+			inURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+
+			expectedError: errors.New("unable to parse the ECR repository name from 123456789012.dkr.ecr.us-west-2.amazonaws.com: please pass the image URL with the format `--url {account}.dkr.ecr.{region}.amazonaws.com/{repositoryName}:{tag}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := ecrImageURL.parse(tc.inURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
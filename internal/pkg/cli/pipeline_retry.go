@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cp "github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRetryAppNamePrompt     = "Which application's pipeline would you like to retry?"
+	pipelineRetryAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineRetryPrompt      = "Which pipeline of %s would you like to retry?"
+	fmtPipelineRetryStagePrompt = "Which failed stage of %s would you like to retry?"
+)
+
+type pipelineRetryVars struct {
+	appName string
+	name    string
+	stage   string
+	watch   bool
+}
+
+type pipelineRetryOpts struct {
+	pipelineRetryVars
+
+	store                  store
+	codepipeline           pipelineStageRetrier
+	sel                    codePipelineSelector
+	prompt                 prompter
+	deployedPipelineLister deployedPipelineLister
+	// watchExecution renders the pipeline execution until it stops and returns its final description.
+	watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+	targetStage    *codepipeline.StageState
+}
+
+func newPipelineRetryOpts(vars pipelineRetryVars) (*pipelineRetryOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline retry")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	client := codepipeline.New(sess)
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	prompter := prompt.New()
+	return &pipelineRetryOpts{
+		pipelineRetryVars:      vars,
+		store:                  store,
+		codepipeline:           client,
+		sel:                    selector.NewAppPipelineSelector(prompter, store, pipelineLister),
+		prompt:                 prompter,
+		deployedPipelineLister: pipelineLister,
+		watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+			return renderPipelineExecution(client, pipelineName, executionID)
+		},
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineRetryOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineRetryOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskPipeline(); err != nil {
+		return err
+	}
+	return o.validateOrAskStage()
+}
+
+// Execute retries the failed actions of the stage, and watches the execution until it stops if requested.
+func (o *pipelineRetryOpts) Execute() error {
+	executionID, err := o.codepipeline.RetryFailedActions(o.targetPipeline.ResourceName, o.stage, o.targetStage.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("retry stage %s of pipeline %s: %w", o.stage, o.name, err)
+	}
+	log.Successf("Retrying the failed actions of stage %s in pipeline %s.\n", color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+	if !o.watch {
+		return nil
+	}
+	return waitForPipelineExecution(o.watchExecution, *o.targetPipeline, executionID)
+}
+
+func (o *pipelineRetryOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *pipelineRetryOpts) validateOrAskPipeline() error {
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRetryPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+func (o *pipelineRetryOpts) validateOrAskStage() error {
+	state, err := o.codepipeline.GetPipelineState(o.targetPipeline.ResourceName)
+	if err != nil {
+		return fmt.Errorf("get state of pipeline %s: %w", o.name, err)
+	}
+	failed := make(map[string]*codepipeline.StageState)
+	var names []string
+	for _, stage := range state.StageStates {
+		if stage.AggregateStatus() != cp.StageExecutionStatusFailed {
+			continue
+		}
+		failed[stage.StageName] = stage
+		names = append(names, stage.StageName)
+	}
+	if o.stage != "" {
+		stage, ok := failed[o.stage]
+		if !ok {
+			return fmt.Errorf("stage %s of pipeline %s has no failed actions to retry", o.stage, o.name)
+		}
+		o.targetStage = stage
+		return nil
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no failed stages to retry in pipeline %s", o.name)
+	}
+	name, err := selectPipelineStage(o.prompt, fmt.Sprintf(fmtPipelineRetryStagePrompt, color.HighlightUserInput(o.name)), names)
+	if err != nil {
+		return err
+	}
+	o.stage = name
+	o.targetStage = failed[name]
+	return nil
+}
+
+// selectPipelineStage prompts for one of the stages, or returns the stage if there is only one.
+func selectPipelineStage(p prompter, msg string, stages []string) (string, error) {
+	if len(stages) == 1 {
+		log.Infof("Only found one stage, defaulting to: %s\n", color.HighlightUserInput(stages[0]))
+		return stages[0], nil
+	}
+	stage, err := p.SelectOne(msg, "", stages, prompt.WithFinalMessage("Stage:"))
+	if err != nil {
+		return "", fmt.Errorf("select stage: %w", err)
+	}
+	return stage, nil
+}
+
+// buildPipelineRetryCmd builds the command for retrying the failed stage of a deployed pipeline.
+func buildPipelineRetryCmd() *cobra.Command {
+	vars := pipelineRetryVars{}
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retries the failed actions of a pipeline stage.",
+		Long:  "Retries the failed actions of a stage in the latest execution of a deployed pipeline.",
+
+		Example: `
+  Retries the failed "DeployTo-test" stage of the pipeline "my-repo-my-branch".
+  /code $ copilot pipeline retry -n my-repo-my-branch --stage DeployTo-test
+  Retries the failed stage and watches the execution until it finishes.
+  /code $ copilot pipeline retry -n my-repo-my-branch --watch`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRetryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, stageFlag, "", pipelineRetryStageFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, pipelineWatchFlagDescription)
+	return cmd
+}
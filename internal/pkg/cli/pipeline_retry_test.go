@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRetryMocks struct {
+	store                  *mocks.Mockstore
+	codepipeline           *mocks.MockpipelineStageRetrier
+	sel                    *mocks.MockcodePipelineSelector
+	prompt                 *mocks.Mockprompter
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+}
+
+func TestPipelineRetryOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "my-pipeline"
+		mockResourceName = "pipeline-dinder-my-pipeline-RANDOM"
+	)
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		ResourceName: mockResourceName,
+		Name:         mockPipelineName,
+	}
+	failedTest := &codepipeline.StageState{
+		StageName:   "DeployTo-test",
+		Actions:     []codepipeline.StageAction{{Name: "TestCommands", Status: "Failed"}},
+		ExecutionID: "execution-1",
+	}
+	failedProd := &codepipeline.StageState{
+		StageName:   "DeployTo-prod",
+		Actions:     []codepipeline.StageAction{{Name: "CreateOrUpdate-api-prod", Status: "Failed"}},
+		ExecutionID: "execution-2",
+	}
+	succeededSource := &codepipeline.StageState{
+		StageName:   "Source",
+		Actions:     []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}},
+		ExecutionID: "execution-2",
+	}
+	validFlags := func(m pipelineRetryMocks) {
+		m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+		m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+	}
+
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		inStage        string
+		setupMocks     func(m pipelineRetryMocks)
+
+		wantedPipeline *deploy.Pipeline
+		wantedStage    string
+		wantedState    *codepipeline.StageState
+		wantedErr      error
+	}{
+		"errors if fail to select app name": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.sel.EXPECT().Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+		"errors if the pipeline state can't be retrieved": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get state of pipeline my-pipeline: some error"),
+		},
+		"errors if the stage flag is not a failed stage": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "Source",
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{succeededSource, failedTest},
+				}, nil)
+			},
+			wantedErr: errors.New("stage Source of pipeline my-pipeline has no failed actions to retry"),
+		},
+		"errors if there are no failed stages": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{succeededSource},
+				}, nil)
+			},
+			wantedErr: errors.New("no failed stages to retry in pipeline my-pipeline"),
+		},
+		"validates the stage flag": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			inStage:        "DeployTo-test",
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{succeededSource, failedTest},
+				}, nil)
+			},
+			wantedPipeline: &mockPipeline,
+			wantedStage:    "DeployTo-test",
+			wantedState:    failedTest,
+		},
+		"defaults to the only failed stage": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{succeededSource, failedTest},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedPipeline: &mockPipeline,
+			wantedStage:    "DeployTo-test",
+			wantedState:    failedTest,
+		},
+		"prompts for the app, the pipeline and the failed stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.sel.EXPECT().Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt).Return(mockAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{succeededSource, failedTest, failedProd},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"DeployTo-test", "DeployTo-prod"}, gomock.Any()).Return("DeployTo-prod", nil)
+			},
+			wantedPipeline: &mockPipeline,
+			wantedStage:    "DeployTo-prod",
+			wantedState:    failedProd,
+		},
+		"errors if fail to select the stage": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				validFlags(m)
+				m.codepipeline.EXPECT().GetPipelineState(mockResourceName).Return(&codepipeline.PipelineState{
+					StageStates: []*codepipeline.StageState{failedTest, failedProd},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select stage: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRetryMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				codepipeline:           mocks.NewMockpipelineStageRetrier(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				prompt:                 mocks.NewMockprompter(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+					stage:   tc.inStage,
+				},
+				store:                  m.store,
+				codepipeline:           m.codepipeline,
+				sel:                    m.sel,
+				prompt:                 m.prompt,
+				deployedPipelineLister: m.deployedPipelineLister,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipeline, opts.targetPipeline)
+			require.Equal(t, tc.wantedStage, opts.stage)
+			require.Equal(t, tc.wantedState, opts.targetStage)
+		})
+	}
+}
+
+func TestPipelineRetryOpts_Execute(t *testing.T) {
+	mockPipeline := &deploy.Pipeline{
+		AppName:      "dinder",
+		ResourceName: "pipeline-dinder-my-pipeline-RANDOM",
+		Name:         "my-pipeline",
+	}
+	mockStage := &codepipeline.StageState{
+		StageName:   "DeployTo-test",
+		Actions:     []codepipeline.StageAction{{Name: "TestCommands", Status: "Failed"}},
+		ExecutionID: "execution-id",
+	}
+
+	testCases := map[string]struct {
+		inWatch        bool
+		setupMocks     func(m pipelineRetryMocks)
+		watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+		wantedErr error
+	}{
+		"errors if the stage can't be retried": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.codepipeline.EXPECT().RetryFailedActions("pipeline-dinder-my-pipeline-RANDOM", "DeployTo-test", "execution-id").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retry stage DeployTo-test of pipeline my-pipeline: some error"),
+		},
+		"retries the stage without watching the execution": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.codepipeline.EXPECT().RetryFailedActions("pipeline-dinder-my-pipeline-RANDOM", "DeployTo-test", "execution-id").Return("execution-id", nil)
+			},
+		},
+		"errors if the watched execution is stopped": {
+			inWatch: true,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.codepipeline.EXPECT().RetryFailedActions(gomock.Any(), gomock.Any(), gomock.Any()).Return("execution-id", nil)
+			},
+			watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+				require.Equal(t, "pipeline-dinder-my-pipeline-RANDOM", pipelineName)
+				require.Equal(t, "execution-id", executionID)
+				return &stream.PipelineExecution{Status: "Stopped"}, nil
+			},
+			wantedErr: errors.New("execution of pipeline my-pipeline ended with status Stopped"),
+		},
+		"succeeds if the watched execution succeeds": {
+			inWatch: true,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.codepipeline.EXPECT().RetryFailedActions(gomock.Any(), gomock.Any(), gomock.Any()).Return("execution-id", nil)
+			},
+			watchExecution: func(_, _ string) (*stream.PipelineExecution, error) {
+				return &stream.PipelineExecution{Status: "Succeeded"}, nil
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRetryMocks{
+				codepipeline: mocks.NewMockpipelineStageRetrier(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRetryOpts{
+				pipelineRetryVars: pipelineRetryVars{
+					name:  "my-pipeline",
+					stage: "DeployTo-test",
+					watch: tc.inWatch,
+				},
+				codepipeline:   m.codepipeline,
+				watchExecution: tc.watchExecution,
+				targetPipeline: mockPipeline,
+				targetStage:    mockStage,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cp "github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	pipelineRunAppNamePrompt     = "Which application's pipeline would you like to run?"
+	pipelineRunAppNameHelpPrompt = "An application is a collection of related services."
+
+	fmtPipelineRunPrompt = "Which pipeline of %s would you like to run?"
+)
+
+type pipelineRunVars struct {
+	appName string
+	name    string
+	watch   bool
+}
+
+type pipelineRunOpts struct {
+	pipelineRunVars
+
+	store                  store
+	codepipeline           pipelineExecutionStarter
+	sel                    codePipelineSelector
+	deployedPipelineLister deployedPipelineLister
+	// watchExecution renders the pipeline execution until it stops and returns its final description.
+	watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newPipelineRunOpts(vars pipelineRunVars) (*pipelineRunOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline run")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("session: %w", err)
+	}
+	client := codepipeline.New(sess)
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	return &pipelineRunOpts{
+		pipelineRunVars:        vars,
+		store:                  store,
+		codepipeline:           client,
+		sel:                    selector.NewAppPipelineSelector(prompt.New(), store, pipelineLister),
+		deployedPipelineLister: pipelineLister,
+		watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+			return renderPipelineExecution(client, pipelineName, executionID)
+		},
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *pipelineRunOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *pipelineRunOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskPipeline()
+}
+
+// Execute starts a new execution of the pipeline, and watches it until it stops if requested.
+func (o *pipelineRunOpts) Execute() error {
+	executionID, err := o.codepipeline.StartPipelineExecution(o.targetPipeline.ResourceName)
+	if err != nil {
+		return fmt.Errorf("run pipeline %s: %w", o.name, err)
+	}
+	log.Successf("Started execution %s of pipeline %s.\n", executionID, color.HighlightUserInput(o.name))
+	if !o.watch {
+		return nil
+	}
+	return waitForPipelineExecution(o.watchExecution, *o.targetPipeline, executionID)
+}
+
+func (o *pipelineRunOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *pipelineRunOpts) validateOrAskPipeline() error {
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRunPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+// renderPipelineExecution streams the stages of the pipeline execution to the diagnostic writer until it stops.
+func renderPipelineExecution(client stream.PipelineExecutionDescriber, pipelineName, executionID string) (*stream.PipelineExecution, error) {
+	streamer := stream.NewPipelineExecutionStreamer(client, pipelineName, executionID)
+	renderer := termprogress.ListeningPipelineExecutionRenderer(streamer, termprogress.RenderOptions{})
+
+	// Keep track of the latest description to report the final status of the execution.
+	var last stream.PipelineExecution
+	descriptions := streamer.Subscribe()
+	g, ctx := errgroup.WithContext(context.Background())
+	g.Go(func() error {
+		for desc := range descriptions {
+			last = desc
+		}
+		return nil
+	})
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		_, err := termprogress.Render(ctx, termprogress.NewTabbedFileWriter(os.Stderr), renderer)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return &last, nil
+}
+
+// waitForPipelineExecution watches the execution of the pipeline and returns an error if it did not succeed.
+func waitForPipelineExecution(watch func(pipelineName, executionID string) (*stream.PipelineExecution, error), pipeline deploy.Pipeline, executionID string) error {
+	execution, err := watch(pipeline.ResourceName, executionID)
+	if err != nil {
+		return fmt.Errorf("watch execution %s of pipeline %s: %w", executionID, pipeline.Name, err)
+	}
+	if execution.Status != cp.PipelineExecutionStatusSucceeded {
+		return &errPipelineExecutionFailed{
+			pipeline: pipeline.Name,
+			status:   execution.Status,
+			summary:  execution.StatusSummary,
+		}
+	}
+	log.Successf("Execution %s of pipeline %s succeeded.\n", executionID, color.HighlightUserInput(pipeline.Name))
+	return nil
+}
+
+type errPipelineExecutionFailed struct {
+	pipeline string
+	status   string
+	summary  string
+}
+
+func (e *errPipelineExecutionFailed) Error() string {
+	msg := fmt.Sprintf("execution of pipeline %s ended with status %s", e.pipeline, e.status)
+	if e.summary == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, e.summary)
+}
+
+// buildPipelineRunCmd builds the command for starting a new execution of a deployed pipeline.
+func buildPipelineRunCmd() *cobra.Command {
+	vars := pipelineRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs the release process of a pipeline.",
+		Long:  "Starts a new execution of a deployed pipeline with the latest revision of its source.",
+
+		Example: `
+  Runs the pipeline "my-repo-my-branch".
+  /code $ copilot pipeline run -n my-repo-my-branch
+  Runs the pipeline and watches the execution until it finishes.
+  /code $ copilot pipeline run -n my-repo-my-branch --watch`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPipelineRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, pipelineWatchFlagDescription)
+	return cmd
+}
@@ -0,0 +1,217 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRunMocks struct {
+	store                  *mocks.Mockstore
+	codepipeline           *mocks.MockpipelineExecutionStarter
+	sel                    *mocks.MockcodePipelineSelector
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+}
+
+func TestPipelineRunOpts_Ask(t *testing.T) {
+	const (
+		mockAppName      = "dinder"
+		mockPipelineName = "my-pipeline"
+	)
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		ResourceName: "pipeline-dinder-my-pipeline-RANDOM",
+		Name:         mockPipelineName,
+	}
+
+	testCases := map[string]struct {
+		inAppName      string
+		inPipelineName string
+		setupMocks     func(m pipelineRunMocks)
+
+		wantedApp      string
+		wantedPipeline *deploy.Pipeline
+		wantedErr      error
+	}{
+		"errors if the app does not exist": {
+			inAppName: mockAppName,
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("validate application name: some error"),
+		},
+		"errors if fail to select app name": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+		"errors if the pipeline is not deployed": {
+			inAppName:      mockAppName,
+			inPipelineName: "unknown",
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			wantedErr: errors.New("validate pipeline name unknown: cannot find pipeline named unknown"),
+		},
+		"validates the flags": {
+			inAppName:      mockAppName,
+			inPipelineName: mockPipelineName,
+			setupMocks: func(m pipelineRunMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: &mockPipeline,
+		},
+		"prompts for the app and the pipeline": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.sel.EXPECT().Application(pipelineRunAppNamePrompt, pipelineRunAppNameHelpPrompt).Return(mockAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: &mockPipeline,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRunMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					appName: tc.inAppName,
+					name:    tc.inPipelineName,
+				},
+				store:                  m.store,
+				sel:                    m.sel,
+				deployedPipelineLister: m.deployedPipelineLister,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedPipeline, opts.targetPipeline)
+		})
+	}
+}
+
+func TestPipelineRunOpts_Execute(t *testing.T) {
+	mockPipeline := &deploy.Pipeline{
+		AppName:      "dinder",
+		ResourceName: "pipeline-dinder-my-pipeline-RANDOM",
+		Name:         "my-pipeline",
+	}
+
+	testCases := map[string]struct {
+		inWatch        bool
+		setupMocks     func(m pipelineRunMocks)
+		watchExecution func(pipelineName, executionID string) (*stream.PipelineExecution, error)
+
+		wantedErr error
+	}{
+		"errors if the execution fails to start": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartPipelineExecution("pipeline-dinder-my-pipeline-RANDOM").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("run pipeline my-pipeline: some error"),
+		},
+		"starts the execution without watching it": {
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartPipelineExecution("pipeline-dinder-my-pipeline-RANDOM").Return("execution-id", nil)
+			},
+			watchExecution: func(_, _ string) (*stream.PipelineExecution, error) {
+				return nil, errors.New("should not be called")
+			},
+		},
+		"errors if the execution can't be watched": {
+			inWatch: true,
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartPipelineExecution(gomock.Any()).Return("execution-id", nil)
+			},
+			watchExecution: func(_, _ string) (*stream.PipelineExecution, error) {
+				return nil, errors.New("some error")
+			},
+			wantedErr: errors.New("watch execution execution-id of pipeline my-pipeline: some error"),
+		},
+		"errors if the watched execution fails": {
+			inWatch: true,
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartPipelineExecution(gomock.Any()).Return("execution-id", nil)
+			},
+			watchExecution: func(pipelineName, executionID string) (*stream.PipelineExecution, error) {
+				require.Equal(t, "pipeline-dinder-my-pipeline-RANDOM", pipelineName)
+				require.Equal(t, "execution-id", executionID)
+				return &stream.PipelineExecution{
+					Status:        "Failed",
+					StatusSummary: "Action TestCommands failed",
+				}, nil
+			},
+			wantedErr: errors.New("execution of pipeline my-pipeline ended with status Failed: Action TestCommands failed"),
+		},
+		"succeeds if the watched execution succeeds": {
+			inWatch: true,
+			setupMocks: func(m pipelineRunMocks) {
+				m.codepipeline.EXPECT().StartPipelineExecution(gomock.Any()).Return("execution-id", nil)
+			},
+			watchExecution: func(_, _ string) (*stream.PipelineExecution, error) {
+				return &stream.PipelineExecution{Status: "Succeeded"}, nil
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := pipelineRunMocks{
+				codepipeline: mocks.NewMockpipelineExecutionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &pipelineRunOpts{
+				pipelineRunVars: pipelineRunVars{
+					name:  "my-pipeline",
+					watch: tc.inWatch,
+				},
+				codepipeline:   m.codepipeline,
+				watchExecution: tc.watchExecution,
+				targetPipeline: mockPipeline,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,574 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	runLocalWkldNamePrompt = "Which workload would you like to run locally?"
+	runLocalEnvNamePrompt  = "Which environment's configuration would you like to run %s with?"
+	runLocalEnvNameHelp    = "Copilot applies the environment's manifest overrides and fetches the secrets of the environment."
+
+	localImageTag = "local"
+
+	minEnvVersionForRunLocalSecretsManager = "v1.21.0" // The environment manager role can read the secrets tagged with the app and env.
+)
+
+// Environment variables that Copilot injects in the containers of a workload.
+const (
+	envVarApplicationName          = "COPILOT_APPLICATION_NAME"
+	envVarEnvironmentName          = "COPILOT_ENVIRONMENT_NAME"
+	envVarServiceName              = "COPILOT_SERVICE_NAME"
+	envVarServiceDiscoveryEndpoint = "COPILOT_SERVICE_DISCOVERY_ENDPOINT"
+)
+
+type runLocalVars struct {
+	wkldName string
+	envName  string
+	appName  string
+}
+
+type runLocalOpts struct {
+	runLocalVars
+
+	ws              wsWlDirReader
+	store           store
+	sel             wsSelector
+	docker          localContainerRunner
+	unmarshal       func([]byte) (manifest.DynamicWorkload, error)
+	newInterpolator func(app, env string) interpolator
+	notifyInterrupt func(c chan<- os.Signal)
+
+	// Clients that assume the manager role of the environment, initialized by initEnvClients.
+	envSess        *session.Session
+	ssm            secretValueGetter
+	secretsManager secretValueGetter
+	endpointGetter serviceDiscoveryEndpointGetter
+	envChecker     versionCompatibilityChecker
+	initEnvClients func() error
+
+	// Cached variables.
+	isSecretsManagerCompatible bool // True once the environment is known to allow reading Secrets Manager secrets.
+}
+
+func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("run local"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+
+	opts := &runLocalOpts{
+		runLocalVars:    vars,
+		ws:              ws,
+		store:           store,
+		sel:             selector.NewLocalWorkloadSelector(prompt.New(), store, ws),
+		docker:          dockerengine.New(exec.NewCmd()),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		notifyInterrupt: func(c chan<- os.Signal) {
+			signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		},
+	}
+	opts.initEnvClients = func() error {
+		env, err := store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return err
+		}
+		opts.envSess = sess
+		opts.ssm = ssm.New(sess)
+		opts.secretsManager = secretsmanager.New(sess)
+		opts.endpointGetter = envDescriber
+		opts.envChecker = envDescriber
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *runLocalOpts) Validate() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *runLocalOpts) Ask() error {
+	if err := o.validateOrAskWorkloadName(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnvName()
+}
+
+// Execute builds the images of the workload, and runs its containers with the configuration of the environment
+// until one of them exits or the command is interrupted.
+func (o *runLocalOpts) Execute() error {
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return fmt.Errorf("check if docker engine is running: %w", err)
+	}
+	if err := o.initEnvClients(); err != nil {
+		return err
+	}
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.wkldName,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+		sess:         o.envSess,
+	})
+	if err != nil {
+		return err
+	}
+	wkld, err := newLocalWorkload(mft.Manifest())
+	if err != nil {
+		return err
+	}
+	containers, err := o.containers(wkld)
+	if err != nil {
+		return err
+	}
+	return o.run(containers)
+}
+
+func (o *runLocalOpts) validateOrAskWorkloadName() error {
+	if o.wkldName != "" {
+		names, err := o.ws.ListWorkloads()
+		if err != nil {
+			return fmt.Errorf("list workloads in the workspace: %w", err)
+		}
+		if !contains(o.wkldName, names) {
+			return fmt.Errorf("workload %q does not exist in the workspace", o.wkldName)
+		}
+		return nil
+	}
+	name, err := o.sel.Workload(runLocalWkldNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select workload: %w", err)
+	}
+	o.wkldName = name
+	return nil
+}
+
+func (o *runLocalOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Environment(fmt.Sprintf(runLocalEnvNamePrompt, color.HighlightUserInput(o.wkldName)), runLocalEnvNameHelp, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// localWorkload holds the configuration of the containers of a workload that runs on Amazon ECS.
+type localWorkload struct {
+	image       manifest.Image
+	port        *uint16
+	healthCheck manifest.ContainerHealthCheck
+	override    manifest.ImageOverride
+	task        manifest.TaskConfig
+	sidecars    map[string]*manifest.SidecarConfig
+}
+
+func newLocalWorkload(mft any) (*localWorkload, error) {
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	case *manifest.BackendService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	case *manifest.WorkerService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	case *manifest.ScheduledJob:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	default:
+		return nil, errors.New("only services and jobs that run on Amazon ECS can run locally")
+	}
+}
+
+// containers builds the images of the workload and returns the options to run its main container and its sidecars.
+func (o *runLocalOpts) containers(wkld *localWorkload) ([]*dockerengine.RunOptions, error) {
+	endpoint, err := o.endpointGetter.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, fmt.Errorf("get service discovery endpoint of environment %s: %w", o.envName, err)
+	}
+	main, err := o.container(o.wkldName, localContainerConfig{
+		image:       &wkld.image.ImageLocationOrBuild,
+		variables:   wkld.task.Variables,
+		secrets:     wkld.task.Secrets,
+		healthCheck: wkld.healthCheck,
+		override:    wkld.override,
+		endpoint:    endpoint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if wkld.port != nil {
+		port := fmt.Sprintf("%d", aws.Uint16Value(wkld.port))
+		main.PortMappings = map[string]string{port: port}
+	}
+	containers := []*dockerengine.RunOptions{main}
+
+	names := make([]string, 0, len(wkld.sidecars))
+	for name := range wkld.sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sidecar := wkld.sidecars[name]
+		img := &sidecar.Image.Advanced
+		if uri, ok := sidecar.ImageURI(); ok {
+			img = &manifest.ImageLocationOrBuild{Location: aws.String(uri)}
+		}
+		c, err := o.container(name, localContainerConfig{
+			image:       img,
+			variables:   sidecar.Variables,
+			secrets:     sidecar.Secrets,
+			healthCheck: sidecar.HealthCheck,
+			override:    sidecar.ImageOverride,
+			endpoint:    endpoint,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if port := aws.StringValue(sidecar.Port); port != "" {
+			// The port of a sidecar can be followed by its protocol, for example "2000/udp".
+			c.PortMappings = map[string]string{strings.Split(port, "/")[0]: port}
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+type localContainerConfig struct {
+	image       *manifest.ImageLocationOrBuild
+	variables   map[string]manifest.Variable
+	secrets     map[string]manifest.Secret
+	healthCheck manifest.ContainerHealthCheck
+	override    manifest.ImageOverride
+	endpoint    string
+}
+
+func (o *runLocalOpts) container(name string, cfg localContainerConfig) (*dockerengine.RunOptions, error) {
+	uri, err := o.imageURI(name, cfg.image)
+	if err != nil {
+		return nil, err
+	}
+	envVars := map[string]string{
+		envVarApplicationName:          o.appName,
+		envVarEnvironmentName:          o.envName,
+		envVarServiceName:              o.wkldName,
+		envVarServiceDiscoveryEndpoint: cfg.endpoint,
+	}
+	for k, v := range cfg.variables {
+		if v.RequiresImport() {
+			return nil, fmt.Errorf("variable %s of container %s: values imported from CloudFormation stacks are not supported locally", k, name)
+		}
+		envVars[k] = v.Value()
+	}
+	secrets := make(map[string]string, len(cfg.secrets))
+	for k, v := range cfg.secrets {
+		value, err := o.secretValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("get value of secret %s of container %s: %w", k, name, err)
+		}
+		secrets[k] = value
+	}
+	entryPoint, err := cfg.override.EntryPoint.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert entrypoint of container %s to string slice: %w", name, err)
+	}
+	command, err := cfg.override.Command.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert command of container %s to string slice: %w", name, err)
+	}
+	return &dockerengine.RunOptions{
+		ImageURI:       uri,
+		ContainerName:  fmt.Sprintf("%s-%s", o.networkName(), name),
+		NetworkName:    o.networkName(),
+		NetworkAliases: []string{name},
+		EnvVars:        envVars,
+		Secrets:        secrets,
+		EntryPoint:     entryPoint,
+		Command:        command,
+		HealthCheck:    localHealthCheck(cfg.healthCheck),
+	}, nil
+}
+
+// imageURI returns the location of the image of a container, or builds the image if it's built from a Dockerfile.
+func (o *runLocalOpts) imageURI(container string, img *manifest.ImageLocationOrBuild) (string, error) {
+	if img.Location != nil {
+		return aws.StringValue(img.Location), nil
+	}
+	uri := fmt.Sprintf("%s/%s/%s:%s", o.appName, o.wkldName, container, localImageTag)
+	build := img.BuildConfig(o.ws.Path())
+	err := o.docker.Build(&dockerengine.BuildArguments{
+		URI:        uri,
+		Dockerfile: aws.StringValue(build.Dockerfile),
+		Context:    aws.StringValue(build.Context),
+		Args:       build.Args,
+		Target:     aws.StringValue(build.Target),
+		CacheFrom:  build.CacheFrom,
+	})
+	if err != nil {
+		return "", fmt.Errorf("build image of container %s: %w", container, err)
+	}
+	return uri, nil
+}
+
+// secretValue returns the value of a secret stored in SSM Parameter Store or in Secrets Manager.
+func (o *runLocalOpts) secretValue(secret manifest.Secret) (string, error) {
+	if secret.RequiresImport() {
+		return "", errors.New("values imported from CloudFormation stacks are not supported locally")
+	}
+	ref := secret.Value()
+	if secret.IsSecretsManagerName() {
+		return o.secretsManagerValue(ref, 1)
+	}
+	if parsed, err := arn.Parse(ref); err == nil && parsed.Service == awssecretsmanager.ServiceName {
+		// The ARN of a secret is formatted as "arn:partition:secretsmanager:region:account:secret:name".
+		return o.secretsManagerValue(ref, 7)
+	}
+	return o.ssm.GetSecretValue(ref)
+}
+
+// secretsManagerValue returns the value of a secret referenced the same way as in an ECS task definition,
+// that is "<secret>:<json-key>:<version-stage>:<version-id>" where the secret is made of idParts parts.
+// The version of the secret is ignored and the current version of the secret is returned.
+func (o *runLocalOpts) secretsManagerValue(ref string, idParts int) (string, error) {
+	if err := o.validateSecretsManagerCompatible(); err != nil {
+		return "", err
+	}
+	parts := strings.Split(ref, ":")
+	if len(parts) < idParts {
+		return "", fmt.Errorf("invalid secret reference %s", ref)
+	}
+	id := strings.Join(parts[:idParts], ":")
+	var key string
+	if len(parts) > idParts {
+		key = parts[idParts]
+	}
+	value, err := o.secretsManager.GetSecretValue(id)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return value, nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return "", fmt.Errorf("unmarshal value of secret %s as JSON: %w", id, err)
+	}
+	field, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("key %q does not exist in secret %s", key, id)
+	}
+	if s, ok := field.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(field)
+	if err != nil {
+		return "", fmt.Errorf("marshal key %q of secret %s: %w", key, id, err)
+	}
+	return string(out), nil
+}
+
+// validateSecretsManagerCompatible returns an error if the manager role of the environment can't read the values of Secrets Manager secrets.
+func (o *runLocalOpts) validateSecretsManagerCompatible() error {
+	if o.isSecretsManagerCompatible {
+		return nil
+	}
+	if err := validateMinEnvVersion(o.ws, o.envChecker, o.appName, o.envName, minEnvVersionForRunLocalSecretsManager, "run local with Secrets Manager secrets"); err != nil {
+		return err
+	}
+	o.isSecretsManagerCompatible = true
+	return nil
+}
+
+func localHealthCheck(hc manifest.ContainerHealthCheck) *dockerengine.HealthCheckOptions {
+	if hc.IsEmpty() {
+		return nil
+	}
+	// All the fields are set once the defaults are applied.
+	hc.ApplyIfNotSet(manifest.NewDefaultContainerHealthCheck())
+	return &dockerengine.HealthCheckOptions{
+		Command:     hc.Command,
+		Interval:    *hc.Interval,
+		Retries:     aws.IntValue(hc.Retries),
+		Timeout:     *hc.Timeout,
+		StartPeriod: *hc.StartPeriod,
+	}
+}
+
+// run starts the containers on a shared network, and tears them down once one of them exits or the command is interrupted,
+// similar to the essential containers of an Amazon ECS task.
+func (o *runLocalOpts) run(containers []*dockerengine.RunOptions) error {
+	network := o.networkName()
+	exists, err := o.docker.NetworkExists(network)
+	if err != nil {
+		return err
+	}
+	// Reuse the network left behind by a previous run that didn't exit cleanly.
+	if !exists {
+		if err := o.docker.CreateNetwork(network); err != nil {
+			return err
+		}
+	}
+	interrupt := make(chan os.Signal, 1)
+	o.notifyInterrupt(interrupt)
+	defer signal.Stop(interrupt)
+
+	exited := make(chan error, len(containers))
+	for _, c := range containers {
+		c := c
+		go func() {
+			exited <- o.docker.Run(c)
+		}()
+	}
+	log.Infof("Running %s with the configuration of environment %s. Press Ctrl-C to stop.\n",
+		color.HighlightUserInput(o.wkldName), color.HighlightUserInput(o.envName))
+
+	running := len(containers)
+	select {
+	case <-interrupt:
+		log.Infoln("Stopping the containers.")
+	case err = <-exited:
+		running--
+	}
+	var wg sync.WaitGroup
+	for _, c := range containers {
+		name := c.ContainerName
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// The container might have already exited and been removed, so we ignore the error.
+			_ = o.docker.Stop(name)
+		}()
+	}
+	wg.Wait()
+	for ; running > 0; running-- {
+		<-exited
+	}
+	if rmErr := o.docker.RemoveNetwork(network); rmErr != nil && err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+func (o *runLocalOpts) networkName() string {
+	return fmt.Sprintf("%s-%s-%s", o.appName, o.envName, o.wkldName)
+}
+
+// buildRunLocalCmd builds the command for running a workload locally.
+func buildRunLocalCmd() *cobra.Command {
+	vars := runLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Runs a service or job and its sidecars in Docker with the configuration of an environment.",
+		Long: `Runs a service or job and its sidecars in Docker with the configuration of an environment.
+The manifest overrides and the secrets of the environment are applied to the containers.
+Press Ctrl-C to stop and remove the containers.`,
+
+		Example: `
+  Run the "api" service with the configuration of the "test" environment.
+  /code $ copilot run local -n api -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
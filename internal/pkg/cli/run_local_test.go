@@ -0,0 +1,410 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(store *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"returns error if not in a workspace": {
+			setupMocks:  func(store *mocks.Mockstore) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"returns error if the application does not exist": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool configuration: some error"),
+		},
+		"valid application": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(store)
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName: tc.inApp,
+				},
+				store: store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunLocalOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+		inEnv  string
+
+		setupMocks func(ws *mocks.MockwsWlDirReader, store *mocks.Mockstore, sel *mocks.MockwsSelector)
+
+		wantedName  string
+		wantedEnv   string
+		wantedError error
+	}{
+		"prompts for the workload and the environment": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader, store *mocks.Mockstore, sel *mocks.MockwsSelector) {
+				sel.EXPECT().Workload(runLocalWkldNamePrompt, "").Return("api", nil)
+				sel.EXPECT().Environment(gomock.Any(), runLocalEnvNameHelp, "phonetool").Return("test", nil)
+			},
+			wantedName: "api",
+			wantedEnv:  "test",
+		},
+		"validates the flags": {
+			inName: "api",
+			inEnv:  "test",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, store *mocks.Mockstore, sel *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"api", "worker"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedName: "api",
+			wantedEnv:  "test",
+		},
+		"returns error if the workload is not in the workspace": {
+			inName: "api",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, store *mocks.Mockstore, sel *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"worker"}, nil)
+			},
+			wantedError: errors.New(`workload "api" does not exist in the workspace`),
+		},
+		"returns error if fail to select the environment": {
+			inName: "api",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, store *mocks.Mockstore, sel *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				sel.EXPECT().Environment(gomock.Any(), runLocalEnvNameHelp, "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockwsSelector(ctrl)
+			tc.setupMocks(ws, store, sel)
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "phonetool",
+					wkldName: tc.inName,
+					envName:  tc.inEnv,
+				},
+				ws:    ws,
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.wkldName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+// offlineWorkloadMft is a workload manifest that doesn't load dynamic content from AWS.
+type offlineWorkloadMft struct {
+	manifest.DynamicWorkload
+}
+
+func (m *offlineWorkloadMft) ApplyEnv(envName string) (manifest.DynamicWorkload, error) {
+	mft, err := m.DynamicWorkload.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return &offlineWorkloadMft{mft}, nil
+}
+
+func (m *offlineWorkloadMft) Load(_ *session.Session) error {
+	return nil
+}
+
+type runLocalMocks struct {
+	ws             *mocks.MockwsWlDirReader
+	docker         *mocks.MocklocalContainerRunner
+	ssm            *mocks.MocksecretValueGetter
+	secretsManager *mocks.MocksecretValueGetter
+	endpointGetter *mocks.MockserviceDiscoveryEndpointGetter
+	envChecker     *mocks.MockversionCompatibilityChecker
+}
+
+func TestRunLocalOpts_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  port: 8080
+  healthcheck:
+    command: ["CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"]
+command: npm start
+variables:
+  LOG_LEVEL: info
+secrets:
+  DB_PASSWORD: /copilot/phonetool/test/secrets/db_password
+  API_KEY:
+    secretsmanager: 'phonetool/test/api:key::'
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx
+    port: 80
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+	mainContainer := &dockerengine.RunOptions{
+		ImageURI:       "phonetool/api/api:local",
+		ContainerName:  "phonetool-test-api-api",
+		NetworkName:    "phonetool-test-api",
+		NetworkAliases: []string{"api"},
+		PortMappings:   map[string]string{"8080": "8080"},
+		EnvVars: map[string]string{
+			"COPILOT_APPLICATION_NAME":           "phonetool",
+			"COPILOT_ENVIRONMENT_NAME":           "test",
+			"COPILOT_SERVICE_NAME":               "api",
+			"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.phonetool.local",
+			"LOG_LEVEL":                          "debug",
+		},
+		Secrets: map[string]string{
+			"DB_PASSWORD": "hunter2",
+			"API_KEY":     "abc123",
+		},
+		Command: []string{"npm", "start"},
+		HealthCheck: &dockerengine.HealthCheckOptions{
+			Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+			Interval: 10 * time.Second,
+			Retries:  2,
+			Timeout:  5 * time.Second,
+		},
+	}
+	sidecar := &dockerengine.RunOptions{
+		ImageURI:       "public.ecr.aws/nginx/nginx",
+		ContainerName:  "phonetool-test-api-nginx",
+		NetworkName:    "phonetool-test-api",
+		NetworkAliases: []string{"nginx"},
+		PortMappings:   map[string]string{"80": "80"},
+		EnvVars: map[string]string{
+			"COPILOT_APPLICATION_NAME":           "phonetool",
+			"COPILOT_ENVIRONMENT_NAME":           "test",
+			"COPILOT_SERVICE_NAME":               "api",
+			"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.phonetool.local",
+		},
+		Secrets: map[string]string{},
+	}
+	setupBuild := func(m *runLocalMocks) {
+		m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+		m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+		m.ws.EXPECT().Path().Return("/ws")
+		m.endpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+		m.docker.EXPECT().Build(&dockerengine.BuildArguments{
+			URI:        "phonetool/api/api:local",
+			Dockerfile: "/ws/Dockerfile",
+			Context:    "/ws",
+		}).Return(nil)
+		m.ssm.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db_password").Return("hunter2", nil)
+		m.envChecker.EXPECT().Version().Return("v1.21.0", nil)
+		m.secretsManager.EXPECT().GetSecretValue("phonetool/test/api").Return(`{"key":"abc123"}`, nil)
+	}
+	testCases := map[string]struct {
+		setupMocks  func(m *runLocalMocks)
+		interrupted bool
+
+		wantedError error
+	}{
+		"returns error if docker engine is not running": {
+			setupMocks: func(m *runLocalMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(dockerengine.ErrDockerCommandNotFound)
+			},
+			wantedError: errors.New("check if docker engine is running: docker: command not found"),
+		},
+		"returns error if fail to build the image": {
+			setupMocks: func(m *runLocalMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+				m.ws.EXPECT().Path().Return("/ws")
+				m.endpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("build image of container api: some error"),
+		},
+		"returns error if fail to get the value of a secret": {
+			setupMocks: func(m *runLocalMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+				m.ws.EXPECT().Path().Return("/ws")
+				m.endpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.ssm.EXPECT().GetSecretValue(gomock.Any()).Return("", errors.New("some error")).AnyTimes()
+				m.envChecker.EXPECT().Version().Return("v1.21.0", nil).AnyTimes()
+				m.secretsManager.EXPECT().GetSecretValue(gomock.Any()).Return("", errors.New("some error")).AnyTimes()
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns error if the environment can't read Secrets Manager secrets": {
+			setupMocks: func(m *runLocalMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+				m.ws.EXPECT().Path().Return("/ws")
+				m.endpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.ssm.EXPECT().GetSecretValue(gomock.Any()).Return("hunter2", nil).AnyTimes()
+				m.envChecker.EXPECT().Version().Return("v1.20.0", nil)
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil).AnyTimes()
+			},
+			wantedError: errors.New(`environment "test" is on version "v1.20.0" which does not support the "run local with Secrets Manager secrets" feature`),
+		},
+		"reuses the network left behind by a previous run": {
+			setupMocks: func(m *runLocalMocks) {
+				setupBuild(m)
+				m.docker.EXPECT().NetworkExists("phonetool-test-api").Return(true, nil)
+				m.docker.EXPECT().Run(gomock.Any()).Return(nil).Times(2)
+				m.docker.EXPECT().Stop(gomock.Any()).Return(nil).Times(2)
+				m.docker.EXPECT().RemoveNetwork("phonetool-test-api").Return(nil)
+			},
+		},
+		"tears down all the containers once one of them exits": {
+			setupMocks: func(m *runLocalMocks) {
+				setupBuild(m)
+				stopped := make(chan struct{})
+				m.docker.EXPECT().NetworkExists("phonetool-test-api").Return(false, nil)
+				m.docker.EXPECT().CreateNetwork("phonetool-test-api").Return(nil)
+				m.docker.EXPECT().Run(mainContainer).Return(errors.New("some error"))
+				m.docker.EXPECT().Run(sidecar).DoAndReturn(func(_ *dockerengine.RunOptions) error {
+					<-stopped
+					return nil
+				})
+				m.docker.EXPECT().Stop("phonetool-test-api-api").Return(errors.New("no such container"))
+				m.docker.EXPECT().Stop("phonetool-test-api-nginx").DoAndReturn(func(_ string) error {
+					close(stopped)
+					return nil
+				})
+				m.docker.EXPECT().RemoveNetwork("phonetool-test-api").Return(nil)
+			},
+			wantedError: errors.New("some error"),
+		},
+		"tears down all the containers when interrupted": {
+			interrupted: true,
+			setupMocks: func(m *runLocalMocks) {
+				setupBuild(m)
+				stopped := map[string]chan struct{}{
+					"phonetool-test-api-api":   make(chan struct{}),
+					"phonetool-test-api-nginx": make(chan struct{}),
+				}
+				m.docker.EXPECT().NetworkExists("phonetool-test-api").Return(false, nil)
+				m.docker.EXPECT().CreateNetwork("phonetool-test-api").Return(nil)
+				m.docker.EXPECT().Run(gomock.Any()).DoAndReturn(func(in *dockerengine.RunOptions) error {
+					<-stopped[in.ContainerName]
+					return errors.New("exit status 130")
+				}).Times(2)
+				m.docker.EXPECT().Stop(gomock.Any()).DoAndReturn(func(name string) error {
+					close(stopped[name])
+					return nil
+				}).Times(2)
+				m.docker.EXPECT().RemoveNetwork("phonetool-test-api").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalMocks{
+				ws:             mocks.NewMockwsWlDirReader(ctrl),
+				docker:         mocks.NewMocklocalContainerRunner(ctrl),
+				ssm:            mocks.NewMocksecretValueGetter(ctrl),
+				secretsManager: mocks.NewMocksecretValueGetter(ctrl),
+				endpointGetter: mocks.NewMockserviceDiscoveryEndpointGetter(ctrl),
+				envChecker:     mocks.NewMockversionCompatibilityChecker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  "phonetool",
+					envName:  "test",
+					wkldName: "api",
+				},
+				ws:     m.ws,
+				docker: m.docker,
+				unmarshal: func(b []byte) (manifest.DynamicWorkload, error) {
+					mft, err := manifest.UnmarshalWorkload(b)
+					if err != nil {
+						return nil, err
+					}
+					return &offlineWorkloadMft{mft}, nil
+				},
+				newInterpolator: func(app, env string) interpolator {
+					return manifest.NewInterpolator(app, env)
+				},
+				notifyInterrupt: func(c chan<- os.Signal) {
+					if tc.interrupted {
+						c <- os.Interrupt
+					}
+				},
+				initEnvClients: func() error { return nil },
+				ssm:            m.ssm,
+				secretsManager: m.secretsManager,
+				endpointGetter: m.endpointGetter,
+				envChecker:     m.envChecker,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.ErrorContains(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
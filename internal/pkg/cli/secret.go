@@ -18,6 +18,10 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretLsCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretDiffCmd())
+	cmd.AddCommand(buildSecretRmCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
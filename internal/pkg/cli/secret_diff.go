@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretDiffAppNamePrompt     = "Which application's secrets would you like to compare?"
+	secretDiffAppNameHelpPrompt = "The secrets of the environments in the application will be compared."
+)
+
+type secretDiffVars struct {
+	appName          string
+	envNames         []string
+	shouldOutputJSON bool
+}
+
+type secretDiffOpts struct {
+	secretDiffVars
+
+	w                          io.Writer
+	store                      store
+	sel                        appSelector
+	secretsDiffer              secretsDiffer
+	initSecretsDiffer          func(*secretDiffOpts) error // Overridden in tests.
+	newEnvCompatibilityChecker func(app, env string) (versionCompatibilityChecker, error)
+
+	// Cached variables.
+	envs []*config.Environment
+}
+
+func newSecretDiffOpts(vars secretDiffVars) (*secretDiffOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret diff"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &secretDiffOpts{
+		secretDiffVars: vars,
+		w:              log.OutputWriter,
+		store:          configStore,
+		sel:            selector.NewAppEnvSelector(prompt.New(), configStore),
+		initSecretsDiffer: func(o *secretDiffOpts) error {
+			d, err := newAppSecretsDescriber(sessProvider, o.appName, o.envs)
+			if err != nil {
+				return err
+			}
+			o.secretsDiffer = d
+			return nil
+		},
+		newEnvCompatibilityChecker: newSecretEnvCompatibilityChecker(configStore),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *secretDiffOpts) Validate() error {
+	if len(o.envNames) == 1 {
+		return fmt.Errorf("--%s must list at least two environments to compare", envsFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *secretDiffOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateEnvs()
+}
+
+// Execute shows the secrets that are missing from some of the environments.
+func (o *secretDiffOpts) Execute() error {
+	if err := validateSecretEnvsCompatible(o.newEnvCompatibilityChecker, o.appName, o.envs, "secret diff"); err != nil {
+		return err
+	}
+	if err := o.initSecretsDiffer(o); err != nil {
+		return err
+	}
+	diff, err := o.secretsDiffer.Diff()
+	if err != nil {
+		return fmt.Errorf("compare secrets of application %s: %w", o.appName, err)
+	}
+	return writeHumanOrJSON(o.w, diff, o.shouldOutputJSON)
+}
+
+func (o *secretDiffOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretDiffAppNamePrompt, secretDiffAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *secretDiffOpts) validateEnvs() error {
+	if len(o.envNames) == 0 {
+		envs, err := o.store.ListEnvironments(o.appName)
+		if err != nil {
+			return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+		}
+		o.envs = envs
+		return nil
+	}
+	for _, name := range o.envNames {
+		env, err := o.store.GetEnvironment(o.appName, name)
+		if err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", name, o.appName, err)
+		}
+		o.envs = append(o.envs, env)
+	}
+	return nil
+}
+
+// buildSecretDiffCmd builds the command for comparing the secrets of the environments of an application.
+func buildSecretDiffCmd() *cobra.Command {
+	vars := secretDiffVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Shows the secrets that are missing from some environments.",
+		Long: `Compares the secrets of the environments of an application.
+Secrets that are present in some of the environments but missing from others are shown.`,
+		Example: `
+  Finds the secrets missing from any environment of the application "my-app".
+  /code $ copilot secret diff -a my-app
+  Compares the secrets of the "test" and "prod" environments.
+  /code $ copilot secret diff -a my-app -e test,prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretDiffOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringSliceVarP(&vars.envNames, envsFlag, envsFlagShort, nil, secretDiffEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
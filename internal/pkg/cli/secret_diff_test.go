@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretDiff_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputEnvs []string
+
+		wantedError error
+	}{
+		"errors if only one environment is passed": {
+			inputEnvs:   []string{"test"},
+			wantedError: errors.New("--environments must list at least two environments to compare"),
+		},
+		"success without environments": {},
+		"success with two environments": {
+			inputEnvs: []string{"test", "prod"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &secretDiffOpts{
+				secretDiffVars: secretDiffVars{
+					envNames: tc.inputEnvs,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretDiff_Ask(t *testing.T) {
+	testEnv := &config.Environment{Name: "test"}
+	prodEnv := &config.Environment{Name: "prod"}
+	testCases := map[string]struct {
+		inputEnvs  []string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedEnvs  []*config.Environment
+		wantedError error
+	}{
+		"errors if an environment does not exist": {
+			inputEnvs: []string{"test", "prod"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"compares every environment by default": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+			},
+			wantedEnvs: []*config.Environment{testEnv, prodEnv},
+		},
+		"compares the selected environments": {
+			inputEnvs: []string{"prod", "test"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+			},
+			wantedEnvs: []*config.Environment{prodEnv, testEnv},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstore(ctrl)
+			tc.setupMocks(m)
+			opts := &secretDiffOpts{
+				secretDiffVars: secretDiffVars{
+					appName:  "phonetool",
+					envNames: tc.inputEnvs,
+				},
+				store: m,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnvs, opts.envs)
+		})
+	}
+}
+
+func TestSecretDiff_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		envVersion string
+		setupMocks func(m *mocks.MocksecretsDiffer)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if an environment can't manage Secrets Manager secrets": {
+			envVersion:  "v1.19.0",
+			wantedError: errors.New(`environment "test" is on version "v1.19.0" which does not support the "secret diff" feature`),
+		},
+		"errors if failed to compare the secrets": {
+			setupMocks: func(m *mocks.MocksecretsDiffer) {
+				m.EXPECT().Diff().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("compare secrets of application phonetool: some error"),
+		},
+		"writes the missing secrets": {
+			setupMocks: func(m *mocks.MocksecretsDiffer) {
+				m.EXPECT().Diff().Return(&mockDescribeData{data: "data"}, nil)
+			},
+			wantedContent: "data",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			m := mocks.NewMocksecretsDiffer(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			checker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envVersion := "v1.20.0"
+			if tc.envVersion != "" {
+				envVersion = tc.envVersion
+			}
+			checker.EXPECT().Version().Return(envVersion, nil)
+			opts := &secretDiffOpts{
+				secretDiffVars: secretDiffVars{
+					appName: "phonetool",
+				},
+				w:    b,
+				envs: []*config.Environment{{Name: "test"}},
+				initSecretsDiffer: func(o *secretDiffOpts) error {
+					o.secretsDiffer = m
+					return nil
+				},
+				newEnvCompatibilityChecker: func(app, env string) (versionCompatibilityChecker, error) {
+					return checker, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
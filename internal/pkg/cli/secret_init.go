@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -28,6 +29,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -41,8 +43,19 @@ import (
 const (
 	fmtSecretParameterName           = "/copilot/%s/%s/secrets/%s"
 	fmtSecretParameterNameMftExample = "/copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
+
+	fmtSecretsManagerSecretName           = "copilot/%s/%s/secrets/%s"
+	fmtSecretsManagerSecretNameMftExample = "copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/%s"
 )
 
+// Services where the secrets can be stored.
+const (
+	secretStoreSSM            = "ssm"
+	secretStoreSecretsManager = "secretsmanager"
+)
+
+var secretStores = []string{secretStoreSSM, secretStoreSecretsManager}
+
 const (
 	secretInitAppPrompt     = "Which application do you want to add the secret to?"
 	secretInitAppPromptHelp = "The secret can then be versioned by your existing environments inside the application."
@@ -61,6 +74,7 @@ type secretInitVars struct {
 	values        map[string]string
 	inputFilePath string
 	overwrite     bool
+	secretStore   string
 }
 
 type secretInitOpts struct {
@@ -75,6 +89,7 @@ type secretInitOpts struct {
 	ws                      wsEnvironmentsLister
 	envCompatibilityChecker map[string]versionCompatibilityChecker
 	secretPutters           map[string]secretPutter
+	secretsManagerPutters   map[string]secretsManagerSecretPutter
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
@@ -102,6 +117,7 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 
 		envCompatibilityChecker: make(map[string]versionCompatibilityChecker),
 		secretPutters:           make(map[string]secretPutter),
+		secretsManagerPutters:   make(map[string]secretsManagerSecretPutter),
 
 		prompter: prompter,
 		selector: selector.NewAppEnvSelector(prompter, store),
@@ -127,6 +143,7 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
 		opts.secretPutters[envName] = ssm.New(sess)
+		opts.secretsManagerPutters[envName] = secretsmanager.New(sess)
 
 		return nil
 	}
@@ -157,6 +174,12 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	switch o.secretStore {
+	case "", secretStoreSSM, secretStoreSecretsManager:
+	default:
+		return fmt.Errorf("invalid --%s %q: must be one of %s", secretStoreFlag, o.secretStore, english.OxfordWordSeries(secretStores, "or"))
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -254,12 +277,19 @@ func (o *secretInitOpts) configureClientsAndUpgradeForEnvironments(secrets map[s
 		}
 	}
 
-	const minEnvVersionForSecretInit = "v1.4.0"
+	const (
+		minEnvVersionForSecretInit               = "v1.4.0"
+		minEnvVersionForSecretsManagerSecretInit = "v1.17.0"
+	)
+	minEnvVersion, feature := minEnvVersionForSecretInit, "secret init"
+	if o.secretStore == secretStoreSecretsManager {
+		minEnvVersion, feature = minEnvVersionForSecretsManagerSecretInit, "secret init --store secretsmanager"
+	}
 	for envName := range envNames {
 		if err := o.configureClientsForEnv(envName); err != nil {
 			return err
 		}
-		if err := validateMinEnvVersion(o.ws, o.envCompatibilityChecker[envName], o.appName, envName, minEnvVersionForSecretInit, "secret init"); err != nil {
+		if err := validateMinEnvVersion(o.ws, o.envCompatibilityChecker[envName], o.appName, envName, minEnvVersion, feature); err != nil {
 			return err
 		}
 	}
@@ -302,6 +332,9 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 }
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
+	if o.secretStore == secretStoreSecretsManager {
+		return o.putSecretsManagerSecretInEnv(secretName, envName, value)
+	}
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
 	in := ssm.PutSecretInput{
 		Name:      name,
@@ -334,6 +367,36 @@ func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error
 	return nil
 }
 
+func (o *secretInitOpts) putSecretsManagerSecretInEnv(secretName, envName, value string) error {
+	name := fmt.Sprintf(fmtSecretsManagerSecretName, o.appName, envName, secretName)
+	out, err := o.secretsManagerPutters[envName].PutSecret(secretsmanager.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: envName,
+		},
+	})
+	if err != nil {
+		var targetErr *secretsmanager.ErrSecretAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite. \n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+
+	if out.Overwritten {
+		log.Successln(fmt.Sprintf("Secret %s already exists in environment %s. Overwritten.", name, color.HighlightUserInput(envName)))
+		return nil
+	}
+
+	log.Successln(fmt.Sprintf("Successfully put secret %s in environment %s as %s.", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name)))
+	return nil
+}
+
 func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string, error) {
 	raw, err := o.readFile()
 	if err != nil {
@@ -341,13 +404,46 @@ func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string,
 	}
 
 	type inputFile struct {
-		Secrets map[string]map[string]string `yaml:",inline"`
+		Secrets map[string]map[string]secretInputValue `yaml:",inline"`
 	}
 	var f inputFile
 	if err := yaml.Unmarshal(raw, &f); err != nil {
 		return nil, fmt.Errorf("unmarshal input file: %w", err)
 	}
-	return f.Secrets, nil
+	secrets := make(map[string]map[string]string, len(f.Secrets))
+	for name, values := range f.Secrets {
+		secrets[name] = make(map[string]string, len(values))
+		for env, value := range values {
+			secrets[name][env] = string(value)
+		}
+	}
+	return secrets, nil
+}
+
+// secretInputValue is the value of a secret in the input file.
+// Mappings and sequences are stored as JSON strings, so that structured secrets such as credentials can be specified.
+type secretInputValue string
+
+// UnmarshalYAML implements the yaml.Unmarshaler (v3) interface.
+func (v *secretInputValue) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+		*v = secretInputValue(s)
+		return nil
+	}
+	var structured interface{}
+	if err := value.Decode(&structured); err != nil {
+		return err
+	}
+	b, err := json.Marshal(structured)
+	if err != nil {
+		return fmt.Errorf("convert secret value on line %d to JSON: %w", value.Line, err)
+	}
+	*v = secretInputValue(b)
+	return nil
 }
 
 func (o *secretInitOpts) askForAppName() error {
@@ -421,11 +517,17 @@ func (o *secretInitOpts) RecommendActions() error {
 	secretsManifestExample := "secrets:"
 	for secretName := range o.secretValues {
 		currSecret := fmt.Sprintf("%s: %s", secretName, fmt.Sprintf(fmtSecretParameterNameMftExample, secretName))
+		if o.secretStore == secretStoreSecretsManager {
+			currSecret = fmt.Sprintf("%s:\n      secretsmanager: %s", secretName, fmt.Sprintf(fmtSecretsManagerSecretNameMftExample, secretName))
+		}
 		secretsManifestExample = fmt.Sprintf("%s\n%s", secretsManifestExample, fmt.Sprintf("    %s", currSecret))
 	}
 
 	log.Infoln("You can refer to these secrets from your manifest file by editing the `secrets` section.")
 	log.Infoln(color.HighlightCodeBlock(secretsManifestExample))
+	if o.secretStore == secretStoreSecretsManager {
+		log.Infof("To inject a single key of a JSON secret, append %s to its name.\n", color.HighlightCode(":<key>::"))
+	}
 	return nil
 }
 
@@ -483,14 +585,16 @@ func buildSecretInitCmd() *cobra.Command {
 	vars := secretInitVars{}
 	cmd := &cobra.Command{
 		Use:   "init",
-		Short: "Create or update secrets in SSM Parameter Store.",
+		Short: "Create or update secrets in SSM Parameter Store or AWS Secrets Manager.",
 		Example: `
 Create a secret with prompts. 
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
-/code $ copilot secret init --cli-input-yaml input.yml`,
+/code $ copilot secret init --cli-input-yaml input.yml
+Create a secret in AWS Secrets Manager instead of SSM Parameter Store.
+/code $ copilot secret init --name db-password --store secretsmanager`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSecretInitOpts(vars)
 			if err != nil {
@@ -523,5 +627,6 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.secretStore, secretStoreFlag, secretStoreSSM, secretStoreFlagDescription)
 	return cmd
 }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -35,6 +36,7 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inValues        map[string]string
 		inOverwrite     bool
 		inInputFilePath string
+		inSecretStore   string
 
 		setupMocks func(m secretInitMocks)
 
@@ -67,6 +69,12 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "bad_village").Return(&config.Environment{}, nil)
 			},
 		},
+		"error if the secret store is invalid": {
+			inSecretStore: "vault",
+			setupMocks:    func(m secretInitMocks) {},
+
+			wantedError: errors.New(`invalid --store "vault": must be one of ssm or secretsmanager`),
+		},
 		"error getting app": {
 			inApp: "dragon_befriending",
 			setupMocks: func(m secretInitMocks) {
@@ -126,6 +134,7 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 					values:        tc.inValues,
 					inputFilePath: tc.inInputFilePath,
 					overwrite:     tc.inOverwrite,
+					secretStore:   tc.inSecretStore,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
@@ -344,6 +353,7 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 type secretInitExecuteMocks struct {
 	mockStore                   *mocks.Mockstore
 	mockSecretPutter            *mocks.MocksecretPutter
+	mockSecretsManagerPutter    *mocks.MocksecretsManagerSecretPutter
 	mockEnvCompatibilityChecker *mocks.MockversionCompatibilityChecker
 }
 
@@ -364,7 +374,8 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 
 		inInputFilePath string
 
-		inOverwrite bool
+		inOverwrite   bool
+		inSecretStore string
 
 		mockInputFileContent []byte
 		setupMocks           func(m secretInitExecuteMocks)
@@ -499,6 +510,44 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				},
 			},
 		},
+		"successfully create secrets in secrets manager": {
+			inAppName:     testApp,
+			inName:        testName,
+			inValues:      testValues,
+			inSecretStore: secretStoreSecretsManager,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/test/secrets/db-password",
+					Value: "test-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&secretsmanager.PutSecretOutput{}, nil)
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/prod/secrets/db-password",
+					Value: "prod-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+				}).Return(nil, &secretsmanager.ErrSecretAlreadyExists{})
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.17.0", nil).Times(2)
+			},
+		},
+		"error if an environment is too old to store secrets in secrets manager": {
+			inAppName:     testApp,
+			inName:        testName,
+			inValues:      map[string]string{"test": "test-password"},
+			inSecretStore: secretStoreSecretsManager,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockEnvCompatibilityChecker.EXPECT().Version().Return("v1.16.0", nil)
+			},
+
+			wantedError: errors.New(`environment "test" is on version "v1.16.0" which does not support the "secret init --store secretsmanager" feature`),
+		},
 		"some secrets fail to create during a batch operation": {
 			inAppName:       testApp,
 			inInputFilePath: "some/file",
@@ -568,6 +617,7 @@ db-host:
 			m := secretInitExecuteMocks{
 				mockStore:                   mocks.NewMockstore(ctrl),
 				mockSecretPutter:            mocks.NewMocksecretPutter(ctrl),
+				mockSecretsManagerPutter:    mocks.NewMocksecretsManagerSecretPutter(ctrl),
 				mockEnvCompatibilityChecker: mocks.NewMockversionCompatibilityChecker(ctrl),
 			}
 			tc.setupMocks(m)
@@ -579,10 +629,12 @@ db-host:
 					values:        tc.inValues,
 					overwrite:     tc.inOverwrite,
 					inputFilePath: tc.inInputFilePath,
+					secretStore:   tc.inSecretStore,
 				},
 				store: m.mockStore,
 
 				secretPutters:           make(map[string]secretPutter),
+				secretsManagerPutters:   make(map[string]secretsManagerSecretPutter),
 				envCompatibilityChecker: make(map[string]versionCompatibilityChecker),
 				readFile: func() ([]byte, error) {
 					return tc.mockInputFileContent, nil
@@ -591,6 +643,7 @@ db-host:
 
 			opts.configureClientsForEnv = func(envName string) error {
 				opts.secretPutters[envName] = m.mockSecretPutter
+				opts.secretsManagerPutters[envName] = m.mockSecretsManagerPutter
 				opts.envCompatibilityChecker[envName] = m.mockEnvCompatibilityChecker
 				return nil
 			}
@@ -635,6 +688,34 @@ test-only-secret:
 			},
 		}
 
+		secrets, err := opts.parseSecretsInputFile()
+		require.NoError(t, err)
+		require.Equal(t, expected, secrets)
+	})
+	t.Run("success with JSON-valued secrets", func(t *testing.T) {
+		opts := secretInitOpts{
+			readFile: func() ([]byte, error) {
+				raw := `db-credentials:
+    test:
+        username: admin
+        port: 5432
+    prod: '{"username":"root"}'
+allowed-hosts:
+    test: [localhost, example.com]`
+				return []byte(raw), nil
+			},
+		}
+
+		expected := map[string]map[string]string{
+			"db-credentials": {
+				"test": `{"port":5432,"username":"admin"}`,
+				"prod": `{"username":"root"}`,
+			},
+			"allowed-hosts": {
+				"test": `["localhost","example.com"]`,
+			},
+		}
+
 		secrets, err := opts.parseSecretsInputFile()
 		require.NoError(t, err)
 		require.Equal(t, expected, secrets)
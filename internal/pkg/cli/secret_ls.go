@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretLsAppNamePrompt     = "Which application's secrets would you like to list?"
+	secretLsAppNameHelpPrompt = "The secrets of every environment in the application will be listed."
+
+	// minEnvVersionForSecretManagement is the first environment version whose manager role can read and delete Secrets Manager secrets.
+	minEnvVersionForSecretManagement = "v1.20.0"
+)
+
+type secretLsVars struct {
+	appName          string
+	shouldOutputJSON bool
+}
+
+type secretLsOpts struct {
+	secretLsVars
+
+	w                          io.Writer
+	store                      store
+	sel                        appSelector
+	secretsDescriber           describer
+	initSecretsDescriber       func(*secretLsOpts, []*config.Environment) error // Overridden in tests.
+	newEnvCompatibilityChecker func(app, env string) (versionCompatibilityChecker, error)
+}
+
+func newSecretLsOpts(vars secretLsVars) (*secretLsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &secretLsOpts{
+		secretLsVars: vars,
+		w:            log.OutputWriter,
+		store:        configStore,
+		sel:          selector.NewAppEnvSelector(prompt.New(), configStore),
+		initSecretsDescriber: func(o *secretLsOpts, envs []*config.Environment) error {
+			d, err := newAppSecretsDescriber(sessProvider, o.appName, envs)
+			if err != nil {
+				return err
+			}
+			o.secretsDescriber = d
+			return nil
+		},
+		newEnvCompatibilityChecker: newSecretEnvCompatibilityChecker(configStore),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *secretLsOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *secretLsOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretLsAppNamePrompt, secretLsAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets of the application across its environments.
+func (o *secretLsOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	if err := validateSecretEnvsCompatible(o.newEnvCompatibilityChecker, o.appName, envs, "secret ls"); err != nil {
+		return err
+	}
+	if err := o.initSecretsDescriber(o, envs); err != nil {
+		return err
+	}
+	secrets, err := o.secretsDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe secrets of application %s: %w", o.appName, err)
+	}
+	return writeHumanOrJSON(o.w, secrets, o.shouldOutputJSON)
+}
+
+// newAppSecretsDescriber returns a describer that reads the secrets of each environment with its manager role.
+func newAppSecretsDescriber(sessProvider *sessions.Provider, app string, envs []*config.Environment) (*describe.AppSecretsDescriber, error) {
+	names := make([]string, len(envs))
+	readers := make(map[string]describe.SecretReader)
+	smReaders := make(map[string]describe.SecretsManagerReader)
+	for i, env := range envs {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		names[i] = env.Name
+		readers[env.Name] = ssm.New(sess)
+		smReaders[env.Name] = secretsmanager.New(sess)
+	}
+	return describe.NewAppSecretsDescriber(&describe.NewAppSecretsDescriberConfig{
+		App:                   app,
+		Envs:                  names,
+		Readers:               readers,
+		SecretsManagerReaders: smReaders,
+	}), nil
+}
+
+// newSecretEnvCompatibilityChecker returns a function that creates the version checker of an environment.
+func newSecretEnvCompatibilityChecker(configStore describe.ConfigStoreSvc) func(app, env string) (versionCompatibilityChecker, error) {
+	return func(app, env string) (versionCompatibilityChecker, error) {
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         app,
+			Env:         env,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		return envDescriber, nil
+	}
+}
+
+// validateSecretEnvsCompatible returns an error if the manager role of one of the environments can't manage Secrets Manager secrets.
+func validateSecretEnvsCompatible(newChecker func(app, env string) (versionCompatibilityChecker, error), app string, envs []*config.Environment, feature string) error {
+	for _, env := range envs {
+		checker, err := newChecker(app, env.Name)
+		if err != nil {
+			return err
+		}
+		if err := validateMinEnvVersion(nil, checker, app, env.Name, minEnvVersionForSecretManagement, feature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHumanOrJSON(w io.Writer, out describe.HumanJSONStringer, shouldOutputJSON bool) error {
+	if !shouldOutputJSON {
+		fmt.Fprint(w, out.HumanString())
+		return nil
+	}
+	data, err := out.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(w, data)
+	return nil
+}
+
+// buildSecretLsCmd builds the command for listing the secrets of an application.
+func buildSecretLsCmd() *cobra.Command {
+	vars := secretLsVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application across its environments.",
+		Long: `Lists the secrets of an application across its environments.
+Every SSM parameter tagged with the application and an environment is listed,
+along with the Secrets Manager secrets created with "copilot secret init --store secretsmanager".`,
+		Example: `
+  Lists the secrets of the application "my-app".
+  /code $ copilot secret ls -a my-app
+  Lists the secrets in JSON format.
+  /code $ copilot secret ls -a my-app --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretLsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretLs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappSelector)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"errors if the application does not exist": {
+			inputApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"prompts for the application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(secretLsAppNamePrompt, secretLsAppNameHelpPrompt).Return("phonetool", nil)
+			},
+			wantedApp: "phonetool",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &secretLsOpts{
+				secretLsVars: secretLsVars{
+					appName: tc.inputApp,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+		})
+	}
+}
+
+func TestSecretLs_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		envVersion       string
+		setupMocks       func(m *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if an environment can't manage Secrets Manager secrets": {
+			envVersion:  "v1.19.0",
+			wantedError: errors.New(`environment "test" is on version "v1.19.0" which does not support the "secret ls" feature`),
+		},
+		"errors if failed to describe the secrets": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe secrets of application phonetool: some error"),
+		},
+		"writes the secrets in human format": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "data"}, nil)
+			},
+			wantedContent: "data",
+		},
+		"writes the secrets in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "{}"}, nil)
+			},
+			wantedContent: "{}",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			m := mocks.NewMockdescriber(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			store := mocks.NewMockstore(ctrl)
+			store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+			checker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envVersion := "v1.20.0"
+			if tc.envVersion != "" {
+				envVersion = tc.envVersion
+			}
+			checker.EXPECT().Version().Return(envVersion, nil)
+			opts := &secretLsOpts{
+				secretLsVars: secretLsVars{
+					appName:          "phonetool",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:     b,
+				store: store,
+				initSecretsDescriber: func(o *secretLsOpts, envs []*config.Environment) error {
+					o.secretsDescriber = m
+					return nil
+				},
+				newEnvCompatibilityChecker: func(app, env string) (versionCompatibilityChecker, error) {
+					return checker, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
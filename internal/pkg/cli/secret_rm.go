@@ -0,0 +1,291 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretRmAppNamePrompt     = "Which application's secret would you like to delete?"
+	secretRmAppNameHelpPrompt = "The secret will be deleted from the environments of the selected application."
+
+	secretRmNamePrompt     = "What is the name of the secret you want to delete?"
+	secretRmNameHelpPrompt = `The name of the secret as listed by "copilot secret ls", such as 'db_password'.`
+
+	secretRmEnvNamePrompt     = "Which environment would you like to delete the secret from?"
+	secretRmEnvNameHelpPrompt = "The secret will no longer be available to the workloads deployed in the selected environment."
+	secretRmAllEnvsOption     = "All environments"
+
+	fmtSecretRmConfirmPrompt = "Are you sure you want to delete secret %s from %s?"
+)
+
+type secretRmVars struct {
+	appName          string
+	name             string
+	envName          string
+	allEnvs          bool
+	skipConfirmation bool
+}
+
+type secretRmOpts struct {
+	secretRmVars
+
+	store                      store
+	prompt                     prompter
+	sel                        appEnvSelector
+	newSecretDeleter           func(env *config.Environment) (secretParameterDeleter, error)
+	newSecretsManagerDeleter   func(env *config.Environment) (secretParameterDeleter, error)
+	newEnvCompatibilityChecker func(app, env string) (versionCompatibilityChecker, error)
+}
+
+func newSecretRmOpts(vars secretRmVars) (*secretRmOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret rm"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &secretRmOpts{
+		secretRmVars: vars,
+		store:        configStore,
+		prompt:       prompter,
+		sel:          selector.NewAppEnvSelector(prompter, configStore),
+		newSecretDeleter: func(env *config.Environment) (secretParameterDeleter, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ssm.New(sess), nil
+		},
+		newSecretsManagerDeleter: func(env *config.Environment) (secretParameterDeleter, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return secretsmanager.New(sess), nil
+		},
+		newEnvCompatibilityChecker: newSecretEnvCompatibilityChecker(configStore),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *secretRmOpts) Validate() error {
+	if o.envName != "" && o.allEnvs {
+		return fmt.Errorf("cannot specify both --%s and --%s", envFlag, allFlag)
+	}
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *secretRmOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.askName(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskEnv(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	target := fmt.Sprintf("environment %s", color.HighlightUserInput(o.envName))
+	if o.allEnvs {
+		target = fmt.Sprintf("every environment of application %s", color.HighlightUserInput(o.appName))
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSecretRmConfirmPrompt, color.HighlightUserInput(o.name), target), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("secret rm confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("secret rm cancelled - no changes made")
+	}
+	return nil
+}
+
+// Execute deletes the secret from the selected environments.
+func (o *secretRmOpts) Execute() error {
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	if err := validateSecretEnvsCompatible(o.newEnvCompatibilityChecker, o.appName, envs, "secret rm"); err != nil {
+		return err
+	}
+	var deletedFrom []string
+	for _, env := range envs {
+		deleted, err := o.deleteFromEnv(env)
+		if err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, env.Name, err)
+		}
+		if !deleted {
+			if o.allEnvs {
+				continue
+			}
+			return fmt.Errorf("secret %s not found in environment %s", o.name, env.Name)
+		}
+		deletedFrom = append(deletedFrom, env.Name)
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+	}
+	if len(deletedFrom) == 0 {
+		return fmt.Errorf("secret %s not found in any environment of application %s", o.name, o.appName)
+	}
+	return nil
+}
+
+// deleteFromEnv deletes the secret from both SSM and Secrets Manager, and returns false if neither store had it.
+func (o *secretRmOpts) deleteFromEnv(env *config.Environment) (bool, error) {
+	ssmDeleter, err := o.newSecretDeleter(env)
+	if err != nil {
+		return false, err
+	}
+	smDeleter, err := o.newSecretsManagerDeleter(env)
+	if err != nil {
+		return false, err
+	}
+	var deleted bool
+	err = ssmDeleter.DeleteSecret(fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name))
+	var errParamNotFound *ssm.ErrParameterNotFound
+	switch {
+	case errors.As(err, &errParamNotFound):
+	case err != nil:
+		return false, err
+	default:
+		deleted = true
+	}
+	err = smDeleter.DeleteSecret(fmt.Sprintf(fmtSecretsManagerSecretName, o.appName, env.Name, o.name))
+	var errSecretNotFound *secretsmanager.ErrSecretNotFound
+	switch {
+	case errors.As(err, &errSecretNotFound):
+	case err != nil:
+		return false, err
+	default:
+		deleted = true
+	}
+	return deleted, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *secretRmOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Remove %s from the %s section of the manifests that reference it, then redeploy them.",
+			color.HighlightUserInput(o.name), color.HighlightCode("secrets")),
+	})
+	return nil
+}
+
+func (o *secretRmOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretRmAppNamePrompt, secretRmAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *secretRmOpts) askName() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(secretRmNamePrompt, secretRmNameHelpPrompt, validateSecretName, prompt.WithFinalMessage("Secret name:"))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *secretRmOpts) validateOrAskEnv() error {
+	if o.allEnvs {
+		return nil
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(secretRmEnvNamePrompt, secretRmEnvNameHelpPrompt, o.appName, secretRmAllEnvsOption)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	if env == secretRmAllEnvsOption {
+		o.allEnvs = true
+		return nil
+	}
+	o.envName = env
+	return nil
+}
+
+func (o *secretRmOpts) targetEnvs() ([]*config.Environment, error) {
+	if !o.allEnvs {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+// buildSecretRmCmd builds the command for deleting a secret from one or every environment of an application.
+func buildSecretRmCmd() *cobra.Command {
+	vars := secretRmVars{}
+	cmd := &cobra.Command{
+		Use:   "rm",
+		Short: "Deletes a secret from one or every environment.",
+		Long: fmt.Sprintf(`Deletes a secret created with "copilot secret init" from an environment,
+or from every environment of the application with --%s.
+The secret is deleted from both SSM Parameter Store and Secrets Manager.`, allFlag),
+		Example: `
+  Deletes the secret "db-password" from the "test" environment.
+  /code $ copilot secret rm -a my-app -n db-password -e test
+  Deletes the secret from every environment without confirmation.
+  /code $ copilot secret rm -a my-app -n db-password --all --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretRmOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.allEnvs, allFlag, false, secretRmAllFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretRm_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputName string
+		inputEnv  string
+		inputAll  bool
+
+		wantedError error
+	}{
+		"errors if both --env and --all are set": {
+			inputEnv:    "test",
+			inputAll:    true,
+			wantedError: errors.New("cannot specify both --env and --all"),
+		},
+		"errors if the secret name is invalid": {
+			inputName:   "db password",
+			wantedError: errInvalidSecretNameCharacters,
+		},
+		"success": {
+			inputName: "db-password",
+			inputEnv:  "test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &secretRmOpts{
+				secretRmVars: secretRmVars{
+					name:    tc.inputName,
+					envName: tc.inputEnv,
+					allEnvs: tc.inputAll,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type secretRmAskMocks struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockappEnvSelector
+	prompt *mocks.Mockprompter
+}
+
+func TestSecretRm_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputEnv              string
+		inputAll              bool
+		inputSkipConfirmation bool
+		setupMocks            func(m secretRmAskMocks)
+
+		wantedEnv   string
+		wantedAll   bool
+		wantedError error
+	}{
+		"errors if the environment does not exist": {
+			inputEnv: "test",
+			setupMocks: func(m secretRmAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"selects every environment": {
+			inputSkipConfirmation: true,
+			setupMocks: func(m secretRmAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Environment(secretRmEnvNamePrompt, secretRmEnvNameHelpPrompt, "phonetool", secretRmAllEnvsOption).Return(secretRmAllEnvsOption, nil)
+			},
+			wantedAll: true,
+		},
+		"selects an environment and confirms the deletion": {
+			setupMocks: func(m secretRmAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Environment(secretRmEnvNamePrompt, secretRmEnvNameHelpPrompt, "phonetool", secretRmAllEnvsOption).Return("test", nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+			},
+			wantedEnv: "test",
+		},
+		"errors if the deletion is not confirmed": {
+			inputAll: true,
+			setupMocks: func(m secretRmAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errors.New("secret rm cancelled - no changes made"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretRmAskMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockappEnvSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &secretRmOpts{
+				secretRmVars: secretRmVars{
+					appName:          "phonetool",
+					name:             "db-password",
+					envName:          tc.inputEnv,
+					allEnvs:          tc.inputAll,
+					skipConfirmation: tc.inputSkipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedAll, opts.allEnvs)
+		})
+	}
+}
+
+type secretRmExecuteMocks struct {
+	store   *mocks.Mockstore
+	checker *mocks.MockversionCompatibilityChecker
+	test    *mocks.MocksecretParameterDeleter
+	prod    *mocks.MocksecretParameterDeleter
+	testSM  *mocks.MocksecretParameterDeleter
+	prodSM  *mocks.MocksecretParameterDeleter
+}
+
+func TestSecretRm_Execute(t *testing.T) {
+	testEnv := &config.Environment{Name: "test"}
+	prodEnv := &config.Environment{Name: "prod"}
+	const (
+		testParam  = "/copilot/phonetool/test/secrets/db-password"
+		prodParam  = "/copilot/phonetool/prod/secrets/db-password"
+		testSecret = "copilot/phonetool/test/secrets/db-password"
+		prodSecret = "copilot/phonetool/prod/secrets/db-password"
+	)
+	testCases := map[string]struct {
+		inputEnv   string
+		inputAll   bool
+		setupMocks func(m secretRmExecuteMocks)
+
+		wantedError error
+	}{
+		"errors if an environment can't delete Secrets Manager secrets": {
+			inputAll: true,
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil)
+				m.checker.EXPECT().Version().Return("v1.19.0", nil)
+			},
+			wantedError: errors.New(`environment "prod" is on version "v1.19.0" which does not support the "secret rm" feature`),
+		},
+		"errors if the secret does not exist in the environment": {
+			inputEnv: "test",
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil)
+				m.test.EXPECT().DeleteSecret(testParam).Return(&ssm.ErrParameterNotFound{})
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(&secretsmanager.ErrSecretNotFound{})
+			},
+			wantedError: errors.New("secret db-password not found in environment test"),
+		},
+		"deletes the secret from an environment": {
+			inputEnv: "test",
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil)
+				m.test.EXPECT().DeleteSecret(testParam).Return(nil)
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(&secretsmanager.ErrSecretNotFound{})
+			},
+		},
+		"deletes the Secrets Manager secret from an environment": {
+			inputEnv: "test",
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil)
+				m.test.EXPECT().DeleteSecret(testParam).Return(&ssm.ErrParameterNotFound{})
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(nil)
+			},
+		},
+		"skips the environments without the secret": {
+			inputAll: true,
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil).Times(2)
+				m.test.EXPECT().DeleteSecret(testParam).Return(&ssm.ErrParameterNotFound{})
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(&secretsmanager.ErrSecretNotFound{})
+				m.prod.EXPECT().DeleteSecret(prodParam).Return(nil)
+				m.prodSM.EXPECT().DeleteSecret(prodSecret).Return(nil)
+			},
+		},
+		"errors if the secret does not exist in any environment": {
+			inputAll: true,
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil).Times(2)
+				m.test.EXPECT().DeleteSecret(testParam).Return(&ssm.ErrParameterNotFound{})
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(&secretsmanager.ErrSecretNotFound{})
+				m.prod.EXPECT().DeleteSecret(prodParam).Return(&ssm.ErrParameterNotFound{})
+				m.prodSM.EXPECT().DeleteSecret(prodSecret).Return(&secretsmanager.ErrSecretNotFound{})
+			},
+			wantedError: errors.New("secret db-password not found in any environment of application phonetool"),
+		},
+		"errors if failed to delete the parameter": {
+			inputAll: true,
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil).Times(2)
+				m.test.EXPECT().DeleteSecret(testParam).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db-password from environment test: some error"),
+		},
+		"errors if failed to delete the Secrets Manager secret": {
+			inputEnv: "test",
+			setupMocks: func(m secretRmExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.checker.EXPECT().Version().Return("v1.20.0", nil)
+				m.test.EXPECT().DeleteSecret(testParam).Return(nil)
+				m.testSM.EXPECT().DeleteSecret(testSecret).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db-password from environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretRmExecuteMocks{
+				store:   mocks.NewMockstore(ctrl),
+				checker: mocks.NewMockversionCompatibilityChecker(ctrl),
+				test:    mocks.NewMocksecretParameterDeleter(ctrl),
+				prod:    mocks.NewMocksecretParameterDeleter(ctrl),
+				testSM:  mocks.NewMocksecretParameterDeleter(ctrl),
+				prodSM:  mocks.NewMocksecretParameterDeleter(ctrl),
+			}
+			tc.setupMocks(m)
+			deleters := map[string]secretParameterDeleter{
+				"test": m.test,
+				"prod": m.prod,
+			}
+			smDeleters := map[string]secretParameterDeleter{
+				"test": m.testSM,
+				"prod": m.prodSM,
+			}
+			opts := &secretRmOpts{
+				secretRmVars: secretRmVars{
+					appName: "phonetool",
+					name:    "db-password",
+					envName: tc.inputEnv,
+					allEnvs: tc.inputAll,
+				},
+				store: m.store,
+				newSecretDeleter: func(env *config.Environment) (secretParameterDeleter, error) {
+					return deleters[env.Name], nil
+				},
+				newSecretsManagerDeleter: func(env *config.Environment) (secretParameterDeleter, error) {
+					return smDeleters[env.Name], nil
+				},
+				newEnvCompatibilityChecker: func(app, env string) (versionCompatibilityChecker, error) {
+					return m.checker, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
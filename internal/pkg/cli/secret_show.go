@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowAppNamePrompt     = "Which application's secret would you like to show?"
+	secretShowAppNameHelpPrompt = "The secret will be looked up in every environment of the application."
+
+	secretShowNamePrompt     = "What is the name of the secret?"
+	secretShowNameHelpPrompt = `The name of the secret as listed by "copilot secret ls", such as 'db_password'.`
+)
+
+type secretShowVars struct {
+	appName          string
+	name             string
+	decrypt          bool
+	shouldOutputJSON bool
+}
+
+type secretShowOpts struct {
+	secretShowVars
+
+	w                          io.Writer
+	store                      store
+	prompt                     prompter
+	sel                        appSelector
+	secretDescriber            secretDescriber
+	initSecretDescriber        func(*secretShowOpts, []*config.Environment) error // Overridden in tests.
+	newEnvCompatibilityChecker func(app, env string) (versionCompatibilityChecker, error)
+}
+
+func newSecretShowOpts(vars secretShowVars) (*secretShowOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &secretShowOpts{
+		secretShowVars: vars,
+		w:              log.OutputWriter,
+		store:          configStore,
+		prompt:         prompter,
+		sel:            selector.NewAppEnvSelector(prompter, configStore),
+		initSecretDescriber: func(o *secretShowOpts, envs []*config.Environment) error {
+			d, err := newAppSecretsDescriber(sessProvider, o.appName, envs)
+			if err != nil {
+				return err
+			}
+			o.secretDescriber = d
+			return nil
+		},
+		newEnvCompatibilityChecker: newSecretEnvCompatibilityChecker(configStore),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *secretShowOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *secretShowOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(secretShowNamePrompt, secretShowNameHelpPrompt, validateSecretName, prompt.WithFinalMessage("Secret name:"))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute shows the parameters of the secret in each environment of the application.
+func (o *secretShowOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	if err := validateSecretEnvsCompatible(o.newEnvCompatibilityChecker, o.appName, envs, "secret show"); err != nil {
+		return err
+	}
+	if err := o.initSecretDescriber(o, envs); err != nil {
+		return err
+	}
+	if o.decrypt {
+		log.Warningf("The decrypted values of secret %s will be displayed in plain text.\n", color.HighlightUserInput(o.name))
+	}
+	secret, err := o.secretDescriber.DescribeSecret(o.name, o.decrypt)
+	if err != nil {
+		return fmt.Errorf("describe secret %s: %w", o.name, err)
+	}
+	return writeHumanOrJSON(o.w, secret, o.shouldOutputJSON)
+}
+
+func (o *secretShowOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// buildSecretShowCmd builds the command for showing a secret across the environments of an application.
+func buildSecretShowCmd() *cobra.Command {
+	vars := secretShowVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the metadata of a secret in each environment.",
+		Long: `Shows the store, name, version and last modified date of a secret in each environment.
+The values of the secret are only shown with the --decrypt flag.`,
+		Example: `
+  Shows the secret "db-password" in every environment of the application "my-app".
+  /code $ copilot secret show -a my-app -n db-password
+  Shows the decrypted value of the secret in each environment.
+  /code $ copilot secret show -a my-app -n db-password --decrypt`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretShowOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().BoolVar(&vars.decrypt, decryptFlag, false, secretDecryptFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowAskMocks struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockappSelector
+	prompt *mocks.Mockprompter
+}
+
+func TestSecretShow_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputName  string
+		setupMocks func(m secretShowAskMocks)
+
+		wantedApp   string
+		wantedName  string
+		wantedError error
+	}{
+		"errors if the application does not exist": {
+			inputApp: "phonetool",
+			setupMocks: func(m secretShowAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"errors if failed to ask for the secret name": {
+			inputApp: "phonetool",
+			setupMocks: func(m secretShowAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.prompt.EXPECT().Get(secretShowNamePrompt, secretShowNameHelpPrompt, gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("ask for the secret name: some error"),
+		},
+		"prompts for the application and the secret name": {
+			setupMocks: func(m secretShowAskMocks) {
+				m.sel.EXPECT().Application(secretShowAppNamePrompt, secretShowAppNameHelpPrompt).Return("phonetool", nil)
+				m.prompt.EXPECT().Get(secretShowNamePrompt, secretShowNameHelpPrompt, gomock.Any(), gomock.Any()).Return("db-password", nil)
+			},
+			wantedApp:  "phonetool",
+			wantedName: "db-password",
+		},
+		"does not prompt if the flags are set": {
+			inputApp:  "phonetool",
+			inputName: "db-password",
+			setupMocks: func(m secretShowAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedApp:  "phonetool",
+			wantedName: "db-password",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowAskMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockappSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName: tc.inputApp,
+					name:    tc.inputName,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestSecretShow_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		decrypt    bool
+		envVersion string
+		setupMocks func(m *mocks.MocksecretDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if an environment can't manage Secrets Manager secrets": {
+			envVersion:  "v1.19.0",
+			wantedError: errors.New(`environment "test" is on version "v1.19.0" which does not support the "secret show" feature`),
+		},
+		"errors if failed to describe the secret": {
+			setupMocks: func(m *mocks.MocksecretDescriber) {
+				m.EXPECT().DescribeSecret("db-password", false).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe secret db-password: some error"),
+		},
+		"writes the decrypted secret": {
+			decrypt: true,
+			setupMocks: func(m *mocks.MocksecretDescriber) {
+				m.EXPECT().DescribeSecret("db-password", true).Return(&mockDescribeData{data: "data"}, nil)
+			},
+			wantedContent: "data",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			m := mocks.NewMocksecretDescriber(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			store := mocks.NewMockstore(ctrl)
+			store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+			checker := mocks.NewMockversionCompatibilityChecker(ctrl)
+			envVersion := "v1.20.0"
+			if tc.envVersion != "" {
+				envVersion = tc.envVersion
+			}
+			checker.EXPECT().Version().Return(envVersion, nil)
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName: "phonetool",
+					name:    "db-password",
+					decrypt: tc.decrypt,
+				},
+				w:     b,
+				store: store,
+				initSecretDescriber: func(o *secretShowOpts, envs []*config.Environment) error {
+					o.secretDescriber = m
+					return nil
+				},
+				newEnvCompatibilityChecker: func(app, env string) (versionCompatibilityChecker, error) {
+					return checker, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
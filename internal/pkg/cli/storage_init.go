@@ -33,12 +33,14 @@ const (
 	dynamoDBStorageType = "DynamoDB"
 	s3StorageType       = "S3"
 	rdsStorageType      = "Aurora"
+	redisStorageType    = "Redis"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
 }
 
 // Displayed options for storage types
@@ -46,12 +48,14 @@ const (
 	dynamoDBStorageTypeOption = "DynamoDB"
 	s3StorageTypeOption       = "S3"
 	rdsStorageTypeOption      = "Aurora Serverless"
+	redisStorageTypeOption    = "ElastiCache"
 )
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Cache"
 )
 
 const (
@@ -70,6 +74,7 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache is a fully managed, Redis OSS and Valkey-compatible in-memory cache.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -144,6 +149,36 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// ElastiCache specific questions and help prompts.
+var (
+	storageInitRedisEnginePrompt = "Which engine would you like to use for your cache?"
+	storageInitRedisEngineHelp   = "Valkey is an open source fork of Redis OSS. Both engines are compatible with the Redis protocol."
+	storageInitRedisModePrompt   = "Would you like a serverless cache or a cluster of cache nodes?"
+	storageInitRedisModeHelp     = `A serverless cache scales its capacity automatically with the traffic of your application.
+A cluster is a replication group of cache nodes whose type and number you choose.`
+)
+
+// ElastiCache specific constants and variables.
+const (
+	fmtRedisStorageNameDefault = "%s-cache"
+
+	redisEngineTypeRedis  = addon.RedisEngineTypeRedis
+	redisEngineTypeValkey = addon.RedisEngineTypeValkey
+
+	redisModeCluster    = addon.RedisModeCluster
+	redisModeServerless = addon.RedisModeServerless
+)
+
+var redisEngineTypes = []string{
+	redisEngineTypeRedis,
+	redisEngineTypeValkey,
+}
+
+var redisModes = []string{
+	redisModeServerless,
+	redisModeCluster,
+}
+
 const workloadTypeNonLocal = "Non Local"
 
 const (
@@ -170,6 +205,10 @@ type initStorageVars struct {
 	rdsEngine               string
 	rdsParameterGroup       string
 	rdsInitialDBName        string
+
+	// ElastiCache specific values collected via flags or prompts
+	redisEngine string
+	redisMode   string
 }
 
 type initStorageOpts struct {
@@ -288,7 +327,7 @@ func (o *initStorageOpts) Ask() error {
 	if err := o.askWorkload(); err != nil {
 		return err
 	}
-	// Storage name needs to be asked after workload because for Aurora the default storage name uses the workload name.
+	// Storage name needs to be asked after workload because for Aurora and ElastiCache the default storage name uses the workload name.
 	if err := o.validateOrAskStorageName(); err != nil {
 		return err
 	}
@@ -317,6 +356,13 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.validateOrAskAuroraInitialDBName(); err != nil {
 			return err
 		}
+	case redisStorageType:
+		if err := o.validateOrAskRedisEngineType(); err != nil {
+			return err
+		}
+		if err := o.validateOrAskRedisMode(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -341,6 +387,11 @@ func (o *initStorageOpts) validateOrAskStorageType() error {
 			FriendlyText: rdsStorageTypeOption,
 			Hint:         "SQL",
 		},
+		{
+			Value:        redisStorageType,
+			FriendlyText: redisStorageTypeOption,
+			Hint:         "Cache",
+		},
 	}
 	result, err := o.prompt.SelectOption(o.storageTypePrompt(),
 		storageInitTypeHelp,
@@ -396,6 +447,8 @@ func (o *initStorageOpts) validateOrAskStorageName() error {
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+	case redisStorageType:
+		return o.askStorageNameWithDefault(redisFriendlyText, fmt.Sprintf(fmtRedisStorageNameDefault, o.workloadName), redisNameValidation)
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -418,6 +471,8 @@ func (o *initStorageOpts) validateStorageName() error {
 		return s3BucketNameValidation(o.storageName)
 	case rdsStorageType:
 		return rdsNameValidation(o.storageName)
+	case redisStorageType:
+		return redisNameValidation(o.storageName)
 	default:
 		// use dynamo since it's a superset of s3
 		return dynamoTableNameValidation(o.storageName)
@@ -740,6 +795,36 @@ func (o *initStorageOpts) validateOrAskAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) validateOrAskRedisEngineType() error {
+	if o.redisEngine != "" {
+		return validateRedisEngine(o.redisEngine)
+	}
+	engine, err := o.prompt.SelectOne(storageInitRedisEnginePrompt,
+		storageInitRedisEngineHelp,
+		redisEngineTypes,
+		prompt.WithFinalMessage("Cache engine:"))
+	if err != nil {
+		return fmt.Errorf("select cache engine: %w", err)
+	}
+	o.redisEngine = engine
+	return nil
+}
+
+func (o *initStorageOpts) validateOrAskRedisMode() error {
+	if o.redisMode != "" {
+		return validateRedisMode(o.redisMode)
+	}
+	mode, err := o.prompt.SelectOne(storageInitRedisModePrompt,
+		storageInitRedisModeHelp,
+		redisModes,
+		prompt.WithFinalMessage("Cache mode:"))
+	if err != nil {
+		return fmt.Errorf("select cache mode: %w", err)
+	}
+	o.redisMode = mode
+	return nil
+}
+
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
 func (o *initStorageOpts) Execute() error {
 	o.consumeFlags()
@@ -847,6 +932,10 @@ func (o *initStorageOpts) addonBlobs() ([]addonBlob, error) {
 		return o.envDDBAddonBlobs()
 	case option{lifecycleEnvironmentLevel, rdsStorageType}:
 		return o.envRDSAddonBlobs()
+	case option{lifecycleWorkloadLevel, redisStorageType}:
+		return o.wkldRedisAddonBlobs()
+	case option{lifecycleEnvironmentLevel, redisStorageType}:
+		return o.envRedisAddonBlobs()
 	}
 	return nil, fmt.Errorf("storage type %s is not supported yet", o.storageType)
 }
@@ -1064,6 +1153,60 @@ func (o *initStorageOpts) rdsProps() (addon.RDSProps, error) {
 	}, nil
 }
 
+func (o *initStorageOpts) wkldRedisAddonBlobs() ([]addonBlob, error) {
+	if o.workloadType == manifestinfo.RequestDrivenWebServiceType {
+		return nil, fmt.Errorf("storage type %s is not supported for %s", o.storageType, manifestinfo.RequestDrivenWebServiceType)
+	}
+	props, err := o.redisProps()
+	if err != nil {
+		return nil, err
+	}
+	return []addonBlob{
+		{
+			path:        o.ws.WorkloadAddonFilePath(o.workloadName, fmt.Sprintf("%s.yml", o.storageName)),
+			description: blobDescriptionTemplate,
+			blob:        addon.WorkloadRedisTemplate(props),
+		},
+	}, nil
+}
+
+func (o *initStorageOpts) envRedisAddonBlobs() ([]addonBlob, error) {
+	if o.workloadType == manifestinfo.RequestDrivenWebServiceType {
+		return nil, fmt.Errorf("storage type %s is not supported for %s", o.storageType, manifestinfo.RequestDrivenWebServiceType)
+	}
+	if o.addIngressFrom != "" {
+		return nil, nil
+	}
+	props, err := o.redisProps()
+	if err != nil {
+		return nil, err
+	}
+	tmplBlob := addonBlob{
+		path:        o.ws.EnvAddonFilePath(fmt.Sprintf("%s.yml", o.storageName)),
+		description: blobDescriptionTemplate,
+		blob:        addon.EnvRedisTemplate(props),
+	}
+	paramBlob := addonBlob{
+		path:        o.ws.EnvAddonFilePath(workspace.AddonsParametersFileName),
+		description: blobDescriptionParameters,
+		blob:        addon.EnvParamsForRDS(), // The cache needs the same VPC and subnets of the environment as an Aurora cluster.
+	}
+	return []addonBlob{tmplBlob, paramBlob}, nil
+}
+
+func (o *initStorageOpts) redisProps() (addon.RedisProps, error) {
+	envs, err := o.environmentNames()
+	if err != nil {
+		return addon.RedisProps{}, err
+	}
+	return addon.RedisProps{
+		ClusterName: o.storageName,
+		Engine:      o.redisEngine,
+		Mode:        o.redisMode,
+		Envs:        envs,
+	}, nil
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -1108,6 +1251,10 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		newVar = template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName))
+		retrieveEnvVarCode = fmt.Sprintf(`const {username, password} = JSON.parse(process.env.%s);
+const host = process.env.%s; // TLS is required on port 6379.`, newVar, template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName)+"Endpoint"))
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -1172,6 +1319,18 @@ secrets:
   DB_SECRET:
     from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%sAuroraSecret`,
 			logicalIDSafeStorageName, logicalIDSafeStorageName)
+	case o.storageType == redisStorageType:
+		return fmt.Sprintf(`network:
+  vpc:
+    security_groups:
+      - from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%sSecurityGroup
+variables:
+  CACHE_ENDPOINT:
+    from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%sEndpoint
+secrets:
+  CACHE_SECRET:
+    from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%sAuthTokenSecret`,
+			logicalIDSafeStorageName, logicalIDSafeStorageName, logicalIDSafeStorageName)
 	}
 	return ""
 }
@@ -1222,7 +1381,9 @@ Storage resources are addons, either for a workload or the environments.`,
   Create a DynamoDB table with a sort key.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create an RDS Aurora Serverless v2 cluster using PostgreSQL.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb
+  Create an environment ElastiCache serverless cache using Valkey accessed by the "api" service.
+  /code $ copilot storage init -n my-cache -t Redis -w api -l environment --cache-engine Valkey --cache-mode serverless`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -1248,9 +1409,13 @@ Storage resources are addons, either for a workload or the environments.`,
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
 
+	cmd.Flags().StringVar(&vars.redisEngine, storageRedisEngineFlag, "", storageRedisEngineFlagDescription)
+	cmd.Flags().StringVar(&vars.redisMode, storageRedisModeFlag, "", storageRedisModeFlagDescription)
+
 	ddbFlags := []string{storagePartitionKeyFlag, storageSortKeyFlag, storageNoSortFlag, storageLSIConfigFlag, storageNoLSIFlag}
 	rdsFlags := []string{storageAuroraServerlessVersionFlag, storageRDSEngineFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag}
-	for _, f := range append(ddbFlags, storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag) {
+	redisFlags := []string{storageRedisEngineFlag, storageRedisModeFlag}
+	for _, f := range append(append(ddbFlags, redisFlags...), storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag) {
 		cmd.MarkFlagsMutuallyExclusive(storageAddIngressFromFlag, f)
 	}
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
@@ -1268,15 +1433,21 @@ Storage resources are addons, either for a workload or the environments.`,
 		auroraFlagSet.AddFlag(cmd.Flags().Lookup(f))
 	}
 
+	redisFlagSet := pflag.NewFlagSet("ElastiCache", pflag.ContinueOnError)
+	for _, f := range redisFlags {
+		redisFlagSet.AddFlag(cmd.Flags().Lookup(f))
+	}
+
 	optionalFlagSet := pflag.NewFlagSet("Optional", pflag.ContinueOnError)
 	optionalFlagSet.AddFlag(cmd.Flags().Lookup(storageAddIngressFromFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless,Optional`,
+		"sections":          `Required,DynamoDB,Aurora Serverless,ElastiCache,Optional`,
 		"Required":          requiredFlags.FlagUsages(),
 		"DynamoDB":          ddbFlagSet.FlagUsages(),
 		"Aurora Serverless": auroraFlagSet.FlagUsages(),
+		"ElastiCache":       redisFlagSet.FlagUsages(),
 		"Optional":          optionalFlagSet.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
@@ -203,7 +203,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inStorageType: "box",
 			inSvcName:     "frontend",
 			mock:          func(m *mockStorageInitAsk) {},
-			wantedErr:     errors.New(`invalid storage type box: must be one of "DynamoDB", "S3", "Aurora"`),
+			wantedErr:     errors.New(`invalid storage type box: must be one of "DynamoDB", "S3", "Aurora", "Redis"`),
 		},
 		"asks for storage type": {
 			inSvcName:     wantedSvcName,
@@ -1159,6 +1159,162 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 	}
 }
 
+func TestStorageInitOpts_AskRedis(t *testing.T) {
+	const (
+		wantedSvcName   = "frontend"
+		wantedCacheName = "sessions"
+
+		wantedEngine = redisEngineTypeValkey
+		wantedMode   = redisModeServerless
+	)
+	testCases := map[string]struct {
+		inStorageName string
+		inEngine      string
+		inMode        string
+
+		mock func(m *mockStorageInitAsk)
+
+		wantedErr  error
+		wantedVars *initStorageVars
+	}{
+		"error if the workload is a RDWS": {
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Request-Driven Web Service"), nil)
+			},
+			wantedErr: errors.New("invalid storage type Redis: not supported for Request-Driven Web Service"),
+		},
+		"invalid cache name": {
+			inStorageName: "this-name-is-way-too-long-for-a-cache",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+			wantedErr: errors.New("validate storage name: value must be between 1 and 31 characters in length"),
+		},
+		"asks for cache name with the workload name as default": {
+			inEngine: wantedEngine,
+			inMode:   wantedMode,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.prompt.EXPECT().Get(
+					gomock.Eq("What would you like to name this Cache?"),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return(wantedCacheName, nil)
+			},
+			wantedVars: &initStorageVars{
+				storageType:  redisStorageType,
+				storageName:  wantedCacheName,
+				workloadName: wantedSvcName,
+				lifecycle:    lifecycleEnvironmentLevel,
+
+				redisEngine: wantedEngine,
+				redisMode:   wantedMode,
+			},
+		},
+		"invalid cache engine": {
+			inStorageName: wantedCacheName,
+			inEngine:      "memcached",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+			},
+			wantedErr: errors.New(`invalid engine type memcached: must be one of "Redis", "Valkey"`),
+		},
+		"error if engine not gotten": {
+			inStorageName: wantedCacheName,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.prompt.EXPECT().SelectOne(storageInitRedisEnginePrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select cache engine: some error"),
+		},
+		"invalid cache mode": {
+			inStorageName: wantedCacheName,
+			inEngine:      wantedEngine,
+			inMode:        "provisioned",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+			},
+			wantedErr: errors.New(`invalid cache mode provisioned: must be one of "serverless", "cluster"`),
+		},
+		"error if mode not gotten": {
+			inStorageName: wantedCacheName,
+			inEngine:      wantedEngine,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.prompt.EXPECT().SelectOne(storageInitRedisModePrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select cache mode: some error"),
+		},
+		"asks for engine and mode if not specified": {
+			inStorageName: wantedCacheName,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.prompt.EXPECT().SelectOne(gomock.Eq(storageInitRedisEnginePrompt), gomock.Any(), redisEngineTypes, gomock.Any()).
+					Return(wantedEngine, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Eq(storageInitRedisModePrompt), gomock.Any(), redisModes, gomock.Any()).
+					Return(wantedMode, nil)
+			},
+			wantedVars: &initStorageVars{
+				storageType:  redisStorageType,
+				storageName:  wantedCacheName,
+				workloadName: wantedSvcName,
+				lifecycle:    lifecycleEnvironmentLevel,
+
+				redisEngine: wantedEngine,
+				redisMode:   wantedMode,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockStorageInitAsk{
+				prompt: mocks.NewMockprompter(ctrl),
+				ws:     mocks.NewMockwsReadWriter(ctrl),
+			}
+			opts := initStorageOpts{
+				initStorageVars: initStorageVars{
+					storageType:  redisStorageType,
+					workloadName: wantedSvcName,
+					storageName:  tc.inStorageName,
+					lifecycle:    lifecycleEnvironmentLevel,
+
+					redisEngine: tc.inEngine,
+					redisMode:   tc.inMode,
+				},
+				appName: "ddos",
+				prompt:  m.prompt,
+				ws:      m.ws,
+			}
+			tc.mock(&m)
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedVars != nil {
+				require.Equal(t, *tc.wantedVars, opts.initStorageVars)
+			}
+		})
+	}
+}
+
 func TestStorageInitOpts_Execute(t *testing.T) {
 	const (
 		wantedAppName      = "ddos"
@@ -1184,6 +1340,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName     string
 		inParameterGroup    string
 
+		inRedisEngine string
+		inRedisMode   string
+
 		inLifecycle string
 
 		mockWS         func(m *mocks.MockwsReadWriter)
@@ -1434,6 +1593,64 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
 			},
 		},
+		"happy calls for wkld Redis": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+			inRedisEngine: redisEngineTypeRedis,
+			inRedisMode:   redisModeCluster,
+			inLifecycle:   lifecycleWorkloadLevel,
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(true, nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WorkloadAddonFilePath(gomock.Eq(wantedSvcName), gomock.Eq("mycache.yml")).Return("mockPath")
+				m.EXPECT().Write(gomock.Any(), "mockPath").Return("/frontend/addons/mycache.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
+			},
+		},
+		"error for wkld Redis with a RDWS": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+			inRedisEngine: redisEngineTypeRedis,
+			inRedisMode:   redisModeCluster,
+			inLifecycle:   lifecycleWorkloadLevel,
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(true, nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Request-Driven Web Service"), nil)
+			},
+			wantedErr: errors.New("storage type Redis is not supported for Request-Driven Web Service"),
+		},
+		"happy calls for env Redis": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "mycache",
+			inRedisEngine: redisEngineTypeValkey,
+			inRedisMode:   redisModeServerless,
+			inLifecycle:   lifecycleEnvironmentLevel,
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(true, nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("mycache.yml")).Return("mockEnvTemplatePath")
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("addons.parameters.yml")).Return("mockEnvParametersPath")
+				m.EXPECT().Write(gomock.Any(), "mockEnvTemplatePath").Return("mockEnvTemplatePath", nil)
+				m.EXPECT().Write(gomock.Any(), "mockEnvParametersPath").Return("mockEnvParametersPath", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
+			},
+		},
+		"add ingress for env Redis": {
+			inStorageType:    redisStorageType,
+			inStorageName:    "mycache",
+			inAddIngressFrom: wantedSvcName,
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(true, nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+			},
+		},
 		"do not error out if addon exists": {
 			inStorageType: s3StorageType,
 			inSvcName:     wantedSvcName,
@@ -1501,6 +1718,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 					auroraServerlessVersion: tc.inServerlessVersion,
 					rdsEngine:               tc.inEngine,
 					rdsParameterGroup:       tc.inParameterGroup,
+
+					redisEngine: tc.inRedisEngine,
+					redisMode:   tc.inRedisMode,
 				},
 				appName:        wantedAppName,
 				ws:             mockWS,
@@ -34,6 +34,10 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcScaleCmd())
+	cmd.AddCommand(buildSvcDriftCmd())
+	cmd.AddCommand(buildSvcDeploymentsCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
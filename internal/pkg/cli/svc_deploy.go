@@ -4,7 +4,10 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/spf13/afero"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -34,6 +38,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+const (
+	fmtDeployDiffConfirmPrompt = "Continue with the deployment of %s?"
+)
+
 type deployWkldVars struct {
 	appName         string
 	name            string
@@ -42,6 +50,8 @@ type deployWkldVars struct {
 	resourceTags    map[string]string
 	forceNewUpdate  bool // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback bool
+	showDiff        bool // NOTE: this variable is not applicable for a job workload currently.
+	skipDiffPrompt  bool // NOTE: this variable is not applicable for a job workload currently.
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -62,7 +72,11 @@ type deploySvcOpts struct {
 	spinner        progress
 	sel            wsSelector
 	prompt         prompter
+	diffWriter     io.Writer
+	isTerminal     bool // Whether the standard input is a terminal that can answer the confirmation of the diff.
 	gitShortCommit string
+	output         termprogress.FileWriter // Where the progress of the deployment is written, defaults to stderr.
+	diagnostics    io.Writer               // Where the messages of the deployment are written, defaults to the diagnostic writer.
 
 	// cached variables
 	targetApp         *config.Application
@@ -98,6 +112,8 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
 		prompt:          prompter,
+		diffWriter:      os.Stdout,
+		isTerminal:      isStdinTerminal(),
 		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
@@ -118,7 +134,7 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
+	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider, log.WriterOrDiagnostic(o.diagnostics))
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +154,8 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		RawMft:           raw,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		Output:           o.output,
+		DiagnosticWriter: o.diagnostics,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -165,7 +183,7 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
-	return nil
+	return validateDiffFlags(o.showDiff, o.skipDiffPrompt, o.isTerminal)
 }
 
 // Ask prompts for and validates any required flags.
@@ -191,6 +209,7 @@ func (o *deploySvcOpts) Ask() error {
 
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
+	logger := log.New(log.WriterOrDiagnostic(o.diagnostics))
 	if !o.clientConfigured {
 		if err := o.configureClients(); err != nil {
 			return err
@@ -222,47 +241,90 @@ func (o *deploySvcOpts) Execute() error {
 	}
 
 	if !serviceInRegion {
-		log.Warningf(`%s might not be available in region %s; proceed with caution.
+		logger.Warningf(`%s might not be available in region %s; proceed with caution.
 `, o.svcType, o.targetEnv.Region)
 	}
+	targetApp, err := o.getTargetApp()
+	if err != nil {
+		return err
+	}
+	if o.showDiff {
+		// Preview the deployment before building and pushing the images, so that nothing is published if it's cancelled.
+		previewOut, err := deployer.UploadPreviewArtifacts()
+		if err != nil {
+			return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
+		}
+		if err := o.confirmDiff(deployer, o.deployInput(targetApp, previewOut)); err != nil {
+			return err
+		}
+	}
 	uploadOut, err := deployer.UploadArtifacts()
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
 	}
-	targetApp, err := o.getTargetApp()
+	deployRecs, err := deployer.DeployWorkload(o.deployInput(targetApp, uploadOut))
 	if err != nil {
-		return err
+		if o.disableRollback {
+			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.name)
+			rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stackName, o.targetEnv.ExecutionRoleARN)
+			logger.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can:
+* Run %s to inspect the service log.
+* Visit the AWS console to inspect the errors.
+After fixing the deployment, you can:
+1. Run %s to rollback the deployment.
+2. Run %s to make a new deployment.
+`, color.HighlightCode("copilot svc logs"), color.HighlightCode(rollbackCmd), color.HighlightCode("copilot svc deploy"))
+		}
+		return fmt.Errorf("deploy service %s to environment %s: %w", o.name, o.envName, err)
 	}
-	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
+	o.deployRecs = deployRecs
+	logger.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *deploySvcOpts) deployInput(app *config.Application, uploadOut *clideploy.UploadArtifactsOutput) *clideploy.DeployWorkloadInput {
+	return &clideploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigests:       uploadOut.ImageDigests,
 			EnvFileARN:         uploadOut.EnvFileARN,
 			AddonsURL:          uploadOut.AddonsURL,
 			RootUserARN:        o.rootUserARN,
-			Tags:               tags.Merge(targetApp.Tags, o.resourceTags),
+			Tags:               tags.Merge(app.Tags, o.resourceTags),
 			CustomResourceURLs: uploadOut.CustomResourceURLs,
 		},
 		Options: clideploy.Options{
 			ForceNewUpdate:  o.forceNewUpdate,
 			DisableRollback: o.disableRollback,
 		},
+	}
+}
+
+func (o *deploySvcOpts) confirmDiff(deployer workloadDeployer, in *clideploy.DeployWorkloadInput) error {
+	tpl, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
+		StackRuntimeConfiguration: in.StackRuntimeConfiguration,
 	})
 	if err != nil {
-		if o.disableRollback {
-			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.name)
-			rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stackName, o.targetEnv.ExecutionRoleARN)
-			log.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can:
-* Run %s to inspect the service log.
-* Visit the AWS console to inspect the errors.
-After fixing the deployment, you can:
-1. Run %s to rollback the deployment.
-2. Run %s to make a new deployment.
-`, color.HighlightCode("copilot svc logs"), color.HighlightCode(rollbackCmd), color.HighlightCode("copilot svc deploy"))
-		}
-		return fmt.Errorf("deploy service %s to environment %s: %w", o.name, o.envName, err)
+		return fmt.Errorf("generate the template for service %s: %w", o.name, err)
+	}
+	templateDiff, err := deployer.DeployDiff(tpl.Template)
+	if err != nil {
+		return fmt.Errorf("compute the diff for service %s: %w", o.name, err)
+	}
+	changes, err := deployer.PreviewChangeSet(in)
+	if err != nil {
+		return err
+	}
+	writeDeployDiff(o.diffWriter, templateDiff, changes)
+	if o.skipDiffPrompt {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeployDiffConfirmPrompt, color.HighlightUserInput(o.name)), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm deployment of service %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errors.New("svc deploy cancelled - no changes made")
 	}
-	o.deployRecs = deployRecs
-	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
 	return nil
 }
 
@@ -379,6 +441,35 @@ type workloadManifestInput struct {
 	unmarshal    func([]byte) (manifest.DynamicWorkload, error)
 }
 
+// validateDiffFlags returns an error if the confirmation of the diff can't be answered.
+func validateDiffFlags(showDiff, skipDiffPrompt, isTerminal bool) error {
+	if skipDiffPrompt && !showDiff {
+		return fmt.Errorf("--%s must be specified with --%s", yesFlag, diffFlag)
+	}
+	if showDiff && !skipDiffPrompt && !isTerminal {
+		return fmt.Errorf("--%s requires a terminal to confirm the deployment, specify --%s to deploy without confirming", diffFlag, yesFlag)
+	}
+	return nil
+}
+
+// isStdinTerminal returns true if the standard input is a terminal.
+func isStdinTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// writeDeployDiff writes the diff of the CloudFormation template and the resources that the change set will change.
+func writeDeployDiff(w io.Writer, templateDiff, changes string) {
+	if templateDiff == "" {
+		fmt.Fprintln(w, "No changes to the CloudFormation template.")
+	} else {
+		fmt.Fprint(w, templateDiff)
+	}
+	if changes != "" {
+		fmt.Fprintf(w, "\nResource changes:\n%s", changes)
+	}
+	fmt.Fprintln(w)
+}
+
 func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, error) {
 	raw, err := in.ws.ReadWorkloadManifest(in.name)
 	if err != nil {
@@ -528,10 +619,12 @@ func (e *errFeatureIncompatibleWithEnvironment) Error() string {
 // RecommendActions returns recommended actions to be taken after the error.
 // Implements main.actionRecommender interface.
 func (e *errFeatureIncompatibleWithEnvironment) RecommendActions() string {
-	envs, _ := e.ws.ListEnvironments() // Best effort try to detect if env manifest exists.
-	for _, env := range envs {
-		if e.envName == env {
-			return fmt.Sprintf("You can upgrade the %q environment template by running %s.", e.envName, color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", e.envName)))
+	if e.ws != nil { // Commands that can run outside a workspace don't set it.
+		envs, _ := e.ws.ListEnvironments() // Best effort try to detect if env manifest exists.
+		for _, env := range envs {
+			if e.envName == env {
+				return fmt.Sprintf("You can upgrade the %q environment template by running %s.", e.envName, color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", e.envName)))
+			}
 		}
 	}
 	msgs := []string{
@@ -558,7 +651,9 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes to the service's stack before deploying.
+  /code $ copilot svc deploy --name frontend --env test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -574,6 +669,8 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipDiffPrompt, yesFlag, false, diffYesFlagDescription)
 
 	return cmd
 }
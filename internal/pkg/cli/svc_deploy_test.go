@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -21,7 +22,51 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inShowDiff       bool
+		inSkipDiffPrompt bool
+		inIsTerminal     bool
+
+		wantedError error
+	}{
+		"success without --diff": {},
+		"success with --diff in a terminal": {
+			inShowDiff:   true,
+			inIsTerminal: true,
+		},
+		"success with --diff and --yes outside of a terminal": {
+			inShowDiff:       true,
+			inSkipDiffPrompt: true,
+		},
+		"error if --diff can't be confirmed outside of a terminal": {
+			inShowDiff:  true,
+			wantedError: errors.New("--diff requires a terminal to confirm the deployment, specify --yes to deploy without confirming"),
+		},
+		"error if --yes is specified without --diff": {
+			inSkipDiffPrompt: true,
+			inIsTerminal:     true,
+			wantedError:      errors.New("--yes must be specified with --diff"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					showDiff:       tc.inShowDiff,
+					skipDiffPrompt: tc.inSkipDiffPrompt,
+				},
+				isTerminal: tc.inIsTerminal,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -129,6 +174,7 @@ type deployMocks struct {
 	mockInterpolator         *mocks.Mockinterpolator
 	mockWsReader             *mocks.MockwsWlDirReader
 	mockEnvFeaturesDescriber *mocks.MockversionCompatibilityChecker
+	mockPrompter             *mocks.Mockprompter
 	mockMft                  *mockWorkloadMft
 }
 
@@ -140,8 +186,11 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	)
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		mock        func(m *deployMocks)
-		wantedError error
+		inShowDiff       bool
+		inSkipDiffPrompt bool
+		mock             func(m *deployMocks)
+		wantedDiff       string
+		wantedError      error
 	}{
 		"error out if fail to read workload manifest": {
 			mock: func(m *deployMocks) {
@@ -218,6 +267,149 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: some error"),
 		},
+		"error if failed to upload the artifacts to preview": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(nil, mockError)
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+			},
+
+			wantedError: fmt.Errorf("upload deploy resources for service frontend: some error"),
+		},
+		"error if failed to generate the template to diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("generate the template for service frontend: some error"),
+		},
+		"error if failed to preview the change set": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("mockTemplate").Return("", nil)
+				m.mockDeployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("", mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"do not deploy the service if the diff is not confirmed": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("mockTemplate").Return("~ Resources:\n", nil)
+				m.mockDeployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("mockChanges\n", nil)
+				m.mockPrompter.EXPECT().Confirm("Continue with the deployment of frontend?", "", gomock.Any()).Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedDiff:  "~ Resources:\n\nResource changes:\nmockChanges\n\n",
+			wantedError: errors.New("svc deploy cancelled - no changes made"),
+		},
+		"deploy the service after the diff is confirmed": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil),
+					m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+						Template: "mockTemplate",
+					}, nil),
+					m.mockDeployer.EXPECT().DeployDiff("mockTemplate").Return("", nil),
+					m.mockDeployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("", nil),
+					m.mockPrompter.EXPECT().Confirm("Continue with the deployment of frontend?", "", gomock.Any()).Return(true, nil),
+					m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil),
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil),
+				)
+			},
+
+			wantedDiff: "No changes to the CloudFormation template.\n\n",
+		},
+		"deploy the service without confirming the diff with --yes": {
+			inShowDiff:       true,
+			inSkipDiffPrompt: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadPreviewArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{
+					Template: "mockTemplate",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("mockTemplate").Return("", nil)
+				m.mockDeployer.EXPECT().PreviewChangeSet(gomock.Any()).Return("", nil)
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+
+			wantedDiff: "No changes to the CloudFormation template.\n\n",
+		},
 		"success with no recommendations": {
 			mock: func(m *deployMocks) {
 				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
@@ -247,17 +439,23 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
 				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompter:             mocks.NewMockprompter(ctrl),
 			}
 			tc.mock(m)
+			diff := new(strings.Builder)
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockSvcName,
-					envName: mockEnvName,
+					appName:        mockAppName,
+					name:           mockSvcName,
+					envName:        mockEnvName,
+					showDiff:       tc.inShowDiff,
+					skipDiffPrompt: tc.inSkipDiffPrompt,
 
 					clientConfigured: true,
 				},
+				prompt:     m.mockPrompter,
+				diffWriter: diff,
 				newSvcDeployer: func() (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
@@ -282,6 +480,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
+			require.Equal(t, tc.wantedDiff, diff.String())
 		})
 	}
 }
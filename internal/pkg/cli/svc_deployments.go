@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDeploymentsNamePrompt     = "Which service's deployments would you like to show?"
+	svcDeploymentsNameHelpPrompt = "The recorded deployments of the indicated deployed service will be shown."
+
+	defaultSvcDeploymentsLimit = 10
+)
+
+type svcDeploymentsVars struct {
+	shouldOutputJSON bool
+	name             string
+	envName          string
+	appName          string
+	last             int // The number of most recent deployments to show.
+}
+
+type svcDeploymentsOpts struct {
+	svcDeploymentsVars
+
+	w                        io.Writer
+	store                    store
+	sel                      deploySelector
+	deploymentsDescriber     describer
+	initDeploymentsDescriber func(*svcDeploymentsOpts) error // Overridden in tests.
+}
+
+func newSvcDeploymentsOpts(vars svcDeploymentsVars) (*svcDeploymentsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc deployments"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcDeploymentsOpts{
+		svcDeploymentsVars: vars,
+		w:                  log.OutputWriter,
+		store:              configStore,
+		sel:                selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initDeploymentsDescriber: func(o *svcDeploymentsOpts) error {
+			env, err := configStore.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment: %w", err)
+			}
+			bucket, err := appBucket(defaultSess, configStore, o.appName, env)
+			if err != nil {
+				return err
+			}
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.deploymentsDescriber = describe.NewSvcDeploymentsDescriber(&describe.NewSvcDeploymentsConfig{
+				Env:       o.envName,
+				Svc:       o.name,
+				Limit:     o.last,
+				Revisions: revision.NewStore(s3.New(envSess), bucket),
+			})
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcDeploymentsOpts) Validate() error {
+	if o.last <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", lastFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcDeploymentsOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute displays the recorded deployments of the service.
+func (o *svcDeploymentsOpts) Execute() error {
+	if err := o.initDeploymentsDescriber(o); err != nil {
+		return err
+	}
+	deployments, err := o.deploymentsDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe deployments of service %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := deployments.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, deployments.HumanString())
+	}
+	return nil
+}
+
+func (o *svcDeploymentsOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDeploymentsOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDeploymentsCmd builds the command for listing the recorded deployments of a service.
+func buildSvcDeploymentsCmd() *cobra.Command {
+	vars := svcDeploymentsVars{}
+	cmd := &cobra.Command{
+		Use:   "deployments",
+		Short: "Lists the recorded deployments of a deployed service.",
+		Long: `Lists the recorded deployments of a deployed service.
+Each successful deployment is recorded as a revision that can be redeployed with "copilot svc rollback".`,
+		Example: `
+  Lists the 10 most recent deployments of the service "my-svc" in environment "test".
+  /code $ copilot svc deployments -n my-svc -e test
+  Lists the last 3 deployments of the service in JSON format.
+  /code $ copilot svc deployments -n my-svc -e test --last 3 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDeploymentsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.last, lastFlag, defaultSvcDeploymentsLimit, svcDeploymentsLastFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
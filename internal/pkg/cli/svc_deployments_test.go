@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDeployments_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputLast int
+
+		wantedError error
+	}{
+		"errors if --last is not positive": {
+			inputLast:   0,
+			wantedError: errors.New("--last must be greater than 0"),
+		},
+		"success": {
+			inputLast: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					last: tc.inputLast,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcDeploymentsAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestSvcDeployments_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp string
+		inputSvc string
+		inputEnv string
+
+		setupMocks func(m svcDeploymentsAskMock)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"validate app env and service with all flags passed in": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			inputEnv: "test",
+			setupMocks: func(m svcDeploymentsAskMock) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"prompt for app, env and service": {
+			setupMocks: func(m svcDeploymentsAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"errors if failed to select application": {
+			setupMocks: func(m svcDeploymentsAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("", mockError)
+			},
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			setupMocks: func(m svcDeploymentsAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcDeploymentsAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName: tc.inputApp,
+					envName: tc.inputEnv,
+					name:    tc.inputSvc,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+		})
+	}
+}
+
+func TestSvcDeployments_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	deployments := &describe.SvcDeployments{
+		Deployments: []describe.SvcDeployment{
+			{
+				Revision:   2,
+				DeployedAt: time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC),
+				RolledBack: 1,
+			},
+		},
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the deployments of the service": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe deployments of service api: some error"),
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(deployments, nil)
+			},
+			wantedContent: `{"deployments":[{"revision":2,"deployedAt":"2023-03-01T02:00:00Z","rolledBack":1}]}
+`,
+		},
+		"success with human output": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(deployments, nil)
+			},
+			wantedContent: deployments.HumanString(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName:          "phonetool",
+					envName:          "test",
+					name:             "api",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				deploymentsDescriber:     mockDescriber,
+				initDeploymentsDescriber: func(*svcDeploymentsOpts) error { return nil },
+				w:                        b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
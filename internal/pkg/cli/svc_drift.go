@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDriftAppNamePrompt     = "Which application is the service in?"
+	svcDriftNamePrompt        = "Which service of %s would you like to detect drift for?"
+	svcDriftSvcNameHelpPrompt = "Copilot compares the resources of the service, including its addons, with its deployed CloudFormation templates."
+)
+
+type svcDriftVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	shouldOutputJSON bool
+}
+
+type svcDriftOpts struct {
+	svcDriftVars
+
+	w                  io.Writer
+	store              store
+	sel                deploySelector
+	prog               progress
+	describer          stackDriftDescriber
+	initDriftDescriber func() error
+}
+
+func newSvcDriftOpts(vars svcDriftVars) (*svcDriftOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+
+	opts := &svcDriftOpts{
+		svcDriftVars: vars,
+		w:            log.OutputWriter,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initDriftDescriber = func() error {
+		d, err := describe.NewStackDriftDescriber(describe.NewStackDriftConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Workload:    opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create drift describer for service %s in environment %s: %w", opts.svcName, opts.envName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *svcDriftOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcDriftOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute detects drift on the service stack and its nested stacks, and prints the drifted resources.
+func (o *svcDriftOpts) Execute() error {
+	if err := o.initDriftDescriber(); err != nil {
+		return err
+	}
+	return detectDrift(o.describer, o.prog, o.w, fmt.Sprintf("service %s in environment %s", o.svcName, o.envName), o.shouldOutputJSON)
+}
+
+func (o *svcDriftOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcDriftAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDriftOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcDriftNamePrompt, color.HighlightUserInput(o.appName)),
+		svcDriftSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDriftCmd builds the command for detecting drift of a deployed service.
+func buildSvcDriftCmd() *cobra.Command {
+	vars := svcDriftVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of a deployed service that were changed outside of CloudFormation.",
+		Long: `Detects resources of a deployed service, including its addons, that were changed outside of CloudFormation.
+Exits with code 2 if any resource drifted from its deployed template.`,
+
+		Example: `
+  Show the drifted resources of service "api" in the "test" environment.
+  /code $ copilot svc drift -n api -e test
+  Fail a CI job if service "api" drifted in the "prod" environment.
+  /code $ copilot svc drift -a myapp -n api -e prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDriftOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
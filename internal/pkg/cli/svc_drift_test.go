@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDrift_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"prompts for the deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcDriftSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Name: "api", Env: "test"}, nil)
+			},
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"returns error if the service does not exist": {
+			inApp: "phonetool",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to select the deployed service": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcDriftSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcDrift_Execute(t *testing.T) {
+	report := &describe.StackDriftReport{
+		Stacks: []describe.StackDrift{
+			{Name: "phonetool-test-api", Status: "IN_SYNC"},
+			{Name: "phonetool-test-api-AddonsStack-1A2B3C", Status: "DRIFTED"},
+		},
+	}
+	testCases := map[string]struct {
+		initErr    error
+		setupMocks func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns error if fail to initialize the describer": {
+			initErr:     errors.New("some error"),
+			setupMocks:  func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {},
+			wantedError: errors.New("some error"),
+		},
+		"prints the report and returns an error if the addons stack drifted": {
+			setupMocks: func(d *mocks.MockstackDriftDescriber, prog *mocks.Mockprogress) {
+				prog.EXPECT().Start("Detecting drift of service api in environment test.")
+				d.EXPECT().Describe().Return(report, nil)
+				prog.EXPECT().Stop(log.Ssuccessf("Detected drift of service api in environment test.\n"))
+			},
+			wantedContent: report.HumanString(),
+			wantedError:   errors.New("service api in environment test drifted from its deployed CloudFormation template"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := mocks.NewMockstackDriftDescriber(ctrl)
+			prog := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(d, prog)
+			b := &bytes.Buffer{}
+
+			opts := &svcDriftOpts{
+				svcDriftVars: svcDriftVars{
+					appName: "phonetool",
+					envName: "test",
+					svcName: "api",
+				},
+				w:         b,
+				prog:      prog,
+				describer: d,
+				initDriftDescriber: func() error {
+					return tc.initErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -4,9 +4,13 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -20,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -27,6 +32,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -40,8 +46,16 @@ Would you like to install the plugin to execute into the container?`
 See https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html`
 	ssmPluginUpdatePrompt = `Looks like the Session Manager plugin is using version %s.
 Would you like to update it to the latest version %s?`
+
+	// maxConcurrentTaskExecs is the maximum number of tasks that a command runs in at the same time with --all-tasks.
+	maxConcurrentTaskExecs = 10
+	// The ECS ExecuteCommand API does not report the exit code of a command, so the command prints it after a marker.
+	exitCodeMarker = "copilot-exit-code="
 )
 
+// Lines that the Session Manager plugin writes around the output of a session.
+var ssmPluginSessionLinePrefixes = []string{"Starting session with SessionId:", "Exiting session with sessionId:"}
+
 var (
 	errSSMPluginCommandInstallCancelled = errors.New("ssm plugin install cancelled")
 )
@@ -52,9 +66,14 @@ type svcExecOpts struct {
 	sel                deploySelector
 	newSvcDescriber    func(*session.Session) serviceDescriber
 	newCommandExecutor func(*session.Session) ecsCommandExecutor
+	newPortForwarder   func(*session.Session) ecsPortForwarder
 	ssmPluginManager   ssmPluginManager
 	prompter           prompter
 	sessProvider       sessionProvider
+	w                  io.Writer
+	isCommandSet       bool // True if --command is specified, even with the default value.
+	// Creates the version checker of the environment, since port forwarding requires a recent environment manager role.
+	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
 	// Override in unit test
 	randInt func(int) int
 }
@@ -70,7 +89,7 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
-	return &svcExecOpts{
+	opts := &svcExecOpts{
 		execVars: vars,
 		store:    ssmStore,
 		sel:      selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
@@ -80,17 +99,39 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 		newCommandExecutor: func(s *session.Session) ecsCommandExecutor {
 			return awsecs.New(s)
 		},
+		newPortForwarder: func(s *session.Session) ecsPortForwarder {
+			return awsecs.New(s)
+		},
 		randInt: func(x int) int {
 			return rand.Intn(x)
 		},
 		ssmPluginManager: exec.NewSSMPluginCommand(nil),
 		prompter:         prompt.New(),
 		sessProvider:     sessProvider,
-	}, nil
+		w:                log.OutputWriter,
+	}
+	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: ssmStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		return envDescriber, nil
+	}
+	return opts, nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcExecOpts) Validate() error {
+	if err := o.validatePortForward(); err != nil {
+		return err
+	}
+	if err := o.validateAllTasks(); err != nil {
+		return err
+	}
 	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
 }
 
@@ -114,6 +155,11 @@ func (o *svcExecOpts) Execute() error {
 	if wkld.Type == manifestinfo.RequestDrivenWebServiceType {
 		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifestinfo.RequestDrivenWebServiceType)
 	}
+	if o.portForward != "" {
+		if err := o.validatePortForwardEnvCompatible(o.newEnvCompatibilityChecker, "svc exec --port-forward"); err != nil {
+			return err
+		}
+	}
 	sess, err := o.envSession()
 	if err != nil {
 		return err
@@ -122,11 +168,18 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if o.allTasks {
+		return o.execInAllTasks(o.newCommandExecutor(sess), svcDesc.ClusterName, tasks)
+	}
+	taskID, err := o.selectTask(tasks)
 	if err != nil {
 		return err
 	}
 	container := o.selectContainer()
+	if o.portForward != "" {
+		return o.forwardPort(o.newPortForwarder(sess), svcDesc.ClusterName, taskID, container)
+	}
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -144,6 +197,167 @@ func (o *svcExecOpts) Execute() error {
 	return nil
 }
 
+func (o *svcExecOpts) validateAllTasks() error {
+	if !o.allTasks {
+		if o.shouldOutputJSON {
+			return fmt.Errorf("--%s must be specified with --%s", allTasksFlag, jsonFlag)
+		}
+		return nil
+	}
+	if o.taskID != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", taskIDFlag, allTasksFlag)
+	}
+	if o.portForward != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", portForwardFlag, allTasksFlag)
+	}
+	if !o.isCommandSet {
+		return fmt.Errorf("--%s must be specified with --%s", commandFlag, allTasksFlag)
+	}
+	return nil
+}
+
+// execInAllTasks runs the command in every task with bounded concurrency, then writes the output of each task.
+func (o *svcExecOpts) execInAllTasks(executor ecsCommandExecutor, cluster string, tasks []*awsecs.Task) error {
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	container := o.selectContainer()
+	log.Infof("Execute %s in container %s in %d tasks.\n", color.HighlightCode(o.command),
+		color.HighlightUserInput(container), len(tasks))
+	taskIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return err
+		}
+		taskIDs[i] = taskID
+	}
+	results := make([]*taskExecResult, len(tasks))
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentTaskExecs)
+	for i, taskID := range taskIDs {
+		i, taskID := i, taskID
+		g.Go(func() error {
+			results[i] = execInTask(executor, awsecs.ExecuteCommandInput{
+				Cluster:   cluster,
+				Command:   commandWithExitCode(o.command),
+				Container: container,
+				Task:      taskID,
+			})
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if err := o.writeTaskExecResults(results); err != nil {
+		return err
+	}
+	var failed int
+	for _, result := range results {
+		if result.failed() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("command %s failed in %d of %d tasks", o.command, failed, len(results))
+	}
+	return nil
+}
+
+func (o *svcExecOpts) writeTaskExecResults(results []*taskExecResult) error {
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Tasks []*taskExecResult `json:"tasks"`
+		}{Tasks: results})
+		if err != nil {
+			return fmt.Errorf("marshal results to JSON: %w", err)
+		}
+		fmt.Fprintln(o.w, string(data))
+		return nil
+	}
+	for _, result := range results {
+		prefix := fmt.Sprintf("[%s]", result.TaskID)
+		if len(result.TaskID) > shortTaskIDLength {
+			prefix = fmt.Sprintf("[%s]", result.TaskID[:shortTaskIDLength])
+		}
+		if result.Output != "" {
+			for _, line := range strings.Split(result.Output, "\n") {
+				fmt.Fprintf(o.w, "%s %s\n", prefix, line)
+			}
+		}
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(o.w, "%s %s\n", prefix, color.Red.Sprintf("failed: %s", result.Error))
+		case result.ExitCode == nil:
+			fmt.Fprintf(o.w, "%s %s\n", prefix, color.Red.Sprint("exited with an unknown exit code"))
+		case *result.ExitCode != 0:
+			fmt.Fprintf(o.w, "%s %s\n", prefix, color.Red.Sprintf("exited with code %d", *result.ExitCode))
+		default:
+			fmt.Fprintf(o.w, "%s exited with code 0\n", prefix)
+		}
+	}
+	return nil
+}
+
+// taskExecResult is the outcome of running a command in a task.
+type taskExecResult struct {
+	TaskID   string `json:"taskId"`
+	ExitCode *int   `json:"exitCode"` // Nil if the command did not report its exit code.
+	Output   string `json:"output"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (r *taskExecResult) failed() bool {
+	return r.Error != "" || r.ExitCode == nil || *r.ExitCode != 0
+}
+
+func execInTask(executor ecsCommandExecutor, in awsecs.ExecuteCommandInput) *taskExecResult {
+	result := &taskExecResult{
+		TaskID: in.Task,
+	}
+	var out bytes.Buffer
+	err := executor.ExecuteCommandWithOutput(in, &out)
+	result.Output, result.ExitCode = parseTaskExecOutput(out.String())
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// commandWithExitCode wraps the command in a shell that prints the exit code of the command once it completes.
+// The exit code is printed on a new line, since the output of the command may not end with a newline.
+func commandWithExitCode(command string) string {
+	return fmt.Sprintf(`/bin/sh -c '%s; printf "\n%s%%d\n" $?'`, strings.ReplaceAll(command, "'", `'\''`), exitCodeMarker)
+}
+
+// parseTaskExecOutput removes the lines added by the Session Manager plugin and commandWithExitCode from the output,
+// and returns the exit code of the command if it was reported.
+func parseTaskExecOutput(raw string) (output string, exitCode *int) {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, exitCodeMarker) {
+			if code, err := strconv.Atoi(strings.TrimPrefix(line, exitCodeMarker)); err == nil {
+				exitCode = &code
+			}
+			continue
+		}
+		if isSSMPluginSessionLine(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n"), exitCode
+}
+
+func isSSMPluginSessionLine(line string) bool {
+	for _, prefix := range ssmPluginSessionLinePrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *svcExecOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
@@ -282,7 +496,13 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Runs the 'cat /proc/meminfo' command in every running task of the "backend" service.
+  /code $ copilot svc exec -a my-app -e test --name backend --all-tasks --command "cat /proc/meminfo"
+  Forward local port 8080 to port 80 of the "frontend" service's container.
+  /code $ copilot svc exec -a my-app -e test -n frontend --port-forward 8080:80
+  Reach a private database from your machine through a task of the "backend" service.
+  /code $ copilot svc exec -a my-app -e test -n backend --port-forward 5432:5432 --remote-host mydb.cluster-abc.us-west-2.rds.amazonaws.com`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
@@ -294,6 +514,7 @@ func buildSvcExecCmd() *cobra.Command {
 					opts.skipConfirmation = aws.Bool(true)
 				}
 			}
+			opts.isCommandSet = cmd.Flags().Changed(commandFlag)
 			return run(opts)
 		}),
 	}
@@ -303,6 +524,10 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.portForward, portForwardFlag, "", portForwardFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
+	cmd.Flags().BoolVar(&vars.allTasks, allTasksFlag, false, allTasksFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, execJSONFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
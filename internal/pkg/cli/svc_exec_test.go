@@ -4,8 +4,10 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,8 +28,10 @@ type execSvcMocks struct {
 	sel                *mocks.MockdeploySelector
 	ecsSvcDescriber    *mocks.MockserviceDescriber
 	ecsCommandExecutor *mocks.MockecsCommandExecutor
+	ecsPortForwarder   *mocks.MockecsPortForwarder
 	ssmPluginManager   *mocks.MockssmPluginManager
 	prompter           *mocks.Mockprompter
+	envChecker         *mocks.MockversionCompatibilityChecker
 }
 
 func TestSvcExec_Validate(t *testing.T) {
@@ -41,11 +45,81 @@ func TestSvcExec_Validate(t *testing.T) {
 		inputApp         string
 		inputEnv         string
 		inputSvc         string
+		inputPortForward string
+		inputRemoteHost  string
+		inputCommand     string
+		inputCommandSet  bool
+		inputTaskID      string
+		inputAllTasks    bool
+		inputJSON        bool
 		skipConfirmation *bool
 		setupMocks       func(mocks execSvcMocks)
 
 		wantedError error
 	}{
+		"should error if remote host is specified without port forwarding": {
+			inputApp:        mockApp,
+			inputRemoteHost: "mydb.example.com",
+			setupMocks:      func(m execSvcMocks) {},
+
+			wantedError: errors.New("--port-forward must be specified with --remote-host"),
+		},
+		"should error if port forwarding is not formatted as local:remote": {
+			inputApp:         mockApp,
+			inputPortForward: "8080",
+			setupMocks:       func(m execSvcMocks) {},
+
+			wantedError: errors.New(`--port-forward "8080" must be formatted as "<local port>:<remote port>"`),
+		},
+		"should error if a forwarded port is out of range": {
+			inputApp:         mockApp,
+			inputPortForward: "8080:70000",
+			setupMocks:       func(m execSvcMocks) {},
+
+			wantedError: errors.New(`invalid port "70000" in --port-forward: must be a number between 1 and 65535`),
+		},
+		"should error if json output is requested without all tasks": {
+			inputApp:   mockApp,
+			inputJSON:  true,
+			setupMocks: func(m execSvcMocks) {},
+
+			wantedError: errors.New("--all-tasks must be specified with --json"),
+		},
+		"should error if all tasks is specified with a task ID": {
+			inputApp:      mockApp,
+			inputCommand:  "ls",
+			inputTaskID:   "8c38184",
+			inputAllTasks: true,
+			setupMocks:    func(m execSvcMocks) {},
+
+			wantedError: errors.New("cannot specify both --task-id and --all-tasks"),
+		},
+		"should error if all tasks is specified with port forwarding": {
+			inputApp:         mockApp,
+			inputCommand:     "ls",
+			inputPortForward: "8080:80",
+			inputAllTasks:    true,
+			setupMocks:       func(m execSvcMocks) {},
+
+			wantedError: errors.New("cannot specify both --port-forward and --all-tasks"),
+		},
+		"should error if all tasks is specified without a command": {
+			inputApp:      mockApp,
+			inputCommand:  defaultCommand,
+			inputAllTasks: true,
+			setupMocks:    func(m execSvcMocks) {},
+
+			wantedError: errors.New("--command must be specified with --all-tasks"),
+		},
+		"allows all tasks with a command that is the same as the default": {
+			inputApp:        mockApp,
+			inputCommand:    defaultCommand,
+			inputCommandSet: true,
+			inputAllTasks:   true,
+			setupMocks: func(m execSvcMocks) {
+				m.ssmPluginManager.EXPECT().ValidateBinary().Return(nil)
+			},
+		},
 		"skip without installing/updating if yes flag is set to be false": {
 			inputApp:         mockApp,
 			inputEnv:         mockEnv,
@@ -230,11 +304,18 @@ func TestSvcExec_Validate(t *testing.T) {
 					name:             tc.inputSvc,
 					appName:          tc.inputApp,
 					envName:          tc.inputEnv,
+					portForward:      tc.inputPortForward,
+					remoteHost:       tc.inputRemoteHost,
+					command:          tc.inputCommand,
+					taskID:           tc.inputTaskID,
+					allTasks:         tc.inputAllTasks,
+					shouldOutputJSON: tc.inputJSON,
 					skipConfirmation: tc.skipConfirmation,
 				},
 				store:            mockStoreReader,
 				ssmPluginManager: mockSSMPluginManager,
 				prompter:         mockPrompter,
+				isCommandSet:     tc.inputCommandSet,
 			}
 
 			// WHEN
@@ -415,6 +496,8 @@ func TestSvcExec_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		containerName string
 		taskID        string
+		portForward   string
+		remoteHost    string
 		setupMocks    func(mocks execSvcMocks)
 
 		wantedError error
@@ -537,6 +620,83 @@ func TestSvcExec_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("execute command mockCommand in container hello: some error"),
 		},
+		"return error if the environment can't forward ports": {
+			portForward: "15432:5432",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.envChecker.EXPECT().Version().Return("v1.17.0", nil),
+				)
+			},
+			wantedError: fmt.Errorf(`environment "mockEnv" is on version "v1.17.0" which does not support the "svc exec --port-forward" feature`),
+		},
+		"return error if fail to forward the port": {
+			portForward: "15432:5432",
+			remoteHost:  "mydb.example.com",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.envChecker.EXPECT().Version().Return("v1.18.0", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+						},
+					}, nil),
+					m.ecsPortForwarder.EXPECT().ForwardPort(awsecs.ForwardPortInput{
+						Cluster:    "mockCluster",
+						Task:       "mockTaskID",
+						Container:  "mockSvc",
+						LocalPort:  "15432",
+						RemotePort: "5432",
+						RemoteHost: "mydb.example.com",
+					}).Return(mockError),
+				)
+			},
+			wantedError: fmt.Errorf("forward local port 15432 to container mockSvc: some error"),
+		},
+		"forward a local port to a container instead of executing the command": {
+			containerName: "sidecar",
+			taskID:        "mockTaskID1",
+			portForward:   "8080:80",
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.envChecker.EXPECT().Version().Return("v1.18.0", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+							{
+								TaskArn:    aws.String(mockOtherTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+						},
+					}, nil),
+					m.ecsPortForwarder.EXPECT().ForwardPort(awsecs.ForwardPortInput{
+						Cluster:    "mockCluster",
+						Task:       "mockTaskID1",
+						Container:  "sidecar",
+						LocalPort:  "8080",
+						RemotePort: "80",
+					}).Return(nil),
+				)
+			},
+		},
 		"success": {
 			setupMocks: func(m execSvcMocks) {
 				gomock.InOrder(
@@ -581,7 +741,9 @@ func TestSvcExec_Execute(t *testing.T) {
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			mockPortForwarder := mocks.NewMockecsPortForwarder(ctrl)
 			mockSessionProvider := mocks.NewMocksessionProvider(ctrl)
+			mockEnvChecker := mocks.NewMockversionCompatibilityChecker(ctrl)
 			mockNewSvcDescriber := func(_ *session.Session) serviceDescriber {
 				return mockSvcDescriber
 			}
@@ -592,8 +754,10 @@ func TestSvcExec_Execute(t *testing.T) {
 			mocks := execSvcMocks{
 				storeSvc:           mockStoreReader,
 				ecsCommandExecutor: mockCommandExecutor,
+				ecsPortForwarder:   mockPortForwarder,
 				ecsSvcDescriber:    mockSvcDescriber,
 				sessProvider:       mockSessionProvider,
+				envChecker:         mockEnvChecker,
 			}
 
 			tc.setupMocks(mocks)
@@ -606,12 +770,20 @@ func TestSvcExec_Execute(t *testing.T) {
 					command:       "mockCommand",
 					containerName: tc.containerName,
 					taskID:        tc.taskID,
+					portForward:   tc.portForward,
+					remoteHost:    tc.remoteHost,
 				},
 				store:              mockStoreReader,
 				newSvcDescriber:    mockNewSvcDescriber,
 				newCommandExecutor: mockNewCommandExecutor,
-				randInt:            func(i int) int { return 0 },
-				sessProvider:       mockSessionProvider,
+				newPortForwarder: func(_ *session.Session) ecsPortForwarder {
+					return mockPortForwarder
+				},
+				randInt:      func(i int) int { return 0 },
+				sessProvider: mockSessionProvider,
+				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
+					return mockEnvChecker, nil
+				},
 			}
 
 			// WHEN
@@ -626,3 +798,170 @@ func TestSvcExec_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcExec_ExecuteAllTasks(t *testing.T) {
+	const (
+		mockTaskARN      = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+		mockOtherTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/8c38184f0a8e4b0d9f4e8f3d1c2b3a4e"
+		wrappedCommand   = `/bin/sh -c 'kill -USR1 1; printf "\ncopilot-exit-code=%d\n" $?'`
+	)
+	mockTasks := []*awsecs.Task{
+		{
+			TaskArn:    aws.String(mockTaskARN),
+			LastStatus: aws.String("RUNNING"),
+		},
+		{
+			TaskArn:    aws.String(mockOtherTaskARN),
+			LastStatus: aws.String("RUNNING"),
+		},
+		{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/stopped"),
+			LastStatus: aws.String("STOPPED"),
+		},
+	}
+	writeOutput := func(output string, err error) func(awsecs.ExecuteCommandInput, io.Writer) error {
+		return func(_ awsecs.ExecuteCommandInput, w io.Writer) error {
+			_, _ = w.Write([]byte(output))
+			return err
+		}
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		inTasks    []*awsecs.Task
+		setupMocks func(m *mocks.MockecsCommandExecutor)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"return error if there are no running tasks": {
+			inTasks:     []*awsecs.Task{},
+			setupMocks:  func(m *mocks.MockecsCommandExecutor) {},
+			wantedError: errors.New("found no running task for service mockSvc in environment mockEnv"),
+		},
+		"collects the output of every task": {
+			inTasks: mockTasks,
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommandWithOutput(awsecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Command:   wrappedCommand,
+					Container: "mockSvc",
+					Task:      "4082490ee6c245e09d2145010aa1ba8d",
+				}, gomock.Any()).DoAndReturn(writeOutput("\r\nStarting session with SessionId: ecs-execute-command-1\r\nsignal sent\r\ncopilot-exit-code=0\r\n\r\n\r\nExiting session with sessionId: ecs-execute-command-1.\r\n\r\n", nil))
+				m.EXPECT().ExecuteCommandWithOutput(awsecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Command:   wrappedCommand,
+					Container: "mockSvc",
+					Task:      "8c38184f0a8e4b0d9f4e8f3d1c2b3a4e",
+				}, gomock.Any()).DoAndReturn(writeOutput("signal sent\n", nil))
+			},
+			wantedOutput: `[4082490e] signal sent
+[4082490e] exited with code 0
+[8c38184f] signal sent
+[8c38184f] exited with an unknown exit code
+`,
+			wantedError: errors.New("command kill -USR1 1 failed in 1 of 2 tasks"),
+		},
+		"outputs the results in JSON": {
+			inJSON:  true,
+			inTasks: mockTasks,
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommandWithOutput(gomock.Any(), gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput, w io.Writer) error {
+					if in.Task == "4082490ee6c245e09d2145010aa1ba8d" {
+						_, _ = w.Write([]byte("copilot-exit-code=0\n"))
+						return nil
+					}
+					_, _ = w.Write([]byte("no such process\ncopilot-exit-code=1\n"))
+					return nil
+				}).Times(2)
+			},
+			wantedOutput: `{"tasks":[{"taskId":"4082490ee6c245e09d2145010aa1ba8d","exitCode":0,"output":""},{"taskId":"8c38184f0a8e4b0d9f4e8f3d1c2b3a4e","exitCode":1,"output":"no such process"}]}
+`,
+			wantedError: errors.New("command kill -USR1 1 failed in 1 of 2 tasks"),
+		},
+		"reports tasks that cannot be executed into": {
+			inTasks: mockTasks[:1],
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommandWithOutput(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedOutput: `[4082490e] failed: some error
+`,
+			wantedError: errors.New("command kill -USR1 1 failed in 1 of 1 tasks"),
+		},
+		"reports the exit code of a command whose output does not end with a newline": {
+			inTasks: mockTasks[:1],
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommandWithOutput(gomock.Any(), gomock.Any()).DoAndReturn(writeOutput("ok\ncopilot-exit-code=3\n", nil))
+			},
+			wantedOutput: `[4082490e] ok
+[4082490e] exited with code 3
+`,
+			wantedError: errors.New("command kill -USR1 1 failed in 1 of 1 tasks"),
+		},
+		"success": {
+			inTasks: mockTasks[:1],
+			setupMocks: func(m *mocks.MockecsCommandExecutor) {
+				m.EXPECT().ExecuteCommandWithOutput(gomock.Any(), gomock.Any()).DoAndReturn(writeOutput("copilot-exit-code=0\n", nil))
+			},
+			wantedOutput: `[4082490e] exited with code 0
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			mockSessionProvider := mocks.NewMocksessionProvider(ctrl)
+			mockStore.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&config.Workload{
+				Type: "Backend Service",
+			}, nil)
+			mockStore.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+			mockSessionProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+				ClusterName: "mockCluster",
+				Tasks:       tc.inTasks,
+			}, nil)
+			tc.setupMocks(mockCommandExecutor)
+			buf := &bytes.Buffer{}
+
+			opts := &svcExecOpts{
+				execVars: execVars{
+					name:             "mockSvc",
+					envName:          "mockEnv",
+					appName:          "mockApp",
+					command:          "kill -USR1 1",
+					allTasks:         true,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: mockStore,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return mockSvcDescriber
+				},
+				newCommandExecutor: func(_ *session.Session) ecsCommandExecutor {
+					return mockCommandExecutor
+				},
+				sessProvider: mockSessionProvider,
+				w:            buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
+
+func TestCommandWithExitCode(t *testing.T) {
+	require.Equal(t, `/bin/sh -c 'echo '\''hi'\''; printf "\ncopilot-exit-code=%d\n" $?'`, commandWithExitCode("echo 'hi'"))
+}
@@ -53,6 +53,9 @@ type wkldLogsVars struct {
 
 	taskIDs []string
 	since   time.Duration
+
+	filterPattern string
+	query         string
 }
 
 type svcLogsVars struct {
@@ -185,6 +188,17 @@ func (o *svcLogsOpts) Validate() error {
 			return err
 		}
 	}
+	if err := o.validateFilters(); err != nil {
+		return err
+	}
+	if o.query != "" {
+		if o.previous {
+			return fmt.Errorf("cannot specify both --%s and --%s", logsQueryFlag, previousFlag)
+		}
+		if o.containerName != "" {
+			return fmt.Errorf("cannot specify both --%s and --%s", logsQueryFlag, containerLogFlag)
+		}
+	}
 	return nil
 }
 
@@ -230,6 +244,8 @@ func (o *svcLogsOpts) Execute() error {
 		OnEvents:      eventsWriter,
 		ContainerName: o.containerName,
 		LogGroup:      o.logGroup,
+		FilterPattern: o.filterPattern,
+		Query:         o.query,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for service %s: %w", o.name, err)
@@ -302,6 +318,24 @@ func (o *svcLogsOpts) validatePrevious() error {
 	return nil
 }
 
+// validateFilters returns an error if the server-side filtering flags are combined with incompatible flags.
+// A query searches the whole log group once, so it can't be followed or limited to specific tasks.
+func (v wkldLogsVars) validateFilters() error {
+	if v.query == "" {
+		return nil
+	}
+	if v.filterPattern != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", filterPatternFlag, logsQueryFlag)
+	}
+	if v.follow {
+		return fmt.Errorf("cannot specify both --%s and --%s", followFlag, logsQueryFlag)
+	}
+	if len(v.taskIDs) != 0 {
+		return fmt.Errorf("cannot specify both --%s and --%s", tasksFlag, logsQueryFlag)
+	}
+	return nil
+}
+
 func (o *svcLogsOpts) getTargetEnv() (*config.Environment, error) {
 	if o.targetEnv != nil {
 		return o.targetEnv, nil
@@ -347,7 +381,11 @@ func buildSvcLogsCmd() *cobra.Command {
   Displays logs in real time.
   /code $ copilot svc logs --follow
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays logs that contain "ERROR" in the last hour.
+  /code $ copilot svc logs --filter-pattern ERROR --since 1h
+  Counts the log events that contain "ERROR" in 5-minute intervals over the last day.
+  /code $ copilot svc logs --since 24h --query "filter @message like /ERROR/ | stats count(*) by bin(5m)"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -369,5 +407,7 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
 	cmd.Flags().BoolVarP(&vars.previous, previousFlag, previousFlagShort, false, previousFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerLogFlag, "", containerLogFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, filterPatternFlag, "", filterPatternFlagDescription)
+	cmd.Flags().StringVar(&vars.query, logsQueryFlag, "", logsQueryFlagDescription)
 	return cmd
 }
@@ -50,6 +50,9 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputSince     time.Duration
 		inputPrevious  bool
 		inputTaskIDs   []string
+		inputFilter    string
+		inputQuery     string
+		inputContainer string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -117,6 +120,44 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("cannot specify both --previous and --tasks"),
 		},
+		"returns error if both filter pattern and query flags are defined": {
+			inputFilter: "ERROR",
+			inputQuery:  "stats count(*)",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --filter-pattern and --query"),
+		},
+		"returns error if both follow and query flags are defined": {
+			inputFollow: true,
+			inputQuery:  "stats count(*)",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --follow and --query"),
+		},
+		"returns error if both tasks and query flags are defined": {
+			inputTaskIDs: []string{"taskId"},
+			inputQuery:   "stats count(*)",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --tasks and --query"),
+		},
+		"returns error if both container and query flags are defined": {
+			inputContainer: "sidecar",
+			inputQuery:     "stats count(*)",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("cannot specify both --query and --container"),
+		},
+		"with a filter pattern and follow": {
+			inputFilter: "ERROR",
+			inputFollow: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -139,8 +180,11 @@ func TestSvcLogs_Validate(t *testing.T) {
 						name:           tc.inputSvc,
 						appName:        tc.inputApp,
 						taskIDs:        tc.inputTaskIDs,
+						filterPattern:  tc.inputFilter,
+						query:          tc.inputQuery,
 					},
-					previous: tc.inputPrevious,
+					previous:      tc.inputPrevious,
+					containerName: tc.inputContainer,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -332,6 +376,8 @@ func TestSvcLogs_Execute(t *testing.T) {
 		inputPreviousTask bool
 		container         string
 		logGroup          string
+		filterPattern     string
+		query             string
 
 		setupMocks func(mocks wkldLogsMock)
 
@@ -399,6 +445,17 @@ func TestSvcLogs_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"success with server-side filtering": {
+			inputSvc:      "mockSvc",
+			filterPattern: "ERROR",
+			query:         "stats count(*)",
+			setupMocks: func(m wkldLogsMock) {
+				m.logSvcWriter.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, "ERROR", param.FilterPattern)
+					require.Equal(t, "stats count(*)", param.Query)
+				}).Return(nil)
+			},
+		},
 		"returns error if fail to get event logs": {
 			inputSvc: "mockSvc",
 			setupMocks: func(m wkldLogsMock) {
@@ -510,6 +567,9 @@ func TestSvcLogs_Execute(t *testing.T) {
 						follow:  tc.follow,
 						limit:   tc.limit,
 						taskIDs: tc.taskIDs,
+
+						filterPattern: tc.filterPattern,
+						query:         tc.query,
 					},
 					previous:      tc.inputPreviousTask,
 					containerName: tc.container,
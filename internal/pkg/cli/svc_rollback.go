@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackNamePrompt     = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt = "The selected service will be redeployed with a previous revision."
+
+	fmtSvcRollbackConfirmPrompt = "Are you sure you want to roll back service %s in environment %s to revision %d?"
+)
+
+type svcRollbackVars struct {
+	name             string
+	envName          string
+	appName          string
+	revision         int
+	skipConfirmation bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store        store
+	prompt       prompter
+	sel          deploySelector
+	revisions    revisionStore
+	deployer     serviceStackDeployer
+	initRollback func(*svcRollbackOpts) error // Overridden in tests.
+
+	// Cached variables.
+	targetEnv *config.Environment
+	bucket    string // Bucket where the templates of the environment's stacks are uploaded.
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		prompt:          prompt.New(),
+		sel:             selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initRollback: func(o *svcRollbackOpts) error {
+			env, err := o.getTargetEnv()
+			if err != nil {
+				return err
+			}
+			bucket, err := appBucket(defaultSess, configStore, o.appName, env)
+			if err != nil {
+				return err
+			}
+			envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("create session from role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.bucket = bucket
+			o.revisions = revision.NewStore(s3.New(envSess), bucket)
+			o.deployer = cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr))
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcRollbackOpts) Validate() error {
+	if o.revision <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", toRevisionFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcRollbackConfirmPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), o.revision), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc rollback confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("svc rollback cancelled - no changes made")
+	}
+	return nil
+}
+
+// Execute redeploys the template, parameters and images of a recorded revision of the service.
+func (o *svcRollbackOpts) Execute() error {
+	if err := o.initRollback(o); err != nil {
+		return err
+	}
+	rev, err := o.revisions.Get(o.envName, o.name, o.revision)
+	if err != nil {
+		return fmt.Errorf("get the revision to roll back to: %w", err)
+	}
+	conf := rev.Stack(stack.NameForService(o.appName, o.envName, o.name))
+	err = o.deployer.DeployService(conf, o.bucket, awscloudformation.WithRoleARN(o.targetEnv.ExecutionRoleARN))
+	if err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyCS) {
+			return fmt.Errorf("roll back service %s to revision %d: %w", o.name, o.revision, err)
+		}
+		log.Infof("Service %s in environment %s is already deployed with revision %d.\n", o.name, o.envName, o.revision)
+		return nil
+	}
+	rev.RolledBack = rev.Number
+	if err := o.revisions.Record(o.envName, o.name, rev); err != nil {
+		log.Warningf("Failed to record the rollback of %s: %v\n", o.name, err)
+	}
+	log.Successf("Rolled back service %s in environment %s to revision %d.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), o.revision)
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to check the health of the service.", color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.name, o.envName))),
+		fmt.Sprintf("Run %s to deploy the latest version of the service once it's fixed.", color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.envName))),
+	})
+	return nil
+}
+
+func (o *svcRollbackOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcRollbackOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment: %w", err)
+	}
+	o.targetEnv = env
+	return o.targetEnv, nil
+}
+
+// appBucket returns the name of the application's bucket in the region of the environment.
+func appBucket(sess *session.Session, configStore store, appName string, env *config.Environment) (string, error) {
+	app, err := configStore.GetApplication(appName)
+	if err != nil {
+		return "", fmt.Errorf("get application %s: %w", appName, err)
+	}
+	resources, err := cloudformation.New(sess).GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return "", fmt.Errorf("get application %s resources from region %s: %w", appName, env.Region, err)
+	}
+	return resources.S3Bucket, nil
+}
+
+// buildSvcRollbackCmd builds the command for redeploying a previous revision of a service.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Redeploys a previous revision of a deployed service.",
+		Long: `Redeploys a previous revision of a deployed service.
+The template, parameters and container images recorded for the revision are deployed as is,
+without building or pushing any image.`,
+		Example: `
+  Lists the revisions of the service "my-svc" in environment "prod".
+  /code $ copilot svc deployments -n my-svc -e prod
+  Rolls back the service to revision 4.
+  /code $ copilot svc rollback -n my-svc -e prod --to 4`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.revision, toRevisionFlag, 0, toRevisionFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRollback_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputRevision int
+
+		wantedError error
+	}{
+		"errors if --to is not set": {
+			wantedError: errors.New("--to must be greater than 0"),
+		},
+		"success": {
+			inputRevision: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					revision: tc.inputRevision,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcRollbackAskMock struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockdeploySelector
+	prompt *mocks.Mockprompter
+}
+
+func TestSvcRollback_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp              string
+		inputSvc              string
+		inputEnv              string
+		inputSkipConfirmation bool
+
+		setupMocks func(m svcRollbackAskMock)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"validate app env and service with all flags passed in": {
+			inputApp:              "phonetool",
+			inputSvc:              "api",
+			inputEnv:              "test",
+			inputSkipConfirmation: true,
+			setupMocks: func(m svcRollbackAskMock) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"prompt for app, env and service and confirm the rollback": {
+			setupMocks: func(m svcRollbackAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+			setupMocks: func(m svcRollbackAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"errors if the rollback is not confirmed": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			inputEnv: "test",
+			setupMocks: func(m svcRollbackAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errors.New("svc rollback cancelled - no changes made"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackAskMock{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockdeploySelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:          tc.inputApp,
+					envName:          tc.inputEnv,
+					name:             tc.inputSvc,
+					revision:         1,
+					skipConfirmation: tc.inputSkipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+		})
+	}
+}
+
+type svcRollbackExecuteMocks struct {
+	revisions *mocks.MockrevisionStore
+	deployer  *mocks.MockserviceStackDeployer
+}
+
+func TestSvcRollback_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockRevision := func() *revision.Revision {
+		return &revision.Revision{
+			Number:     2,
+			Template:   "Resources: {}",
+			Parameters: map[string]string{"EnvName": "test"},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcRollbackExecuteMocks)
+
+		wantedError error
+	}{
+		"errors if failed to get the revision": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get("test", "api", 2).Return(nil, &revision.ErrNotFound{Workload: "api", Env: "test", Number: 2})
+			},
+			wantedError: errors.New("get the revision to roll back to: revision 2 of api in environment test not found"),
+		},
+		"errors if failed to deploy the revision": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get("test", "api", 2).Return(mockRevision(), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(mockError)
+			},
+			wantedError: errors.New("roll back service api to revision 2: some error"),
+		},
+		"does not record a revision if the service is already deployed with the revision": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get("test", "api", 2).Return(mockRevision(), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(&awscloudformation.ErrChangeSetEmpty{})
+			},
+		},
+		"records the rollback as a new revision": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get("test", "api", 2).Return(mockRevision(), nil)
+				m.deployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
+					DoAndReturn(func(conf interface{ StackName() string }, _ string, _ ...awscloudformation.StackOption) error {
+						require.Equal(t, "phonetool-test-api", conf.StackName())
+						return nil
+					})
+				rolledBack := mockRevision()
+				rolledBack.RolledBack = 2
+				m.revisions.EXPECT().Record("test", "api", rolledBack).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackExecuteMocks{
+				revisions: mocks.NewMockrevisionStore(ctrl),
+				deployer:  mocks.NewMockserviceStackDeployer(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:  "phonetool",
+					envName:  "test",
+					name:     "api",
+					revision: 2,
+				},
+				initRollback: func(o *svcRollbackOpts) error {
+					o.targetEnv = &config.Environment{
+						Name:             "test",
+						ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+					}
+					o.bucket = "mockBucket"
+					o.revisions = m.revisions
+					o.deployer = m.deployer
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,598 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcScaleAppNamePrompt     = "Which application is the service in?"
+	svcScaleNamePrompt        = "Which service of %s would you like to scale?"
+	svcScaleSvcNameHelpPrompt = "The selected service will be scaled in the environment it is deployed to."
+
+	fmtSvcScaleCountStart   = "Scaling service %s in environment %s to %d tasks."
+	fmtSvcScaleRangeStart   = "Scaling service %s in environment %s between %d and %d tasks."
+	fmtSvcScaleRDWSStart    = "Scaling service %s in environment %s between %d and %d instances."
+	fmtSvcScaleFailed       = "Failed to scale service %s in environment %s.\n"
+	fmtSvcScaleCountSucceed = "Service %s in environment %s is now running %d tasks.\n"
+	fmtSvcScaleRangeSucceed = "Service %s in environment %s now scales between %d and %d tasks.\n"
+	fmtSvcScaleRDWSSucceed  = "Service %s in environment %s now scales between %d and %d instances.\n"
+
+	// App Runner auto scaling configuration names must be between 4 and 32 characters.
+	maxAutoScalingConfigNameLength = 32
+	fmtAutoScalingConfigName       = "%s-%s-%s"
+	fmtAutoScalingConfigRef        = "%s/%d"
+
+	// The environment manager role can update the desired count, scalable targets and App Runner auto scaling configurations.
+	minEnvVersionForSvcScale = "v1.19.0"
+)
+
+type svcScaleVars struct {
+	appName       string
+	envName       string
+	svcName       string
+	count         int64
+	minCount      int64
+	maxCount      int64
+	writeManifest bool
+}
+
+type svcScaleOpts struct {
+	svcScaleVars
+
+	// Values of --count, --min and --max, nil if the flag is not specified.
+	desiredCount *int64
+	minCapacity  *int64
+	maxCapacity  *int64
+
+	store         store
+	sel           deploySelector
+	ws            wsWorkloadManifestOverwriter
+	prog          progress
+	initClients   func() error
+	ecsScaler     ecsServiceScaler
+	targetUpdater ecsScalableTargetUpdater
+	rdwsUpdater   apprunnerAutoScalingUpdater
+
+	// cached variables.
+	targetEnv *config.Environment
+	wkldType  string
+	svcARN    string
+	// Writes the new values to the environment override of the manifest.
+	updateManifest func(mft []byte) ([]byte, error)
+}
+
+func newSvcScaleOpts(vars svcScaleVars) (*svcScaleOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc scale"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcScaleOpts{
+		svcScaleVars: vars,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	opts.initClients = func() error {
+		env, err := opts.getTargetEnv()
+		if err != nil {
+			return err
+		}
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		if err := validateMinEnvVersion(nil, envDescriber, opts.appName, opts.envName, minEnvVersionForSvcScale, "svc scale"); err != nil {
+			return err
+		}
+		wl, err := configStore.GetWorkload(opts.appName, opts.svcName)
+		if err != nil {
+			return fmt.Errorf("get workload: %w", err)
+		}
+		opts.wkldType = wl.Type
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		if opts.wkldType != manifestinfo.RequestDrivenWebServiceType {
+			opts.ecsScaler = ecs.New(sess)
+			opts.targetUpdater = aas.New(sess)
+			return nil
+		}
+		opts.rdwsUpdater = apprunner.New(sess)
+		d, err := describe.NewRDWebServiceDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Svc:         opts.svcName,
+			ConfigStore: opts.store,
+		})
+		if err != nil {
+			return err
+		}
+		opts.svcARN, err = d.ServiceARN(opts.envName)
+		if err != nil {
+			return fmt.Errorf("retrieve ServiceARN for %s: %w", opts.svcName, err)
+		}
+		return nil
+	}
+	if vars.writeManifest {
+		ws, err := workspace.Use(afero.NewOsFs())
+		if err != nil {
+			return nil, err
+		}
+		opts.ws = ws
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcScaleOpts) Validate() error {
+	if o.desiredCount == nil && o.minCapacity == nil && o.maxCapacity == nil {
+		return fmt.Errorf("must specify --%s, or --%s and --%s", countFlag, minCountFlag, maxCountFlag)
+	}
+	if o.desiredCount != nil && (o.minCapacity != nil || o.maxCapacity != nil) {
+		return fmt.Errorf("cannot specify --%s together with --%s or --%s", countFlag, minCountFlag, maxCountFlag)
+	}
+	for _, flag := range []struct {
+		name string
+		val  *int64
+	}{
+		{name: countFlag, val: o.desiredCount},
+		{name: minCountFlag, val: o.minCapacity},
+		{name: maxCountFlag, val: o.maxCapacity},
+	} {
+		if flag.val != nil && aws.Int64Value(flag.val) < 0 {
+			return fmt.Errorf("--%s must be greater than or equal to 0", flag.name)
+		}
+	}
+	if o.minCapacity != nil && o.maxCapacity != nil && aws.Int64Value(o.minCapacity) > aws.Int64Value(o.maxCapacity) {
+		return fmt.Errorf("--%s %d must be less than or equal to --%s %d", minCountFlag, aws.Int64Value(o.minCapacity), maxCountFlag, aws.Int64Value(o.maxCapacity))
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcScaleOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+func (o *svcScaleOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcScaleAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcScaleOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcScaleNamePrompt, color.HighlightUserInput(o.appName)),
+		svcScaleSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter([]string{
+			manifestinfo.LoadBalancedWebServiceType,
+			manifestinfo.BackendServiceType,
+			manifestinfo.WorkerServiceType,
+			manifestinfo.RequestDrivenWebServiceType,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute changes the desired count or the auto scaling range of the deployed service.
+func (o *svcScaleOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	var err error
+	switch {
+	case o.wkldType == manifestinfo.RequestDrivenWebServiceType:
+		err = o.scaleRDWS()
+	case o.desiredCount != nil:
+		err = o.scaleECSServiceCount()
+	default:
+		err = o.scaleECSServiceRange()
+	}
+	if err != nil {
+		return err
+	}
+	if !o.writeManifest {
+		log.Warningf("The manifest of service %s no longer matches what is running in environment %s.\nUpdate the manifest, or run with %s, so that the next deployment does not revert these values.\n",
+			o.svcName, o.envName, color.HighlightCode(fmt.Sprintf("--%s", writeManifestFlag)))
+		return nil
+	}
+	path, err := o.writeManifestCount()
+	if err != nil {
+		return err
+	}
+	log.Successf("Wrote the new values to the %s environment override in %s.\n", o.envName, color.HighlightResource(displayPath(path)))
+	return nil
+}
+
+func (o *svcScaleOpts) scaleECSServiceCount() error {
+	count := aws.Int64Value(o.desiredCount)
+	cluster, service, err := o.ecsServiceNames()
+	if err != nil {
+		return err
+	}
+	target, err := o.targetUpdater.ECSServiceScalableTarget(cluster, service)
+	if err == nil {
+		return fmt.Errorf("service %s in environment %s scales automatically between %d and %d tasks: use --%s and --%s to change its range instead",
+			o.svcName, o.envName, target.MinCapacity, target.MaxCapacity, minCountFlag, maxCountFlag)
+	}
+	var errNotFound *aas.ErrScalableTargetNotFound
+	if !errors.As(err, &errNotFound) {
+		return fmt.Errorf("get auto scaling range of service %s: %w", o.svcName, err)
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcScaleCountStart, o.svcName, o.envName, count))
+	if err := o.ecsScaler.UpdateServiceDesiredCount(o.appName, o.envName, o.svcName, count); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcScaleFailed, o.svcName, o.envName))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcScaleCountSucceed, o.svcName, o.envName, count))
+	o.updateManifest = func(mft []byte) ([]byte, error) {
+		return setEnvOverrideCount(mft, o.envName, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!int",
+			Value: strconv.FormatInt(count, 10),
+		})
+	}
+	return nil
+}
+
+func (o *svcScaleOpts) scaleECSServiceRange() error {
+	cluster, service, err := o.ecsServiceNames()
+	if err != nil {
+		return err
+	}
+	current, err := o.targetUpdater.ECSServiceScalableTarget(cluster, service)
+	if err != nil {
+		var errNotFound *aas.ErrScalableTargetNotFound
+		if errors.As(err, &errNotFound) {
+			return fmt.Errorf("service %s in environment %s does not scale automatically: use --%s to change its desired count instead",
+				o.svcName, o.envName, countFlag)
+		}
+		return fmt.Errorf("get auto scaling range of service %s: %w", o.svcName, err)
+	}
+	minCap, maxCap, err := o.capacityRange(current.MinCapacity, current.MaxCapacity)
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcScaleRangeStart, o.svcName, o.envName, minCap, maxCap))
+	if err := o.targetUpdater.UpdateECSServiceScalableTarget(cluster, service, aas.ScalableTarget{
+		MinCapacity: minCap,
+		MaxCapacity: maxCap,
+	}); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcScaleFailed, o.svcName, o.envName))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcScaleRangeSucceed, o.svcName, o.envName, minCap, maxCap))
+	o.updateManifest = func(mft []byte) ([]byte, error) {
+		return setEnvOverrideCountRange(mft, o.envName, minCap, maxCap)
+	}
+	return nil
+}
+
+func (o *svcScaleOpts) scaleRDWS() error {
+	if o.desiredCount != nil {
+		return fmt.Errorf("--%s is not supported for %ss: use --%s and --%s to change the number of instances instead",
+			countFlag, manifestinfo.RequestDrivenWebServiceType, minCountFlag, maxCountFlag)
+	}
+	current, err := o.rdwsUpdater.ServiceAutoScalingConfiguration(o.svcARN)
+	if err != nil {
+		return fmt.Errorf("get auto scaling configuration of service %s: %w", o.svcName, err)
+	}
+	minSize, maxSize, err := o.capacityRange(current.MinSize, current.MaxSize)
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcScaleRDWSStart, o.svcName, o.envName, minSize, maxSize))
+	cfg, err := o.rdwsUpdater.CreateAutoScalingConfiguration(apprunner.CreateAutoScalingConfigurationInput{
+		Name:           autoScalingConfigName(o.appName, o.envName, o.svcName),
+		MinSize:        minSize,
+		MaxSize:        maxSize,
+		MaxConcurrency: current.MaxConcurrency,
+	})
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcScaleFailed, o.svcName, o.envName))
+		return err
+	}
+	if err := o.rdwsUpdater.UpdateServiceAutoScalingConfiguration(o.svcARN, cfg.ARN); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcScaleFailed, o.svcName, o.envName))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcScaleRDWSSucceed, o.svcName, o.envName, minSize, maxSize))
+	o.updateManifest = func(mft []byte) ([]byte, error) {
+		return setEnvOverrideCount(mft, o.envName, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: fmt.Sprintf(fmtAutoScalingConfigRef, cfg.Name, cfg.Revision),
+		})
+	}
+	return nil
+}
+
+// capacityRange fills in the bound that is not specified with its current value.
+func (o *svcScaleOpts) capacityRange(currentMin, currentMax int64) (minCap, maxCap int64, err error) {
+	minCap, maxCap = currentMin, currentMax
+	if o.minCapacity != nil {
+		minCap = aws.Int64Value(o.minCapacity)
+	}
+	if o.maxCapacity != nil {
+		maxCap = aws.Int64Value(o.maxCapacity)
+	}
+	if minCap > maxCap {
+		return 0, 0, fmt.Errorf("minimum %d must be less than or equal to maximum %d", minCap, maxCap)
+	}
+	return minCap, maxCap, nil
+}
+
+func (o *svcScaleOpts) ecsServiceNames() (cluster, service string, err error) {
+	svc, err := o.ecsScaler.Service(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return "", "", fmt.Errorf("get ECS service of %s: %w", o.svcName, err)
+	}
+	arn := awsecs.ServiceArn(aws.StringValue(svc.ServiceArn))
+	cluster, err = arn.ClusterName()
+	if err != nil {
+		return "", "", fmt.Errorf("get cluster name: %w", err)
+	}
+	service, err = arn.ServiceName()
+	if err != nil {
+		return "", "", fmt.Errorf("get service name: %w", err)
+	}
+	return cluster, service, nil
+}
+
+func (o *svcScaleOpts) writeManifestCount() (string, error) {
+	raw, err := o.ws.ReadWorkloadManifest(o.svcName)
+	if err != nil {
+		return "", fmt.Errorf("read manifest file for service %s: %w", o.svcName, err)
+	}
+	mft, err := o.updateManifest(raw)
+	if err != nil {
+		return "", fmt.Errorf("update manifest of service %s: %w", o.svcName, err)
+	}
+	path, err := o.ws.OverwriteWorkloadManifest(mft, o.svcName)
+	if err != nil {
+		return "", fmt.Errorf("write manifest file for service %s: %w", o.svcName, err)
+	}
+	return path, nil
+}
+
+func (o *svcScaleOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment: %w", err)
+	}
+	o.targetEnv = env
+	return o.targetEnv, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcScaleOpts) RecommendActions() error {
+	if !o.writeManifest {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to record the new values in the service's stack.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+// autoScalingConfigName returns the name of the App Runner auto scaling configuration of a service.
+// Each scale operation creates a new revision under the same name.
+func autoScalingConfigName(app, env, svc string) string {
+	name := fmt.Sprintf(fmtAutoScalingConfigName, app, env, svc)
+	if len(name) > maxAutoScalingConfigNameLength {
+		name = name[:maxAutoScalingConfigNameLength]
+	}
+	return name
+}
+
+// setEnvOverrideCount sets the "count" field under "environments.<env>" of a workload manifest.
+func setEnvOverrideCount(mft []byte, env string, count *yaml.Node) ([]byte, error) {
+	return editEnvOverride(mft, env, func(_, override *yaml.Node) {
+		setYAMLMappingValue(override, "count", count)
+	})
+}
+
+// setEnvOverrideCountRange sets the "count.range" field under "environments.<env>" of a workload manifest.
+// If the manifest already configures the range as a mapping, only its "min" and "max" fields are updated
+// so that settings such as "spot_from" are kept.
+func setEnvOverrideCountRange(mft []byte, env string, minCap, maxCap int64) ([]byte, error) {
+	return editEnvOverride(mft, env, func(root, override *yaml.Node) {
+		existing := yamlMappingValue(yamlMappingValue(override, "count"), "range")
+		if existing == nil {
+			existing = yamlMappingValue(yamlMappingValue(root, "count"), "range")
+		}
+		count := mappingNode(override, "count")
+		if existing == nil || existing.Kind != yaml.MappingNode {
+			setYAMLMappingValue(count, "range", &yaml.Node{
+				Kind:  yaml.ScalarNode,
+				Tag:   "!!str",
+				Value: fmt.Sprintf("%d-%d", minCap, maxCap),
+			})
+			return
+		}
+		rangeOverride := mappingNode(count, "range")
+		setYAMLMappingValue(rangeOverride, "min", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(minCap, 10)})
+		setYAMLMappingValue(rangeOverride, "max", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(maxCap, 10)})
+	})
+}
+
+// editEnvOverride applies edit to the "environments.<env>" mapping of a workload manifest,
+// preserving the comments and order of the rest of the document.
+func editEnvOverride(mft []byte, env string, edit func(root, override *yaml.Node)) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(mft, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("manifest is not a YAML mapping")
+	}
+	root := doc.Content[0]
+	edit(root, mappingNode(mappingNode(root, "environments"), env))
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// mappingNode returns the value of key in the mapping m, replacing it with an empty mapping
+// if it does not exist or is not a mapping.
+func mappingNode(m *yaml.Node, key string) *yaml.Node {
+	if val := yamlMappingValue(m, key); val != nil && val.Kind == yaml.MappingNode {
+		return val
+	}
+	val := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+	}
+	setYAMLMappingValue(m, key, val)
+	return val
+}
+
+// yamlMappingValue returns the value of key in the mapping m, or nil if it does not exist.
+func yamlMappingValue(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setYAMLMappingValue sets the value of key in the mapping m, appending the key if it does not exist.
+func setYAMLMappingValue(m *yaml.Node, key string, val *yaml.Node) {
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			m.Content[i+1] = val
+			return
+		}
+	}
+	m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, val)
+}
+
+// buildSvcScaleCmd builds the command for scaling a deployed service.
+func buildSvcScaleCmd() *cobra.Command {
+	vars := svcScaleVars{}
+	cmd := &cobra.Command{
+		Use:   "scale",
+		Short: "Change the number of tasks of a deployed service without redeploying it.",
+		Long: `Change the number of tasks of a deployed service without redeploying it.
+Use --count to set the desired count of a service that does not scale automatically,
+or --min and --max to change the auto scaling range of a service.
+For Request-Driven Web Services, --min and --max change the number of App Runner instances.`,
+
+		Example: `
+  Run 5 tasks of service "api" in environment "prod".
+  /code $ copilot svc scale -n api -e prod --count 5
+  Let service "api" scale between 2 and 20 tasks in environment "prod", and update its manifest.
+  /code $ copilot svc scale -n api -e prod --min 2 --max 20 --write-manifest`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcScaleOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(countFlag) {
+				opts.desiredCount = aws.Int64(vars.count)
+			}
+			if cmd.Flags().Changed(minCountFlag) {
+				opts.minCapacity = aws.Int64(vars.minCount)
+			}
+			if cmd.Flags().Changed(maxCountFlag) {
+				opts.maxCapacity = aws.Int64(vars.maxCount)
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().Int64Var(&vars.count, countFlag, 0, svcScaleCountFlagDescription)
+	cmd.Flags().Int64Var(&vars.minCount, minCountFlag, 0, svcScaleMinFlagDescription)
+	cmd.Flags().Int64Var(&vars.maxCount, maxCountFlag, 0, svcScaleMaxFlagDescription)
+	cmd.Flags().BoolVar(&vars.writeManifest, writeManifestFlag, false, writeManifestFlagDescription)
+	return cmd
+}
@@ -0,0 +1,385 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+func TestSvcScale_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inCount *int64
+		inMin   *int64
+		inMax   *int64
+
+		wantedError error
+	}{
+		"errors if no value is specified": {
+			wantedError: errors.New("must specify --count, or --min and --max"),
+		},
+		"errors if both count and range are specified": {
+			inCount:     aws.Int64(3),
+			inMax:       aws.Int64(5),
+			wantedError: errors.New("cannot specify --count together with --min or --max"),
+		},
+		"errors if count is negative": {
+			inCount:     aws.Int64(-1),
+			wantedError: errors.New("--count must be greater than or equal to 0"),
+		},
+		"errors if min is greater than max": {
+			inMin:       aws.Int64(5),
+			inMax:       aws.Int64(2),
+			wantedError: errors.New("--min 5 must be less than or equal to --max 2"),
+		},
+		"valid count": {
+			inCount: aws.Int64(0),
+		},
+		"valid range with only max": {
+			inMax: aws.Int64(10),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcScaleOpts{
+				desiredCount: tc.inCount,
+				minCapacity:  tc.inMin,
+				maxCapacity:  tc.inMax,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcScaleMocks struct {
+	ecsScaler     *mocks.MockecsServiceScaler
+	targetUpdater *mocks.MockecsScalableTargetUpdater
+	rdwsUpdater   *mocks.MockapprunnerAutoScalingUpdater
+	ws            *mocks.MockwsWorkloadManifestOverwriter
+	prog          *mocks.Mockprogress
+}
+
+func TestSvcScale_Execute(t *testing.T) {
+	const (
+		mockSvcARN = "arn:aws:ecs:us-west-2:123456789012:service/my-app-prod-Cluster/my-app-prod-api-Service"
+		mockRDWS   = "arn:aws:apprunner:us-west-2:123456789012:service/my-app-prod-api/abc"
+	)
+	mockService := &awsecs.Service{ServiceArn: aws.String(mockSvcARN)}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inWkldType      string
+		inCount         *int64
+		inMin           *int64
+		inMax           *int64
+		inWriteManifest bool
+		setupMocks      func(m svcScaleMocks)
+
+		wantedError    error
+		wantedManifest string
+	}{
+		"errors if the service scales automatically when setting the desired count": {
+			inWkldType: manifestinfo.LoadBalancedWebServiceType,
+			inCount:    aws.Int64(3),
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(&aas.ScalableTarget{
+					MinCapacity: 1,
+					MaxCapacity: 10,
+				}, nil)
+			},
+			wantedError: errors.New("service api in environment prod scales automatically between 1 and 10 tasks: use --min and --max to change its range instead"),
+		},
+		"errors if failed to update the desired count": {
+			inWkldType: manifestinfo.BackendServiceType,
+			inCount:    aws.Int64(3),
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(nil, &aas.ErrScalableTargetNotFound{})
+				m.prog.EXPECT().Start("Scaling service api in environment prod to 3 tasks.")
+				m.ecsScaler.EXPECT().UpdateServiceDesiredCount("my-app", "prod", "api", int64(3)).Return(mockErr)
+				m.prog.EXPECT().Stop(log.Serrorf("Failed to scale service api in environment prod.\n"))
+			},
+			wantedError: mockErr,
+		},
+		"sets the desired count and writes it to the manifest": {
+			inWkldType:      manifestinfo.BackendServiceType,
+			inCount:         aws.Int64(3),
+			inWriteManifest: true,
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(nil, &aas.ErrScalableTargetNotFound{})
+				m.prog.EXPECT().Start("Scaling service api in environment prod to 3 tasks.")
+				m.ecsScaler.EXPECT().UpdateServiceDesiredCount("my-app", "prod", "api", int64(3)).Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf("Service api in environment prod is now running 3 tasks.\n"))
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest("name: api\ntype: Backend Service\ncount: 1 # one task by default\n"), nil)
+			},
+			wantedManifest: `name: api
+type: Backend Service
+count: 1 # one task by default
+environments:
+  prod:
+    count: 3
+`,
+		},
+		"errors if the service does not scale automatically when setting the range": {
+			inWkldType: manifestinfo.LoadBalancedWebServiceType,
+			inMax:      aws.Int64(20),
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(nil, &aas.ErrScalableTargetNotFound{})
+			},
+			wantedError: errors.New("service api in environment prod does not scale automatically: use --count to change its desired count instead"),
+		},
+		"errors if the new max is less than the current min": {
+			inWkldType: manifestinfo.LoadBalancedWebServiceType,
+			inMax:      aws.Int64(1),
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(&aas.ScalableTarget{
+					MinCapacity: 2,
+					MaxCapacity: 10,
+				}, nil)
+			},
+			wantedError: errors.New("minimum 2 must be less than or equal to maximum 1"),
+		},
+		"updates the range keeping the current min": {
+			inWkldType: manifestinfo.LoadBalancedWebServiceType,
+			inMax:      aws.Int64(20),
+			setupMocks: func(m svcScaleMocks) {
+				m.ecsScaler.EXPECT().Service("my-app", "prod", "api").Return(mockService, nil)
+				m.targetUpdater.EXPECT().ECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service").Return(&aas.ScalableTarget{
+					MinCapacity: 2,
+					MaxCapacity: 10,
+				}, nil)
+				m.prog.EXPECT().Start("Scaling service api in environment prod between 2 and 20 tasks.")
+				m.targetUpdater.EXPECT().UpdateECSServiceScalableTarget("my-app-prod-Cluster", "my-app-prod-api-Service", aas.ScalableTarget{
+					MinCapacity: 2,
+					MaxCapacity: 20,
+				}).Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf("Service api in environment prod now scales between 2 and 20 tasks.\n"))
+			},
+		},
+		"errors if count is used with a Request-Driven Web Service": {
+			inWkldType:  manifestinfo.RequestDrivenWebServiceType,
+			inCount:     aws.Int64(3),
+			setupMocks:  func(m svcScaleMocks) {},
+			wantedError: errors.New("--count is not supported for Request-Driven Web Services: use --min and --max to change the number of instances instead"),
+		},
+		"errors if failed to update the auto scaling configuration of a Request-Driven Web Service": {
+			inWkldType: manifestinfo.RequestDrivenWebServiceType,
+			inMin:      aws.Int64(2),
+			setupMocks: func(m svcScaleMocks) {
+				m.rdwsUpdater.EXPECT().ServiceAutoScalingConfiguration(mockRDWS).Return(&apprunner.AutoScalingConfiguration{
+					MinSize:        1,
+					MaxSize:        25,
+					MaxConcurrency: 100,
+				}, nil)
+				m.prog.EXPECT().Start("Scaling service api in environment prod between 2 and 25 instances.")
+				m.rdwsUpdater.EXPECT().CreateAutoScalingConfiguration(apprunner.CreateAutoScalingConfigurationInput{
+					Name:           "my-app-prod-api",
+					MinSize:        2,
+					MaxSize:        25,
+					MaxConcurrency: 100,
+				}).Return(&apprunner.AutoScalingConfiguration{
+					ARN:      "mockConfigArn",
+					Name:     "my-app-prod-api",
+					Revision: 2,
+				}, nil)
+				m.rdwsUpdater.EXPECT().UpdateServiceAutoScalingConfiguration(mockRDWS, "mockConfigArn").Return(mockErr)
+				m.prog.EXPECT().Stop(log.Serrorf("Failed to scale service api in environment prod.\n"))
+			},
+			wantedError: mockErr,
+		},
+		"updates the auto scaling configuration of a Request-Driven Web Service and writes it to the manifest": {
+			inWkldType:      manifestinfo.RequestDrivenWebServiceType,
+			inMin:           aws.Int64(2),
+			inMax:           aws.Int64(5),
+			inWriteManifest: true,
+			setupMocks: func(m svcScaleMocks) {
+				m.rdwsUpdater.EXPECT().ServiceAutoScalingConfiguration(mockRDWS).Return(&apprunner.AutoScalingConfiguration{
+					MinSize:        1,
+					MaxSize:        25,
+					MaxConcurrency: 100,
+				}, nil)
+				m.prog.EXPECT().Start("Scaling service api in environment prod between 2 and 5 instances.")
+				m.rdwsUpdater.EXPECT().CreateAutoScalingConfiguration(apprunner.CreateAutoScalingConfigurationInput{
+					Name:           "my-app-prod-api",
+					MinSize:        2,
+					MaxSize:        5,
+					MaxConcurrency: 100,
+				}).Return(&apprunner.AutoScalingConfiguration{
+					ARN:      "mockConfigArn",
+					Name:     "my-app-prod-api",
+					Revision: 2,
+				}, nil)
+				m.rdwsUpdater.EXPECT().UpdateServiceAutoScalingConfiguration(mockRDWS, "mockConfigArn").Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf("Service api in environment prod now scales between 2 and 5 instances.\n"))
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`name: api
+type: Request-Driven Web Service
+environments:
+  prod:
+    variables:
+      LOG_LEVEL: warn
+`), nil)
+			},
+			wantedManifest: `name: api
+type: Request-Driven Web Service
+environments:
+  prod:
+    variables:
+      LOG_LEVEL: warn
+    count: my-app-prod-api/2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcScaleMocks{
+				ecsScaler:     mocks.NewMockecsServiceScaler(ctrl),
+				targetUpdater: mocks.NewMockecsScalableTargetUpdater(ctrl),
+				rdwsUpdater:   mocks.NewMockapprunnerAutoScalingUpdater(ctrl),
+				ws:            mocks.NewMockwsWorkloadManifestOverwriter(ctrl),
+				prog:          mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			var gotManifest string
+			if tc.wantedManifest != "" {
+				m.ws.EXPECT().OverwriteWorkloadManifest(gomock.Any(), "api").Do(func(mft workspace.WorkloadManifest, _ string) {
+					gotManifest = string(mft)
+				}).Return("/copilot/api/manifest.yml", nil)
+			}
+
+			opts := &svcScaleOpts{
+				svcScaleVars: svcScaleVars{
+					appName:       "my-app",
+					envName:       "prod",
+					svcName:       "api",
+					writeManifest: tc.inWriteManifest,
+				},
+				desiredCount:  tc.inCount,
+				minCapacity:   tc.inMin,
+				maxCapacity:   tc.inMax,
+				ws:            m.ws,
+				prog:          m.prog,
+				initClients:   func() error { return nil },
+				ecsScaler:     m.ecsScaler,
+				targetUpdater: m.targetUpdater,
+				rdwsUpdater:   m.rdwsUpdater,
+				wkldType:      tc.inWkldType,
+				svcARN:        mockRDWS,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, gotManifest)
+		})
+	}
+}
+
+func TestSetEnvOverrideCountRange(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wantedManifest string
+	}{
+		"adds the range to a new environment override": {
+			inManifest: `name: api
+# Auto scale on CPU.
+count:
+  range: 1-10
+  cpu_percentage: 70
+`,
+			wantedManifest: `name: api
+# Auto scale on CPU.
+count:
+  range: 1-10
+  cpu_percentage: 70
+environments:
+  prod:
+    count:
+      range: 2-20
+`,
+		},
+		"replaces a basic count in the environment override": {
+			inManifest: `name: api
+environments:
+  prod:
+    count: 3
+`,
+			wantedManifest: `name: api
+environments:
+  prod:
+    count:
+      range: 2-20
+`,
+		},
+		"updates min and max of an advanced range": {
+			inManifest: `name: api
+count:
+  range:
+    min: 1
+    max: 10
+    spot_from: 3
+  cpu_percentage: 70
+environments:
+  prod:
+    count:
+      range:
+        min: 1
+        max: 4
+        spot_from: 2
+`,
+			wantedManifest: `name: api
+count:
+  range:
+    min: 1
+    max: 10
+    spot_from: 3
+  cpu_percentage: 70
+environments:
+  prod:
+    count:
+      range:
+        min: 2
+        max: 20
+        spot_from: 2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := setEnvOverrideCountRange([]byte(tc.inManifest), "prod", 2, 20)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, string(got))
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -52,7 +53,10 @@ type taskExecOpts struct {
 	newTaskSel         func(*session.Session) runningTaskSelector
 	configSel          appEnvSelector
 	newCommandExecutor func(*session.Session) ecsCommandExecutor
+	newPortForwarder   func(*session.Session) ecsPortForwarder
 	provider           sessionProvider
+	// Creates the version checker of the environment, since port forwarding requires a recent environment manager role.
+	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
 
 	task *awsecs.Task
 }
@@ -66,7 +70,7 @@ func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
 
 	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 	prompter := prompt.New()
-	return &taskExecOpts{
+	opts := &taskExecOpts{
 		taskExecVars:     vars,
 		store:            ssmStore,
 		ssmPluginManager: exec.NewSSMPluginCommand(nil),
@@ -78,8 +82,23 @@ func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
 		newCommandExecutor: func(s *session.Session) ecsCommandExecutor {
 			return awsecs.New(s)
 		},
+		newPortForwarder: func(s *session.Session) ecsPortForwarder {
+			return awsecs.New(s)
+		},
 		provider: sessProvider,
-	}, nil
+	}
+	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
+		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: ssmStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		return envDescriber, nil
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values provided by the user are invalid.
@@ -87,6 +106,9 @@ func (o *taskExecOpts) Validate() error {
 	if o.useDefault && (o.appName != tryReadingAppName() || o.envName != "") {
 		return fmt.Errorf("cannot specify both default flag and app or env flags")
 	}
+	if err := o.validatePortForward(); err != nil {
+		return err
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -132,6 +154,11 @@ func (o *taskExecOpts) Ask() error {
 
 // Execute executes a command in a running container.
 func (o *taskExecOpts) Execute() error {
+	if o.portForward != "" && !o.useDefault {
+		if err := o.validatePortForwardEnvCompatible(o.newEnvCompatibilityChecker, "task exec --port-forward"); err != nil {
+			return err
+		}
+	}
 	sess, err := o.configSession()
 	if err != nil {
 		return err
@@ -141,6 +168,9 @@ func (o *taskExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(o.task.TaskArn), err)
 	}
+	if o.portForward != "" {
+		return o.forwardPort(o.newPortForwarder(sess), cluster, taskID, container)
+	}
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -210,7 +240,9 @@ func buildTaskExecCmd() *cobra.Command {
   Runs the 'cat progress.csv' command in the task prefixed with ID "1848c38" part of the "db-migrate" task group.
   /code $ copilot task exec --name db-migrate --task-id 1848c38 --command "cat progress.csv"
   Start an interactive bash session with a task prefixed with ID "38c3818" in the default cluster.
-  /code $ copilot task exec --default --task-id 38c3818`,
+  /code $ copilot task exec --default --task-id 38c3818
+  Reach a private database from your machine through a task in the "db-migrate" task group.
+  /code $ copilot task exec -e test -n db-migrate --port-forward 5432:5432 --remote-host mydb.cluster-abc.us-west-2.rds.amazonaws.com`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskExecOpts(vars)
 			if err != nil {
@@ -230,6 +262,8 @@ func buildTaskExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.portForward, portForwardFlag, "", portForwardFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
 	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
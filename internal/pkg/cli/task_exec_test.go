@@ -23,8 +23,10 @@ type execTaskMocks struct {
 	configSel        *mocks.MockappEnvSelector
 	taskSel          *mocks.MockrunningTaskSelector
 	commandExec      *mocks.MockecsCommandExecutor
+	portForwarder    *mocks.MockecsPortForwarder
 	ssmPluginManager *mocks.MockssmPluginManager
 	provider         *mocks.MocksessionProvider
+	envChecker       *mocks.MockversionCompatibilityChecker
 }
 
 func TestTaskExec_Validate(t *testing.T) {
@@ -314,9 +316,10 @@ func TestTaskExec_Execute(t *testing.T) {
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
-		inTask       *ecs.Task
-		inUseDefault bool
-		setupMocks   func(mocks execTaskMocks)
+		inTask        *ecs.Task
+		inUseDefault  bool
+		inPortForward string
+		setupMocks    func(mocks execTaskMocks)
 
 		wantedError error
 	}{
@@ -359,6 +362,46 @@ func TestTaskExec_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("execute command mockCommand in container mockContainerName: some error"),
 		},
+		"should return error if the environment can't forward ports": {
+			inTask:        mockTask,
+			inPortForward: "8080:80",
+			setupMocks: func(m execTaskMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.17.0", nil)
+			},
+
+			wantedError: fmt.Errorf(`environment "my-env" is on version "v1.17.0" which does not support the "task exec --port-forward" feature`),
+		},
+		"forward a local port in the default cluster without checking the environment version": {
+			inTask:        mockTask,
+			inUseDefault:  true,
+			inPortForward: "8080:80",
+			setupMocks: func(m execTaskMocks) {
+				m.provider.EXPECT().Default()
+				m.portForwarder.EXPECT().ForwardPort(ecs.ForwardPortInput{
+					Cluster:    mockClusterARN,
+					Task:       mockTaskID,
+					Container:  mockContainerName,
+					LocalPort:  "8080",
+					RemotePort: "80",
+				}).Return(nil)
+			},
+		},
+		"forward a local port instead of executing the command": {
+			inTask:        mockTask,
+			inPortForward: "8080:80",
+			setupMocks: func(m execTaskMocks) {
+				m.envChecker.EXPECT().Version().Return("v1.18.0", nil)
+				m.storeSvc.EXPECT().GetEnvironment(mockApp, mockEnv).Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.portForwarder.EXPECT().ForwardPort(ecs.ForwardPortInput{
+					Cluster:    mockClusterARN,
+					Task:       mockTaskID,
+					Container:  mockContainerName,
+					LocalPort:  "8080",
+					RemotePort: "80",
+				}).Return(nil)
+			},
+		},
 		"success": {
 			inTask: mockTask,
 			setupMocks: func(m execTaskMocks) {
@@ -384,10 +427,13 @@ func TestTaskExec_Execute(t *testing.T) {
 			mockNewCommandExec := func(_ *session.Session) ecsCommandExecutor {
 				return mockCommandExec
 			}
+			mockPortForwarder := mocks.NewMockecsPortForwarder(ctrl)
 			mocks := execTaskMocks{
-				storeSvc:    mockStoreReader,
-				commandExec: mockCommandExec,
-				provider:    mocks.NewMocksessionProvider(ctrl),
+				storeSvc:      mockStoreReader,
+				commandExec:   mockCommandExec,
+				portForwarder: mockPortForwarder,
+				provider:      mocks.NewMocksessionProvider(ctrl),
+				envChecker:    mocks.NewMockversionCompatibilityChecker(ctrl),
 			}
 
 			tc.setupMocks(mocks)
@@ -395,16 +441,23 @@ func TestTaskExec_Execute(t *testing.T) {
 			execTasks := &taskExecOpts{
 				taskExecVars: taskExecVars{
 					execVars: execVars{
-						appName: mockApp,
-						envName: mockEnv,
-						command: mockCommand,
+						appName:     mockApp,
+						envName:     mockEnv,
+						command:     mockCommand,
+						portForward: tc.inPortForward,
 					},
 					useDefault: tc.inUseDefault,
 				},
 				task:               tc.inTask,
 				store:              mockStoreReader,
 				newCommandExecutor: mockNewCommandExec,
-				provider:           mocks.provider,
+				newPortForwarder: func(_ *session.Session) ecsPortForwarder {
+					return mockPortForwarder
+				},
+				provider: mocks.provider,
+				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
+					return mocks.envChecker, nil
+				},
 			}
 
 			// WHEN
@@ -135,8 +135,9 @@ type runTaskVars struct {
 	follow                bool
 	generateCommandTarget string
 
-	os   string
-	arch string
+	os             string
+	arch           string
+	buildPlatforms []string
 }
 
 type runTaskOpts struct {
@@ -385,6 +386,9 @@ func (o *runTaskOpts) Validate() error {
 	if noOS, noArch := o.os == "", o.arch == ""; noOS != noArch {
 		return fmt.Errorf("must specify either both `--%s` and `--%s` or neither", osFlag, archFlag)
 	}
+	if err := o.validateBuildPlatforms(); err != nil {
+		return err
+	}
 	if err := o.validatePlatform(); err != nil {
 		return err
 	}
@@ -548,6 +552,46 @@ func (o *runTaskOpts) validatePlatform() error {
 	return fmt.Errorf("platform %s is invalid; %s: %s", dockerengine.PlatformString(o.os, o.arch), english.PluralWord(len(validPlatforms), "the valid platform is", "valid platforms are"), english.WordSeries(validPlatforms, "and"))
 }
 
+// validateBuildPlatforms validates the platforms of a multi-platform image, and ensures that the task runs on one of them.
+// If the platform of the task isn't specified, the task runs on the first platform.
+func (o *runTaskOpts) validateBuildPlatforms() error {
+	if len(o.buildPlatforms) == 0 {
+		return nil
+	}
+	if o.image != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", imageFlag, buildPlatformsFlag)
+	}
+	var taskArchs []string
+	for _, platform := range o.buildPlatforms {
+		os, arch, _ := strings.Cut(strings.ToLower(platform), "/")
+		taskArch, ok := taskArchOfBuildArch[arch]
+		if os != dockerengine.OSLinux || !ok {
+			return fmt.Errorf("build platform %s is invalid; multi-platform images can only be built for %s", platform,
+				english.WordSeries([]string{
+					dockerengine.PlatformString(dockerengine.OSLinux, dockerengine.ArchAMD64),
+					dockerengine.PlatformString(dockerengine.OSLinux, dockerengine.ArchARM64),
+				}, "and"))
+		}
+		taskArchs = append(taskArchs, taskArch)
+	}
+	if o.os == "" {
+		o.os, o.arch = template.OSLinux, taskArchs[0]
+		return nil
+	}
+	if !strings.EqualFold(o.os, template.OSLinux) || !contains(strings.ToUpper(o.arch), taskArchs) {
+		return fmt.Errorf("platform %s of the task must be one of the platforms of `--%s`",
+			dockerengine.PlatformString(o.os, o.arch), buildPlatformsFlag)
+	}
+	return nil
+}
+
+// taskArchOfBuildArch maps the architectures of docker platforms to the CPU architectures of a task.
+var taskArchOfBuildArch = map[string]string{
+	dockerengine.ArchAMD64: template.ArchX86,
+	dockerengine.ArchX86:   template.ArchX86,
+	dockerengine.ArchARM64: template.ArchARM64,
+}
+
 func (o *runTaskOpts) validateFlagsWithCluster() error {
 	if o.cluster == "" {
 		return nil
@@ -962,6 +1006,7 @@ func (o *runTaskOpts) buildAndPushImage() error {
 	if _, err := o.repository.BuildAndPush(dockerengine.New(exec.NewCmd()), &dockerengine.BuildArguments{
 		Dockerfile: o.dockerfilePath,
 		Context:    ctx,
+		Platforms:  o.buildPlatforms,
 		Tags:       append([]string{imageTagLatest}, additionalTags...),
 	}); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
@@ -1169,7 +1214,9 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Build a multi-platform image for x86 and ARM, and run the task on ARM.
+  /code $ copilot task run --build-platforms linux/arm64,linux/amd64`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -1190,6 +1237,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.dockerfileContextPath, dockerFileContextFlag, "", dockerFileContextFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", taskImageTagFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.buildPlatforms, buildPlatformsFlag, nil, buildPlatformsFlagDescription)
 
 	cmd.Flags().StringVar(&vars.appName, appFlag, "", taskAppFlagDescription)
 	cmd.Flags().StringVar(&vars.env, envFlag, "", taskEnvFlagDescription)
@@ -1225,6 +1273,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	buildFlags.AddFlag(cmd.Flags().Lookup(dockerFileContextFlag))
 	buildFlags.AddFlag(cmd.Flags().Lookup(imageFlag))
 	buildFlags.AddFlag(cmd.Flags().Lookup(imageTagFlag))
+	buildFlags.AddFlag(cmd.Flags().Lookup(buildPlatformsFlag))
 
 	placementFlags := pflag.NewFlagSet("Placement", pflag.ContinueOnError)
 	placementFlags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -64,13 +64,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		inSubnets        []string
 		inSecurityGroups []string
 
-		inEnvVars    map[string]string
-		inEnvFile    string
-		inSecrets    map[string]string
-		inCommand    string
-		inEntryPoint string
-		inOS         string
-		inArch       string
+		inEnvVars        map[string]string
+		inEnvFile        string
+		inSecrets        map[string]string
+		inCommand        string
+		inEntryPoint     string
+		inOS             string
+		inArch           string
+		inBuildPlatforms []string
 
 		inDefault               bool
 		inGenerateCommandTarget string
@@ -81,6 +82,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		mockStore      func(m *mocks.Mockstore)
 		mockFileSystem func(mockFS afero.Fs)
 
+		wantedOS    string
+		wantedArch  string
 		wantedError error
 	}{
 		"valid with no flag": {
@@ -170,6 +173,38 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			inArch:      "x86_64",
 			wantedError: nil,
 		},
+		"invalid with image and build platforms": {
+			basicOpts:        defaultOpts,
+			inImage:          "113459295.dkr.ecr.ap-northeast-1.amazonaws.com/my-app",
+			inBuildPlatforms: []string{"linux/amd64", "linux/arm64"},
+			wantedError:      errors.New("cannot specify both `--image` and `--build-platforms`"),
+		},
+		"invalid build platform": {
+			basicOpts:        defaultOpts,
+			inBuildPlatforms: []string{"linux/amd64", "windows/amd64"},
+			wantedError:      errors.New("build platform windows/amd64 is invalid; multi-platform images can only be built for linux/amd64 and linux/arm64"),
+		},
+		"invalid if the task doesn't run on one of the build platforms": {
+			basicOpts:        defaultOpts,
+			inOS:             "LINUX",
+			inArch:           "ARM64",
+			inBuildPlatforms: []string{"linux/amd64"},
+			wantedError:      errors.New("platform LINUX/ARM64 of the task must be one of the platforms of `--build-platforms`"),
+		},
+		"task runs on the first build platform by default": {
+			basicOpts:        defaultOpts,
+			inBuildPlatforms: []string{"linux/arm64", "linux/amd64"},
+			wantedOS:         "LINUX",
+			wantedArch:       "ARM64",
+		},
+		"task runs on the specified build platform": {
+			basicOpts:        defaultOpts,
+			inOS:             "linux",
+			inArch:           "x86_64",
+			inBuildPlatforms: []string{"linux/arm64", "linux/amd64"},
+			wantedOS:         "LINUX",
+			wantedArch:       "X86_64",
+		},
 		"invalid number of tasks": {
 			basicOpts: basicOpts{
 				inCount:  -1,
@@ -414,6 +449,7 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					generateCommandTarget:       tc.inGenerateCommandTarget,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
+					buildPlatforms:              tc.inBuildPlatforms,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
@@ -435,6 +471,10 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			if tc.wantedOS != "" {
+				require.Equal(t, tc.wantedOS, opts.os)
+				require.Equal(t, tc.wantedArch, opts.arch)
+			}
 		})
 	}
 }
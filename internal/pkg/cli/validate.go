@@ -62,7 +62,9 @@ var (
 	// Aurora-Serverless-specific errors.
 	errInvalidRDSNameCharacters    = errors.New("value must start with a letter and followed by alphanumeric letters only")
 	errRDWSNotConnectedToVPC       = fmt.Errorf("%s requires a VPC connection", manifestinfo.RequestDrivenWebServiceType)
+	errRedisNotSupportedForRDWS    = fmt.Errorf("not supported for %s", manifestinfo.RequestDrivenWebServiceType)
 	fmtErrInvalidEngineType        = "invalid engine type %s: must be one of %s"
+	fmtErrInvalidRedisMode         = "invalid cache mode %s: must be one of %s"
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
@@ -346,8 +348,11 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
+	switch storageType {
+	case rdsStorageType:
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	case redisStorageType:
+		return validateRedisStorageType(opts.ws, opts.workloadName)
 	}
 	return nil
 }
@@ -379,6 +384,24 @@ func validateAuroraStorageType(ws manifestReader, workloadName string) error {
 	return nil
 }
 
+func validateRedisStorageType(ws manifestReader, workloadName string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	if mftType == manifestinfo.RequestDrivenWebServiceType {
+		return fmt.Errorf("invalid storage type %s: %w", redisStorageType, errRedisNotSupportedForRDWS)
+	}
+	return nil
+}
+
 func validateMySQLDBName(val interface{}) error {
 	const (
 		minMySQLDBNameLength = 1
@@ -452,6 +475,32 @@ func validateEngine(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(engineTypes))
 }
 
+func validateRedisEngine(val interface{}) error {
+	engine, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range redisEngineTypes {
+		if engine == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(redisEngineTypes))
+}
+
+func validateRedisMode(val interface{}) error {
+	mode, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, valid := range redisModes {
+		if mode == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidRedisMode, mode, prettify(redisModes))
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -680,6 +729,28 @@ func rdsNameValidation(val interface{}) error {
 	return nil
 }
 
+func redisNameValidation(val interface{}) error {
+	// The storage name is used to generate the names of the serverless cache, its user and user group.
+	// These names are at most 40 characters long, and are suffixed by "-" and the first 8 characters of the stack's ID.
+	// Hence the maximal length of the storage name is 40 - len("-xxxxxxxx").
+	const minRedisNameLength = 1
+	const maxRedisNameLength = 40 - len("-xxxxxxxx")
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minRedisNameLength || len(s) > maxRedisNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minRedisNameLength, maxRedisNameLength)
+	}
+	// Names of ElastiCache resources must start with a letter as well, so the RDS storage name constraints apply.
+	m := rdsStorageNameRegExp.FindStringSubmatch(s)
+	if m == nil {
+		return errInvalidRDSNameCharacters
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
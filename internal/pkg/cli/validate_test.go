@@ -322,6 +322,33 @@ func TestValidateRDSName(t *testing.T) {
 	}
 }
 
+func TestValidateRedisName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "sessions-cache",
+			want:  nil,
+		},
+		"too long": {
+			input: "AprilisthecruellestmonthbreedingLilacs",
+			want:  fmt.Errorf("value must be between 1 and %d characters in length", 40-len("-xxxxxxxx")),
+		},
+		"bad character": {
+			input: "1cache",
+			want:  errInvalidRDSNameCharacters,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := redisNameValidation(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidatePath(t *testing.T) {
 	testCases := map[string]struct {
 		input interface{}
@@ -438,6 +465,34 @@ type: Request-Driven Web Service
 			},
 			want: errors.New("invalid storage type Aurora: Request-Driven Web Service requires a VPC connection"),
 		},
+		"should allow Redis if the workload type is not a RDWS": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Backend Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
+		"should return an error if Redis is selected for a RDWS": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+network:
+  vpc:
+    placement: private
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type Redis: not supported for Request-Driven Web Service"),
+		},
 		"should succeed if Aurora is selected and RDWS is connected to a VPC": {
 			input: "Aurora",
 			optionals: validateStorageTypeOpts{
@@ -973,3 +1028,53 @@ func TestValidateJobName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRedisEngine(t *testing.T) {
+	testCases := map[string]testCase{
+		"redis": {
+			input: "Redis",
+		},
+		"valkey": {
+			input: "Valkey",
+		},
+		"invalid engine type": {
+			input: "Memcached",
+			want:  errors.New(`invalid engine type Memcached: must be one of "Redis", "Valkey"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRedisEngine(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateRedisMode(t *testing.T) {
+	testCases := map[string]testCase{
+		"serverless": {
+			input: "serverless",
+		},
+		"cluster": {
+			input: "cluster",
+		},
+		"invalid mode": {
+			input: "Serverless",
+			want:  errors.New(`invalid cache mode Serverless: must be one of "serverless", "cluster"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateRedisMode(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
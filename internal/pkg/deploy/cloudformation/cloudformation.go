@@ -25,6 +25,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -39,6 +40,9 @@ const (
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+
+	// Output key of the listener rule that shifts traffic between the deployments of a service.
+	trafficShiftListenerRuleOutputKey = "TrafficShiftListenerRuleArn"
 )
 
 // CloudFormation's error types to compare against.
@@ -91,6 +95,10 @@ type ecsClient interface {
 	stream.ECSServiceDescriber
 }
 
+type elbv2Client interface {
+	stream.TargetGroupWeightDescriber
+}
+
 type cfnClient interface {
 	// Methods augmented by the aws wrapper struct.
 	Create(*cloudformation.Stack) (string, error)
@@ -104,6 +112,7 @@ type cfnClient interface {
 	DeleteAndWaitWithRoleARN(stackName, roleARN string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
 	DescribeChangeSet(changeSetID, stackName string) (*cloudformation.ChangeSetDescription, error)
+	PreviewChangeSet(*cloudformation.Stack) (*cloudformation.ChangeSetDescription, error)
 	TemplateBody(stackName string) (string, error)
 	TemplateBodyFromChangeSet(changeSetID, stackName string) (string, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
@@ -172,6 +181,7 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	elbv2Client    elbv2Client
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -192,6 +202,7 @@ func New(sess *session.Session, opts ...OptFn) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		elbv2Client:    elbv2.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -302,6 +313,30 @@ func (cf CloudFormation) newUpsertChangeSetInput(w progress.FileWriter, stack *c
 	return in
 }
 
+// previewChangeSet returns the changes that would be applied to the stack without executing them.
+// If there are no changes, returns an empty description.
+func (cf CloudFormation) previewChangeSet(stack *cloudformation.Stack) (*cloudformation.ChangeSetDescription, error) {
+	spinner := progress.NewSpinner(cf.console)
+	label := fmt.Sprintf("Previewing infrastructure changes for stack %s", stack.Name)
+	spinner.Start(label)
+	descr, err := cf.cfnClient.PreviewChangeSet(stack)
+	if err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		switch {
+		case errors.As(err, &errChangeSetEmpty):
+			spinner.Stop(fmt.Sprintf("- No new infrastructure changes for stack %s\n", stack.Name))
+			return &cloudformation.ChangeSetDescription{}, nil
+		case errors.As(err, &errNotFound):
+			spinner.Stop(fmt.Sprintf("- Stack %s does not exist yet\n", stack.Name))
+			return nil, err
+		}
+		spinner.Stop(log.Serrorf("%s\n", label))
+		return nil, err
+	}
+	spinner.Stop(log.Ssuccessf("%s\n", label))
+	return descr, nil
+}
+
 func (cf CloudFormation) executeAndRenderChangeSet(in *executeAndRenderChangeSetInput) error {
 	changeSetID, err := in.createChangeSet()
 	if err != nil {
@@ -405,9 +440,11 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 			renderer = r
 		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
 			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
-				Group:      in.g,
-				Ctx:        in.ctx,
-				RenderOpts: in.opts,
+				Group:              in.g,
+				Ctx:                in.ctx,
+				RenderOpts:         in.opts,
+				TargetGroupWeights: cf.elbv2Client,
+				ListenerRuleARN:    cf.trafficShiftListenerRule(in.stackName),
 			})
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
@@ -429,6 +466,22 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 	return resources, nil
 }
 
+// trafficShiftListenerRule returns the ARN of the listener rule that shifts traffic between the deployments of a service,
+// or an empty string if the service isn't deployed with a traffic-shifted strategy.
+func (cf CloudFormation) trafficShiftListenerRule(stackName string) string {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		// The traffic split is only informational, so we don't fail the deployment if it can't be retrieved.
+		return ""
+	}
+	for _, output := range descr.Outputs {
+		if aws.StringValue(output.OutputKey) == trafficShiftListenerRuleOutputKey {
+			return aws.StringValue(output.OutputValue)
+		}
+	}
+	return ""
+}
+
 type envControllerRendererInput struct {
 	g                 *errgroup.Group
 	ctx               context.Context
@@ -315,6 +315,9 @@ Resources:
 			},
 		},
 	}, nil)
+	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		StackStatus: aws.String("REVIEW_IN_PROGRESS"),
+	}, nil)
 	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_COMPLETE"),
 	}, nil)
@@ -66,6 +66,18 @@ func (cf CloudFormation) UpdateAndRenderEnvironment(conf StackConfiguration, buc
 	return cf.executeAndRenderChangeSet(in)
 }
 
+// PreviewEnvironment returns the changes that updating the environment stack would apply, without executing them.
+func (cf CloudFormation) PreviewEnvironment(conf StackConfiguration, bucketARN string, opts ...cloudformation.StackOption) (*cloudformation.ChangeSetDescription, error) {
+	cfnStack, err := cf.toUploadedStack(bucketARN, conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(cfnStack)
+	}
+	return cf.previewChangeSet(cfnStack)
+}
+
 func newRenderEnvironmentInput(cfnStack *cloudformation.Stack) *executeAndRenderChangeSetInput {
 	return &executeAndRenderChangeSetInput{
 		stackName:        cfnStack.Name,
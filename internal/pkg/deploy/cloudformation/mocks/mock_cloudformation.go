@@ -13,6 +13,7 @@ import (
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -188,6 +189,44 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// Mockelbv2Client is a mock of elbv2Client interface.
+type Mockelbv2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockelbv2ClientMockRecorder
+}
+
+// Mockelbv2ClientMockRecorder is the mock recorder for Mockelbv2Client.
+type Mockelbv2ClientMockRecorder struct {
+	mock *Mockelbv2Client
+}
+
+// NewMockelbv2Client creates a new mock instance.
+func NewMockelbv2Client(ctrl *gomock.Controller) *Mockelbv2Client {
+	mock := &Mockelbv2Client{ctrl: ctrl}
+	mock.recorder = &Mockelbv2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockelbv2Client) EXPECT() *Mockelbv2ClientMockRecorder {
+	return m.recorder
+}
+
+// TargetGroupWeights mocks base method.
+func (m *Mockelbv2Client) TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetGroupWeights", ruleARN)
+	ret0, _ := ret[0].([]elbv2.TargetGroupWeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetGroupWeights indicates an expected call of TargetGroupWeights.
+func (mr *Mockelbv2ClientMockRecorder) TargetGroupWeights(ruleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetGroupWeights", reflect.TypeOf((*Mockelbv2Client)(nil).TargetGroupWeights), ruleARN)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -402,6 +441,21 @@ func (mr *MockcfnClientMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockcfnClient)(nil).Outputs), stack)
 }
 
+// PreviewChangeSet mocks base method.
+func (m *MockcfnClient) PreviewChangeSet(arg0 *cloudformation0.Stack) (*cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewChangeSet", arg0)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewChangeSet indicates an expected call of PreviewChangeSet.
+func (mr *MockcfnClientMockRecorder) PreviewChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewChangeSet", reflect.TypeOf((*MockcfnClient)(nil).PreviewChangeSet), arg0)
+}
+
 // StackResources mocks base method.
 func (m *MockcfnClient) StackResources(name string) ([]*cloudformation0.StackResource, error) {
 	m.ctrl.T.Helper()
@@ -45,6 +45,10 @@ func TestBB_Pipeline_Template(t *testing.T) {
 			RepositoryURL:        "https://bitbucket.org/huanjani/sample",
 			Branch:               "main",
 			OutputArtifactFormat: "CODEBUILD_CLONE_REF",
+			Trigger: &deploy.GitTrigger{
+				Branches:  []string{"main", "release/*"},
+				FilePaths: []string{"api/**", "shared/**"},
+			},
 		},
 		Build:  &build,
 		Stages: []deploy.PipelineStage{stage},
@@ -49,10 +49,8 @@ func TestDD_Pipeline_Template(t *testing.T) {
 		AccountID:        "1111",
 		ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
 		ManagerRoleARN:   "arn:aws:iam::1111:role/phonetool-test-EnvManagerRole",
-	}, &manifest.PipelineStage{
-		Name:         "test",
-		TestCommands: []string{`echo "test"`},
-	}, []string{"api"})
+	}, &pipelineMft.Stages[0], []string{"api"})
+	stage.SetPipelineLocation("1111", "us-west-2")
 
 	serializer := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
 		AppName: "phonetool",
@@ -60,6 +60,21 @@ const (
 	maxPercentDefault         = 200
 )
 
+// Traffic shifting deployment configurations.
+const (
+	// Defaults match the CodeDeploy predefined configurations "ECSCanary10Percent5Minutes" and "ECSLinear10PercentEvery1Minutes".
+	canaryTrafficShiftPercentDefault = 10
+	canaryTrafficShiftMinutesDefault = 5
+	linearTrafficShiftPercentDefault = 10
+	linearTrafficShiftMinutesDefault = 1
+)
+
+var ecsDeploymentStrategies = map[string]string{
+	manifest.ECSCanaryDeploymentStrategy:    "CANARY",
+	manifest.ECSLinearDeploymentStrategy:    "LINEAR",
+	manifest.ECSBlueGreenDeploymentStrategy: "BLUE_GREEN",
+}
+
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
@@ -835,6 +850,34 @@ func convertDeploymentConfig(in manifest.DeploymentConfig) template.DeploymentCo
 		CPUUtilization:    in.RollbackAlarms.Advanced.CPUUtilization,
 		MemoryUtilization: in.RollbackAlarms.Advanced.MemoryUtilization,
 	}
+	if in.Strategy == nil {
+		return out
+	}
+	strategy := strings.ToLower(aws.StringValue(in.Strategy))
+	out.Strategy = ecsDeploymentStrategies[strategy]
+	if in.BakeTime != nil {
+		out.BakeTimeInMinutes = aws.Int(int(in.BakeTime.Minutes()))
+	}
+	switch strategy {
+	case manifest.ECSCanaryDeploymentStrategy:
+		out.TrafficShift = convertTrafficShift(in.TrafficShift, canaryTrafficShiftPercentDefault, canaryTrafficShiftMinutesDefault)
+	case manifest.ECSLinearDeploymentStrategy:
+		out.TrafficShift = convertTrafficShift(in.TrafficShift, linearTrafficShiftPercentDefault, linearTrafficShiftMinutesDefault)
+	}
+	return out
+}
+
+func convertTrafficShift(in manifest.TrafficShift, defaultPercent, defaultMinutes int) *template.TrafficShiftOpts {
+	out := &template.TrafficShiftOpts{
+		Percent:           defaultPercent,
+		IntervalInMinutes: defaultMinutes,
+	}
+	if in.Percent != nil {
+		out.Percent = aws.IntValue(in.Percent)
+	}
+	if in.Interval != nil {
+		out.IntervalInMinutes = int(in.Interval.Minutes())
+	}
 	return out
 }
 
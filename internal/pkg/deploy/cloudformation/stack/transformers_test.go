@@ -2117,6 +2117,7 @@ func Test_convertSubscribe(t *testing.T) {
 }
 
 func Test_convertDeploymentConfig(t *testing.T) {
+	twoMinutes, fifteenMinutes := 2*time.Minute, 15*time.Minute
 	testCases := map[string]struct {
 		in  manifest.DeploymentConfig
 		out template.DeploymentConfigurationOpts
@@ -2178,6 +2179,50 @@ func Test_convertDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
+		"if 'canary' strategy indicated, populate with canary defaults": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("canary"),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Strategy:          "CANARY",
+				TrafficShift: &template.TrafficShiftOpts{
+					Percent:           10,
+					IntervalInMinutes: 5,
+				},
+			},
+		},
+		"if 'linear' strategy indicated, transform traffic shift and bake time": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("linear"),
+				TrafficShift: manifest.TrafficShift{
+					Percent:  aws.Int(20),
+					Interval: &twoMinutes,
+				},
+				BakeTime: &fifteenMinutes,
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Strategy:          "LINEAR",
+				TrafficShift: &template.TrafficShiftOpts{
+					Percent:           20,
+					IntervalInMinutes: 2,
+				},
+				BakeTimeInMinutes: aws.Int(15),
+			},
+		},
+		"if 'bluegreen' strategy indicated, shift all traffic at once": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String("bluegreen"),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Strategy:          "BLUE_GREEN",
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2239,6 +2284,15 @@ func Test_convertPlatform(t *testing.T) {
 		out template.RuntimePlatformOpts
 	}{
 		"should return empty struct if user did not set a platform field in the manifest": {},
+		"should return the first platform when the image is built for multiple platforms": {
+			in: manifest.PlatformArgsOrString{
+				PlatformList: []manifest.PlatformString{"linux/arm64", "linux/amd64"},
+			},
+			out: template.RuntimePlatformOpts{
+				OS:   template.OSLinux,
+				Arch: template.ArchARM64,
+			},
+		},
 		"should return windows server 2019 full and x86_64 when advanced config specifies 2019 full": {
 			in: manifest.PlatformArgsOrString{
 				PlatformArgs: manifest.PlatformArgs{
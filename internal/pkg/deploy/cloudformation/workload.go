@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 )
 
@@ -30,6 +31,28 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, bucketName strin
 	return cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, stack))
 }
 
+// PreviewService returns the changes that deploying the service stack would apply, without executing them.
+// If the service stack doesn't exist, returns a cloudformation.ErrStackNotFound.
+func (cf CloudFormation) PreviewService(conf StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (*cloudformation.ChangeSetDescription, error) {
+	templateURL, err := cf.uploadStackTemplateToS3(bucketName, conf)
+	if err != nil {
+		return nil, err
+	}
+	cfnStack, err := toStackFromS3(conf, templateURL)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(cfnStack)
+	}
+	return cf.previewChangeSet(cfnStack)
+}
+
+// WorkloadTemplate returns the template of a deployed workload stack.
+func (cf CloudFormation) WorkloadTemplate(app, env, name string) (string, error) {
+	return cf.cfnClient.TemplateBody(stack.NameForService(app, env, name))
+}
+
 type uploadableStack interface {
 	StackName() string
 	Template() (string, error)
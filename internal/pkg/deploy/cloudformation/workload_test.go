@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -106,6 +107,76 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_PreviewService(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+	}
+	testCases := map[string]struct {
+		createMock  func(ctrl *gomock.Controller) cfnClient
+		wanted      *cloudformation.ChangeSetDescription
+		wantedError error
+	}{
+		"returns the error if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PreviewChangeSet(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+			wantedError: &cloudformation.ErrStackNotFound{},
+		},
+		"returns an empty description if there are no changes": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PreviewChangeSet(gomock.Any()).Return(nil, &cloudformation.ErrChangeSetEmpty{})
+				return m
+			},
+			wanted: &cloudformation.ChangeSetDescription{},
+		},
+		"returns the changes of the previewed change set": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().PreviewChangeSet(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (*cloudformation.ChangeSetDescription, error) {
+					require.Equal(t, "myapp-myenv-mysvc", stack.Name)
+					require.Equal(t, "mockRoleARN", aws.StringValue(stack.RoleARN))
+					return &cloudformation.ChangeSetDescription{
+						Changes: []*sdkcloudformation.Change{{}},
+					}, nil
+				})
+				return m
+			},
+			wanted: &cloudformation.ChangeSetDescription{
+				Changes: []*sdkcloudformation.Change{{}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mS3Client := mocks.NewMocks3Client(ctrl)
+			mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("https://mockBucket.s3.amazonaws.com/template", nil)
+			client := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+				s3Client:  mS3Client,
+				console:   mockFileWriter{Writer: new(strings.Builder)},
+			}
+
+			// WHEN
+			got, err := client.PreviewService(serviceConfig, "mockBucket", cloudformation.WithRoleARN("mockRoleARN"))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	in := deploy.DeleteWorkloadInput{
 		Name:    "webhook",
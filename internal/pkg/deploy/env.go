@@ -13,7 +13,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.13.0"
+	LatestEnvTemplateVersion = "v1.21.0"
 	// EnvTemplateVersionBootstrap is the version of an environment template that contains only bootstrap resources.
 	EnvTemplateVersionBootstrap = "bootstrap"
 )
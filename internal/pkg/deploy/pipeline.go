@@ -22,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/dustin/go-humanize/english"
 )
 
 // DefaultPipelineBranch is the default repository branch to use for pipeline.
@@ -31,6 +32,9 @@ const (
 	fmtInvalidRepo           = "unable to parse the repository from the URL %+v"
 	fmtErrMissingProperty    = "missing `%s` in properties"
 	fmtErrPropertyNotAString = "property `%s` is not a string"
+	fmtErrPropertyNotAList   = "property `%s` is not a list of strings"
+
+	defaultECRSourceImageTag = "latest"
 
 	defaultPipelineBuildImage      = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
 	defaultPipelineEnvironmentType = "LINUX_CONTAINER"
@@ -46,6 +50,8 @@ var (
 	ccRepoExp = regexp.MustCompile(`(https:\/\/(?P<region>.+).console.aws.amazon.com\/codesuite\/codecommit\/repositories\/(?P<repo>.+)(\/browse))`)
 	// Ex: https://bitbucket.org/repoOwner/repoName
 	bbRepoExp = regexp.MustCompile(`(https:\/\/bitbucket.org\/)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Ex: https://gitlab.com/repoOwner/repoName or https://gitlab.com/group/subgroup/repoName
+	glRepoExp = regexp.MustCompile(`(https:\/\/gitlab.com\/)(?P<owner>.+)\/(?P<repo>.+)`)
 )
 
 // CreatePipelineInput represents the fields required to deploy a pipeline.
@@ -157,6 +163,7 @@ type GitHubSource struct {
 	RepositoryURL        GitHubURL
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Trigger              *GitTrigger
 }
 
 // GitHubURL is the common type for repo URLs for both GitHubSource versions:
@@ -178,6 +185,45 @@ type BitbucketSource struct {
 	RepositoryURL        string
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Trigger              *GitTrigger
+}
+
+// GitLabSource defines the (GL) source of the artifacts to be built and deployed.
+type GitLabSource struct {
+	ProviderName         string
+	Branch               string
+	RepositoryURL        string
+	ConnectionARN        string
+	OutputArtifactFormat string
+	Trigger              *GitTrigger
+}
+
+// GitTrigger defines the pushes to a CodeStar Connections source that start the pipeline.
+// If a source has no trigger, the pipeline is started by every push to the source branch.
+type GitTrigger struct {
+	Branches  []string // Glob patterns of the branches pushed to.
+	FilePaths []string // Glob patterns of the files changed by the push. Empty if any change starts the pipeline.
+}
+
+// S3Source defines the source of the artifacts to be built and deployed as an object in an S3 bucket.
+// The pipeline is triggered when a new version of the object is uploaded.
+type S3Source struct {
+	ProviderName string
+	Bucket       string
+	ObjectKey    string
+}
+
+// ECRSource defines the source of the artifacts to be built and deployed as an image in an ECR repository.
+// The pipeline is triggered when the image tag is pushed.
+// The source artifact of the image only contains imageDetail.json, so the workspace, packaged
+// at deploy time, is provided to the build with a second source action.
+type ECRSource struct {
+	ProviderName   string
+	RepositoryName string
+	ImageTag       string
+
+	WorkspaceBucket    string
+	WorkspaceObjectKey string
 }
 
 func convertRequiredProperty(properties map[string]interface{}, key string) (string, error) {
@@ -204,9 +250,94 @@ func convertOptionalProperty(properties map[string]interface{}, key string, defa
 	return vStr, nil
 }
 
+func convertOptionalListProperty(properties map[string]interface{}, key string) ([]string, error) {
+	v, ok := properties[key]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf(fmtErrPropertyNotAList, key)
+	}
+	var list []string
+	for _, item := range items {
+		str, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf(fmtErrPropertyNotAList, key)
+		}
+		list = append(list, str)
+	}
+	return list, nil
+}
+
+// convertTrigger returns the trigger filters of a source, or nil if the pipeline should run on every push to branch.
+func convertTrigger(properties map[string]interface{}, branch string) (*GitTrigger, error) {
+	branches, err := convertOptionalListProperty(properties, "branches")
+	if err != nil {
+		return nil, err
+	}
+	paths, err := convertOptionalListProperty(properties, "paths")
+	if err != nil {
+		return nil, err
+	}
+	if len(branches) == 0 && len(paths) == 0 {
+		return nil, nil
+	}
+	if len(branches) == 0 {
+		branches = []string{branch}
+	}
+	return &GitTrigger{
+		Branches:  branches,
+		FilePaths: paths,
+	}, nil
+}
+
 // PipelineSourceFromManifest processes manifest info about the source based on provider type.
 // The return boolean is true for CodeStar Connections sources that require a polling prompt.
 func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{}, shouldPrompt bool, err error) {
+	_, hasBranches := mfSource.Properties["branches"]
+	_, hasPaths := mfSource.Properties["paths"]
+	if (hasBranches || hasPaths) && (!mfSource.IsCodeStarConnection() || mfSource.Properties["access_token_secret"] != nil) {
+		return nil, false, errors.New("properties `branches` and `paths` are only supported by GitHub, Bitbucket and GitLab sources")
+	}
+	switch mfSource.ProviderName {
+	case manifest.S3ProviderName:
+		bucket, err := convertRequiredProperty(mfSource.Properties, "bucket")
+		if err != nil {
+			return nil, false, err
+		}
+		key, err := convertRequiredProperty(mfSource.Properties, "object_key")
+		if err != nil {
+			return nil, false, err
+		}
+		return &S3Source{
+			ProviderName: manifest.S3ProviderName,
+			Bucket:       bucket,
+			ObjectKey:    key,
+		}, false, nil
+	case manifest.ECRProviderName:
+		repository, err := convertRequiredProperty(mfSource.Properties, "repository")
+		if err != nil {
+			return nil, false, err
+		}
+		// The ECR source action can only read repositories in the account and region of the pipeline,
+		// which are referred to by their name rather than by their ARN or URI.
+		if strings.HasPrefix(repository, "arn:") || strings.Contains(repository, ".dkr.ecr.") {
+			return nil, false, fmt.Errorf("repository %q of the ECR source must be the name of a repository in the same account and region as the pipeline", repository)
+		}
+		tag, err := convertOptionalProperty(mfSource.Properties, "tag", defaultECRSourceImageTag)
+		if err != nil {
+			return nil, false, err
+		}
+		if tag == "" {
+			tag = defaultECRSourceImageTag
+		}
+		return &ECRSource{
+			ProviderName:   manifest.ECRProviderName,
+			RepositoryName: repository,
+			ImageTag:       tag,
+		}, false, nil
+	}
 	branch, err := convertOptionalProperty(mfSource.Properties, "branch", DefaultPipelineBranch)
 	if err != nil {
 		return nil, false, err
@@ -219,6 +350,10 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 	if err != nil {
 		return nil, false, err
 	}
+	trigger, err := convertTrigger(mfSource.Properties, branch)
+	if err != nil {
+		return nil, false, err
+	}
 	switch mfSource.ProviderName {
 	case manifest.GithubV1ProviderName:
 		token, err := convertRequiredProperty(mfSource.Properties, "access_token_secret")
@@ -250,6 +385,7 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 				Branch:               branch,
 				RepositoryURL:        GitHubURL(repository),
 				OutputArtifactFormat: outputFormat,
+				Trigger:              trigger,
 			}
 			if !ok {
 				return repo, true, nil
@@ -272,6 +408,22 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 			Branch:               branch,
 			RepositoryURL:        repository,
 			OutputArtifactFormat: outputFormat,
+			Trigger:              trigger,
+		}
+		if !ok {
+			return repo, true, nil
+		}
+		repo.ConnectionARN = connection.(string)
+		return repo, false, nil
+	case manifest.GitLabProviderName:
+		// If an existing CSC connection is being used, don't prompt to update connection from 'PENDING' to 'AVAILABLE'.
+		connection, ok := mfSource.Properties["connection_arn"]
+		repo := &GitLabSource{
+			ProviderName:         manifest.GitLabProviderName,
+			Branch:               branch,
+			RepositoryURL:        repository,
+			OutputArtifactFormat: outputFormat,
+			Trigger:              trigger,
 		}
 		if !ok {
 			return repo, true, nil
@@ -293,6 +445,32 @@ func (s *GitHubV1Source) GitHubPersonalAccessTokenSecretID() (string, error) {
 	return s.PersonalAccessTokenSecretID, nil
 }
 
+// TriggerRules returns a description of the pushes that start the pipeline.
+func (s *BitbucketSource) TriggerRules() string {
+	return s.Trigger.rules(s.Branch)
+}
+
+// TriggerRules returns a description of the pushes that start the pipeline.
+func (s *GitHubSource) TriggerRules() string {
+	return s.Trigger.rules(s.Branch)
+}
+
+// TriggerRules returns a description of the pushes that start the pipeline.
+func (s *GitLabSource) TriggerRules() string {
+	return s.Trigger.rules(s.Branch)
+}
+
+func (t *GitTrigger) rules(branch string) string {
+	if t == nil {
+		return fmt.Sprintf("every push to branch %s", branch)
+	}
+	rules := fmt.Sprintf("pushes to %s %s", english.PluralWord(len(t.Branches), "branch", "branches"), english.WordSeries(t.Branches, "or"))
+	if len(t.FilePaths) == 0 {
+		return rules
+	}
+	return fmt.Sprintf("%s that change files matching %s", rules, english.WordSeries(t.FilePaths, "or"))
+}
+
 // Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
 func (s *BitbucketSource) Connection() string {
 	return s.ConnectionARN
@@ -303,6 +481,11 @@ func (s *GitHubSource) Connection() string {
 	return s.ConnectionARN
 }
 
+// Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
+func (s *GitLabSource) Connection() string {
+	return s.ConnectionARN
+}
+
 // parse parses the owner and repo name from the GH repo URL, which was formatted and assigned in cli/pipeline_init.go.
 func (url GitHubURL) parse() (owner, repo string, err error) {
 	if url == "" {
@@ -364,6 +547,27 @@ func (s *BitbucketSource) parseOwnerAndRepo() (owner, repo string, err error) {
 	return matches["owner"], matches["repo"], nil
 }
 
+// parseOwnerAndRepo parses the owner and repo name from the GL repo URL, which was formatted and assigned in cli/pipeline_init.go.
+// The owner of a repository in a subgroup is the full path of the subgroup, such as "group/subgroup".
+func (s *GitLabSource) parseOwnerAndRepo() (owner, repo string, err error) {
+	if s.RepositoryURL == "" {
+		return "", "", fmt.Errorf("unable to locate the repository")
+	}
+
+	match := glRepoExp.FindStringSubmatch(s.RepositoryURL)
+	if len(match) == 0 {
+		return "", "", fmt.Errorf(fmtInvalidRepo, s.RepositoryURL)
+	}
+
+	matches := make(map[string]string)
+	for i, name := range glRepoExp.SubexpNames() {
+		if i != 0 && name != "" {
+			matches[name] = match[i]
+		}
+	}
+	return matches["owner"], matches["repo"], nil
+}
+
 // ConnectionName generates a string of maximum length 32 to be used as a CodeStar Connections ConnectionName.
 // If there is a duplicate ConnectionName generated by CFN, the previous one is replaced. (Duplicate names
 // generated by the aws cli don't have to be unique for some reason.)
@@ -392,6 +596,15 @@ func (s *GitHubSource) ConnectionName() (string, error) {
 	return formatConnectionName(owner, repo), nil
 }
 
+// ConnectionName generates a recognizable string by which the connection may be identified.
+func (s *GitLabSource) ConnectionName() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", fmt.Errorf("parse owner and repo to generate connection name: %w", err)
+	}
+	return formatConnectionName(strings.ReplaceAll(owner, "/", "-"), repo), nil
+}
+
 func formatConnectionName(owner, repo string) string {
 	if len(owner) > maxOwnerLength {
 		owner = owner[:maxOwnerLength]
@@ -432,6 +645,16 @@ func (s *GitHubSource) Repository() (string, error) {
 	return fmt.Sprintf("%s/%s", owner, repo), nil
 }
 
+// Repository returns the repository portion. For CodeStar Connections,
+// this needs to be in the format "some-user/my-repo."
+func (s *GitLabSource) Repository() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", owner, repo), nil
+}
+
 // Repository returns the repository portion. For example,
 // given "aws/amazon-copilot", this function returns "amazon-copilot".
 func (s *CodeCommitSource) Repository() (string, error) {
@@ -479,15 +702,19 @@ type associatedEnvironment struct {
 
 // PipelineStage represents configuration for each deployment stage
 // of a workspace. A stage consists of the Config Environment the pipeline
-// is deploying to, the containerized services that will be deployed, and
-// test commands, if the user has opted to add any.
+// is deploying to, the containerized services that will be deployed, the
+// actions to run before and after the deployments, and test commands,
+// if the user has opted to add any.
 type PipelineStage struct {
 	*associatedEnvironment
 	requiresApproval  bool
 	testCommands      []string
 	execRoleARN       string
 	envManagerRoleARN string
+	preDeployments    manifest.PrePostDeployments
 	deployments       manifest.Deployments
+	postDeployments   manifest.PrePostDeployments
+	colocated         bool
 }
 
 // Init populates the fields in PipelineStage against a target environment,
@@ -509,12 +736,25 @@ func (stg *PipelineStage) Init(env *config.Environment, mftStage *manifest.Pipel
 	}
 
 	stg.deployments = deployments
+	stg.preDeployments = mftStage.PreDeployments
+	stg.postDeployments = mftStage.PostDeployments
 	stg.requiresApproval = mftStage.RequiresApproval
 	stg.testCommands = mftStage.TestCommands
 	stg.execRoleARN = env.ExecutionRoleARN
 	stg.envManagerRoleARN = env.ManagerRoleARN
 }
 
+// SetPipelineLocation records whether the stage's environment is in the same account and region
+// as the pipeline, so that its pre and post deployment actions can run in the environment's VPC.
+func (stg *PipelineStage) SetPipelineLocation(accountID, region string) {
+	stg.colocated = stg.associatedEnvironment.AccountID == accountID && stg.associatedEnvironment.Region == region
+}
+
+// IsColocated returns true if the stage's environment is in the same account and region as the pipeline.
+func (stg *PipelineStage) IsColocated() bool {
+	return stg.colocated
+}
+
 // Name returns the stage's name.
 func (stg *PipelineStage) Name() string {
 	return stg.associatedEnvironment.Name
@@ -546,7 +786,7 @@ func (stg *PipelineStage) EnvManagerRoleARN() string {
 	return stg.envManagerRoleARN
 }
 
-// Test returns a test for the stage.
+// Test returns a test for the stage, which runs after the deployments and post-deployments.
 // If the stage does not have any test commands, then returns nil.
 func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 	if len(stg.testCommands) == 0 {
@@ -561,6 +801,17 @@ func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 	for i := range deployActions {
 		prevActions = append(prevActions, &deployActions[i])
 	}
+	postActions, err := stg.PostDeployments()
+	if err != nil {
+		return nil, err
+	}
+	if len(postActions) > 0 {
+		// The tests run once the post-deployments are done.
+		prevActions = nil
+		for i := range postActions {
+			prevActions = append(prevActions, &postActions[i])
+		}
+	}
 
 	return &TestCommandsAction{
 		action: action{
@@ -570,12 +821,52 @@ func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 	}, nil
 }
 
+// PreDeployments returns a list of actions to run before the deploy actions of the stage.
+func (stg *PipelineStage) PreDeployments() ([]PrePostDeployAction, error) {
+	var prevActions []orderedRunner
+	if approval := stg.Approval(); approval != nil {
+		prevActions = append(prevActions, approval)
+	}
+	actions, err := stg.prePostDeployActions(stg.preDeployments, prevActions)
+	if err != nil {
+		return nil, fmt.Errorf("find an ordering for pre-deployments: %v", err)
+	}
+	return actions, nil
+}
+
+// PostDeployments returns a list of actions to run after the deploy actions of the stage.
+func (stg *PipelineStage) PostDeployments() ([]PrePostDeployAction, error) {
+	deployActions, err := stg.Deployments()
+	if err != nil {
+		return nil, err
+	}
+	var prevActions []orderedRunner
+	for i := range deployActions {
+		prevActions = append(prevActions, &deployActions[i])
+	}
+	actions, err := stg.prePostDeployActions(stg.postDeployments, prevActions)
+	if err != nil {
+		return nil, fmt.Errorf("find an ordering for post-deployments: %v", err)
+	}
+	return actions, nil
+}
+
 // Deployments returns a list of deploy actions for the pipeline.
 func (stg *PipelineStage) Deployments() ([]DeployAction, error) {
 	var prevActions []orderedRunner
 	if approval := stg.Approval(); approval != nil {
 		prevActions = append(prevActions, approval)
 	}
+	preActions, err := stg.PreDeployments()
+	if err != nil {
+		return nil, err
+	}
+	if len(preActions) > 0 {
+		prevActions = nil
+		for i := range preActions {
+			prevActions = append(prevActions, &preActions[i])
+		}
+	}
 
 	topo, err := graph.TopologicalOrder(stg.buildDeploymentsGraph())
 	if err != nil {
@@ -602,6 +893,45 @@ func (stg *PipelineStage) Deployments() ([]DeployAction, error) {
 	return actions, nil
 }
 
+func (stg *PipelineStage) prePostDeployActions(confs manifest.PrePostDeployments, prevActions []orderedRunner) ([]PrePostDeployAction, error) {
+	var names []string
+	for name := range confs {
+		names = append(names, name)
+	}
+	digraph := graph.New(names...)
+	for name, conf := range confs {
+		if conf == nil {
+			continue
+		}
+		for _, dependency := range conf.DependsOn {
+			digraph.Add(graph.Edge[string]{
+				From: dependency, // Dependency must be completed before name.
+				To:   name,
+			})
+		}
+	}
+	topo, err := graph.TopologicalOrder(digraph)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []PrePostDeployAction
+	for name, conf := range confs {
+		actions = append(actions, PrePostDeployAction{
+			action: action{
+				prevActions: prevActions,
+			},
+			name:   name,
+			conf:   conf,
+			ranker: topo,
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Name() < actions[j].Name()
+	})
+	return actions, nil
+}
+
 func (stg *PipelineStage) buildDeploymentsGraph() *graph.Graph[string] {
 	var names []string
 	for name := range stg.deployments {
@@ -709,6 +1039,52 @@ func (a *DeployAction) RunOrder() int {
 	return a.action.RunOrder() /* baseline */ + rank
 }
 
+// PrePostDeployAction represents a CodePipeline action of category "Build" that runs a buildspec
+// before or after the deployments of a stage.
+type PrePostDeployAction struct {
+	action
+
+	name string
+	conf *manifest.PrePostDeployment
+
+	ranker ranker // Interface to rank this action against others of the same kind in the stage.
+}
+
+// Name returns the name of the CodePipeline action.
+func (a *PrePostDeployAction) Name() string {
+	return a.name
+}
+
+// BuildspecPath returns the path of the buildspec file in the source repository.
+func (a *PrePostDeployAction) BuildspecPath() string {
+	if a.conf == nil {
+		return ""
+	}
+	return a.conf.BuildspecPath
+}
+
+// Image returns the URI of the Docker image used by the CodeBuild project of the action.
+func (a *PrePostDeployAction) Image() string {
+	if a.conf == nil || a.conf.Image == "" {
+		return defaultPipelineBuildImage
+	}
+	return a.conf.Image
+}
+
+// Variables returns the environment variables set in the CodeBuild project of the action.
+func (a *PrePostDeployAction) Variables() map[string]string {
+	if a.conf == nil {
+		return nil
+	}
+	return a.conf.Variables
+}
+
+// RunOrder returns the order in which the action should run.
+func (a *PrePostDeployAction) RunOrder() int {
+	rank, _ := a.ranker.Rank(a.name) // The action is guaranteed to be in the ranker.
+	return a.action.RunOrder() /* baseline */ + rank
+}
+
 // TestCommandsAction represents a CodePipeline action of category "Test" to validate deployments.
 type TestCommandsAction struct {
 	action
@@ -131,6 +131,140 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          nil,
 		},
+		"transforms Bitbucket source with trigger filters": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.BitbucketProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+					"paths":      []interface{}{"svc-a/**", "shared/**"},
+				},
+			},
+			expectedDeploySource: &BitbucketSource{
+				ProviderName:  manifest.BitbucketProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				Trigger: &GitTrigger{
+					Branches:  []string{"test"},
+					FilePaths: []string{"svc-a/**", "shared/**"},
+				},
+			},
+			expectedShouldPrompt: true,
+		},
+		"error out if the trigger filters are not a list of strings": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.BitbucketProviderName,
+				Properties: map[string]interface{}{
+					"repository": "some/repository/URL",
+					"branches":   "release/*",
+				},
+			},
+			expectedErr: errors.New("property `branches` is not a list of strings"),
+		},
+		"error out if the source does not support trigger filters": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.CodeCommitProviderName,
+				Properties: map[string]interface{}{
+					"repository": "some/repository/URL",
+					"paths":      []interface{}{"svc-a/**"},
+				},
+			},
+			expectedErr: errors.New("properties `branches` and `paths` are only supported by GitHub, Bitbucket and GitLab sources"),
+		},
+		"transforms GitLab source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+			},
+			expectedShouldPrompt: true,
+		},
+		"transforms GitLab source with existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "some/repository/URL",
+					"connection_arn": "garnARN",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				ConnectionARN: "garnARN",
+			},
+			expectedShouldPrompt: false,
+		},
+		"transforms S3 source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"bucket":     "my-bucket",
+					"object_key": "source/frontend.zip",
+				},
+			},
+			expectedDeploySource: &S3Source{
+				ProviderName: manifest.S3ProviderName,
+				Bucket:       "my-bucket",
+				ObjectKey:    "source/frontend.zip",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error out if the object key of an S3 source is not configured": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"bucket": "my-bucket",
+				},
+			},
+			expectedErr: errors.New("missing `object_key` in properties"),
+		},
+		"transforms ECR source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "shared/frontend",
+					"tag":        "release",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName:   manifest.ECRProviderName,
+				RepositoryName: "shared/frontend",
+				ImageTag:       "release",
+			},
+			expectedShouldPrompt: false,
+		},
+		"use default tag `latest` if the tag of an ECR source is not configured": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "shared/frontend",
+				},
+			},
+			expectedDeploySource: &ECRSource{
+				ProviderName:   manifest.ECRProviderName,
+				RepositoryName: "shared/frontend",
+				ImageTag:       "latest",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error if the repository of an ECR source is not a name": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.ECRProviderName,
+				Properties: map[string]interface{}{
+					"repository": "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend",
+				},
+			},
+			expectedErr: errors.New(`repository "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend" of the ECR source must be the name of a repository in the same account and region as the pipeline`),
+		},
 		"transforms CodeCommit source": {
 			mfSource: &manifest.Source{
 				ProviderName: manifest.CodeCommitProviderName,
@@ -352,6 +486,89 @@ func TestParseOwnerAndRepo(t *testing.T) {
 	}
 }
 
+func TestGitLabSource_Repository(t *testing.T) {
+	testCases := map[string]struct {
+		src                  *GitLabSource
+		wantedErr            error
+		wantedRepository     string
+		wantedConnectionName string
+	}{
+		"missing repository property": {
+			src:       &GitLabSource{},
+			wantedErr: errors.New("unable to locate the repository"),
+		},
+		"unable to parse repository name from URL": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.example.com/chicken/wings",
+			},
+			wantedErr: errors.New("unable to parse the repository from the URL https://gitlab.example.com/chicken/wings"),
+		},
+		"valid full GL repository name": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/chicken/wings",
+			},
+			wantedRepository:     "chicken/wings",
+			wantedConnectionName: "copilot-chick-wings",
+		},
+		"valid GL repository in a subgroup": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/farm/poultry/wings",
+			},
+			wantedRepository:     "farm/poultry/wings",
+			wantedConnectionName: "copilot-farm--wings",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, err := tc.src.Repository()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRepository, repo)
+			name, err := tc.src.ConnectionName()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConnectionName, name)
+		})
+	}
+}
+
+func TestGitHubSource_TriggerRules(t *testing.T) {
+	testCases := map[string]struct {
+		trigger *GitTrigger
+		wanted  string
+	}{
+		"every push to the branch without trigger filters": {
+			wanted: "every push to branch main",
+		},
+		"pushes to branches without path filters": {
+			trigger: &GitTrigger{
+				Branches: []string{"main", "release/*"},
+			},
+			wanted: "pushes to branches main or release/*",
+		},
+		"pushes that change files matching paths": {
+			trigger: &GitTrigger{
+				Branches:  []string{"main"},
+				FilePaths: []string{"svc-a/**", "shared/**"},
+			},
+			wanted: "pushes to branch main that change files matching svc-a/** or shared/**",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			src := &GitHubSource{
+				Branch:  "main",
+				Trigger: tc.trigger,
+			}
+			require.Equal(t, tc.wanted, src.TriggerRules())
+		})
+	}
+}
+
 func TestPipelineStage_Init(t *testing.T) {
 	var stg PipelineStage
 	stg.Init(&config.Environment{
@@ -392,6 +609,50 @@ func TestPipelineStage_Init(t *testing.T) {
 	})
 }
 
+func TestPipelineStage_SetPipelineLocation(t *testing.T) {
+	testCases := map[string]struct {
+		inAccountID string
+		inRegion    string
+
+		wanted bool
+	}{
+		"colocated if the pipeline is in the same account and region as the environment": {
+			inAccountID: "123456789012",
+			inRegion:    "us-west-2",
+			wanted:      true,
+		},
+		"not colocated if the pipeline is in a different account": {
+			inAccountID: "210987654321",
+			inRegion:    "us-west-2",
+		},
+		"not colocated if the pipeline is in a different region": {
+			inAccountID: "123456789012",
+			inRegion:    "us-east-1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var stg PipelineStage
+			stg.Init(&config.Environment{
+				Name:      "test",
+				App:       "badgoose",
+				Region:    "us-west-2",
+				AccountID: "123456789012",
+			}, &manifest.PipelineStage{
+				Name: "test",
+			}, nil)
+
+			// WHEN
+			stg.SetPipelineLocation(tc.inAccountID, tc.inRegion)
+
+			// THEN
+			require.Equal(t, tc.wanted, stg.IsColocated())
+		})
+	}
+}
+
 func TestPipelineStage_Deployments(t *testing.T) {
 	testCases := map[string]struct {
 		stg *PipelineStage
@@ -463,6 +724,37 @@ func TestPipelineStage_Deployments(t *testing.T) {
 			},
 			wantedTemplateOrder: []string{"CreateOrUpdate-a-test", "CreateOrUpdate-b-test", "CreateOrUpdate-c-test", "CreateOrUpdate-d-test"},
 		},
+		"deployments should run after the pre-deployments": {
+			stg: func() *PipelineStage {
+				// Create a pipeline with a manual approval, 2 ordered pre-deployments and 2 deployments.
+				var stg PipelineStage
+				stg.Init(&config.Environment{Name: "test"}, &manifest.PipelineStage{
+					Name:             "test",
+					RequiresApproval: true,
+					PreDeployments: map[string]*manifest.PrePostDeployment{
+						"backup": {
+							BuildspecPath: "copilot/backup/buildspec.yml",
+						},
+						"migrate": {
+							BuildspecPath: "copilot/migrate/buildspec.yml",
+							DependsOn:     []string{"backup"},
+						},
+					},
+					Deployments: map[string]*manifest.Deployment{
+						"frontend": {
+							DependsOn: []string{"api"},
+						},
+						"api": nil,
+					},
+				}, nil)
+
+				return &stg
+			}(),
+			wantedRunOrder: map[string]int{
+				"CreateOrUpdate-api-test":      4,
+				"CreateOrUpdate-frontend-test": 5,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -486,6 +778,188 @@ func TestPipelineStage_Deployments(t *testing.T) {
 	}
 }
 
+func TestPipelineStage_PrePostDeployments(t *testing.T) {
+	testCases := map[string]struct {
+		mftStage *manifest.PipelineStage
+
+		wantedPreRunOrder  map[string]int
+		wantedPostRunOrder map[string]int
+		wantedPreErr       error
+		wantedPostErr      error
+	}{
+		"should return an error when the pre-deployments contain a cycle": {
+			mftStage: &manifest.PipelineStage{
+				Name: "test",
+				PreDeployments: map[string]*manifest.PrePostDeployment{
+					"migrate": {
+						DependsOn: []string{"migrate"},
+					},
+				},
+			},
+			wantedPreErr:  errors.New("find an ordering for pre-deployments: graph contains a cycle: migrate"),
+			wantedPostErr: errors.New("find an ordering for pre-deployments: graph contains a cycle: migrate"),
+		},
+		"should return an error when the post-deployments contain a cycle": {
+			mftStage: &manifest.PipelineStage{
+				Name: "test",
+				PostDeployments: map[string]*manifest.PrePostDeployment{
+					"smoke": {
+						DependsOn: []string{"smoke"},
+					},
+				},
+			},
+			wantedPostErr: errors.New("find an ordering for post-deployments: graph contains a cycle: smoke"),
+		},
+		"should rank the actions alongside the approval and the deployments": {
+			mftStage: &manifest.PipelineStage{
+				Name:             "test",
+				RequiresApproval: true,
+				PreDeployments: map[string]*manifest.PrePostDeployment{
+					"migrate": {
+						BuildspecPath: "copilot/migrate/buildspec.yml",
+					},
+				},
+				Deployments: map[string]*manifest.Deployment{
+					"frontend": {
+						DependsOn: []string{"api"},
+					},
+					"api": nil,
+				},
+				PostDeployments: map[string]*manifest.PrePostDeployment{
+					"warmup": {
+						BuildspecPath: "copilot/warmup/buildspec.yml",
+					},
+					"smoke": {
+						BuildspecPath: "copilot/smoke/buildspec.yml",
+						DependsOn:     []string{"warmup"},
+					},
+				},
+			},
+			wantedPreRunOrder: map[string]int{
+				"migrate": 2,
+			},
+			wantedPostRunOrder: map[string]int{
+				"warmup": 5,
+				"smoke":  6,
+			},
+		},
+		"should run the pre-deployments first when the stage does not require approval": {
+			mftStage: &manifest.PipelineStage{
+				Name: "test",
+				PreDeployments: map[string]*manifest.PrePostDeployment{
+					"migrate": {
+						BuildspecPath: "copilot/migrate/buildspec.yml",
+					},
+				},
+			},
+			wantedPreRunOrder: map[string]int{
+				"migrate": 1,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var stg PipelineStage
+			stg.Init(&config.Environment{Name: "test"}, tc.mftStage, []string{"api"})
+
+			pre, err := stg.PreDeployments()
+			if tc.wantedPreErr != nil {
+				require.EqualError(t, err, tc.wantedPreErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, pre, len(tc.wantedPreRunOrder))
+				for _, a := range pre {
+					require.Equal(t, tc.wantedPreRunOrder[a.Name()], a.RunOrder(), "order for pre-deployment %s does not match", a.Name())
+				}
+			}
+
+			post, err := stg.PostDeployments()
+			if tc.wantedPostErr != nil {
+				require.EqualError(t, err, tc.wantedPostErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, post, len(tc.wantedPostRunOrder))
+				for _, a := range post {
+					require.Equal(t, tc.wantedPostRunOrder[a.Name()], a.RunOrder(), "order for post-deployment %s does not match", a.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestPipelineStage_Test(t *testing.T) {
+	testCases := map[string]struct {
+		mftStage *manifest.PipelineStage
+
+		wantedRunOrder int
+		wantedErr      error
+	}{
+		"should return nil when there are no test commands": {
+			mftStage: &manifest.PipelineStage{
+				Name: "test",
+			},
+		},
+		"should return an error when the post-deployments contain a cycle": {
+			mftStage: &manifest.PipelineStage{
+				Name:         "test",
+				TestCommands: []string{"make test"},
+				PostDeployments: map[string]*manifest.PrePostDeployment{
+					"smoke": {
+						DependsOn: []string{"smoke"},
+					},
+				},
+			},
+			wantedErr: errors.New("find an ordering for post-deployments: graph contains a cycle: smoke"),
+		},
+		"should run the tests after the deployments": {
+			mftStage: &manifest.PipelineStage{
+				Name:             "test",
+				RequiresApproval: true,
+				TestCommands:     []string{"make test"},
+			},
+			wantedRunOrder: 3,
+		},
+		"should run the tests after the post-deployments": {
+			mftStage: &manifest.PipelineStage{
+				Name:             "test",
+				RequiresApproval: true,
+				TestCommands:     []string{"make test"},
+				PostDeployments: map[string]*manifest.PrePostDeployment{
+					"warmup": {
+						BuildspecPath: "copilot/warmup/buildspec.yml",
+					},
+					"smoke": {
+						BuildspecPath: "copilot/smoke/buildspec.yml",
+						DependsOn:     []string{"warmup"},
+					},
+				},
+			},
+			wantedRunOrder: 5,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var stg PipelineStage
+			stg.Init(&config.Environment{Name: "test"}, tc.mftStage, []string{"api"})
+
+			test, err := stg.Test()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedRunOrder == 0 {
+				require.Nil(t, test)
+				return
+			}
+			require.Equal(t, tc.wantedRunOrder, test.RunOrder())
+		})
+	}
+}
+
 type mockAction struct {
 	order int
 }
@@ -655,6 +1129,54 @@ func TestDeployAction_RunOrder(t *testing.T) {
 	require.Equal(t, 6, in.RunOrder(), "should be past actions + 1 + rank")
 }
 
+func TestPrePostDeployAction_Image(t *testing.T) {
+	testCases := map[string]struct {
+		in     PrePostDeployAction
+		wanted string
+	}{
+		"should default to the pipeline build image": {
+			in: PrePostDeployAction{
+				conf: &manifest.PrePostDeployment{
+					BuildspecPath: "copilot/migrate/buildspec.yml",
+				},
+			},
+			wanted: defaultPipelineBuildImage,
+		},
+		"should use the image from the manifest": {
+			in: PrePostDeployAction{
+				conf: &manifest.PrePostDeployment{
+					BuildspecPath: "copilot/migrate/buildspec.yml",
+					Image:         "aws/codebuild/standard:6.0",
+				},
+			},
+			wanted: "aws/codebuild/standard:6.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Image())
+		})
+	}
+}
+
+func TestPrePostDeployAction_RunOrder(t *testing.T) {
+	// GIVEN
+	ranker := mockRanker{rank: 1}
+	past := []orderedRunner{
+		mockAction{
+			order: 4,
+		},
+	}
+	in := PrePostDeployAction{
+		action: action{prevActions: past},
+		ranker: ranker,
+	}
+
+	// THEN
+	require.Equal(t, 6, in.RunOrder(), "should be past actions + 1 + rank")
+}
+
 func TestTestCommandsAction_Name(t *testing.T) {
 	require.Equal(t, "TestCommands", (&TestCommandsAction{}).Name())
 }
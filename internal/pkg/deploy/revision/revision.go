@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package revision records the successful deployments of a workload so that they can be redeployed.
+package revision
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+)
+
+// Revision is a successful deployment of a workload stack.
+type Revision struct {
+	Number     int               `json:"number"`
+	DeployedAt time.Time         `json:"deployedAt"`
+	Template   string            `json:"template"`
+	Parameters map[string]string `json:"parameters"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	Images     map[string]string `json:"images,omitempty"`     // Container name to image digest.
+	RolledBack int               `json:"rolledBack,omitempty"` // Number of the revision redeployed by a rollback, 0 otherwise.
+}
+
+// NewRevision returns a revision that can redeploy the template, parameters and tags of a stack configuration.
+func NewRevision(conf StackConfiguration, images map[string]string) (*Revision, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate template of stack %s: %w", conf.StackName(), err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate parameters of stack %s: %w", conf.StackName(), err)
+	}
+	rev := &Revision{
+		Template:   tpl,
+		Parameters: make(map[string]string, len(params)),
+		Images:     images,
+	}
+	for _, param := range params {
+		rev.Parameters[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	for _, tag := range conf.Tags() {
+		if rev.Tags == nil {
+			rev.Tags = make(map[string]string)
+		}
+		rev.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return rev, nil
+}
+
+// StackConfiguration is the subset of a CloudFormation stack configuration recorded in a revision.
+type StackConfiguration interface {
+	StackName() string
+	Template() (string, error)
+	Parameters() ([]*cloudformation.Parameter, error)
+	Tags() []*cloudformation.Tag
+}
+
+// Stack is the configuration to redeploy a revision to a CloudFormation stack.
+type Stack struct {
+	name     string
+	revision *Revision
+}
+
+// Stack returns the configuration to redeploy the revision to the stack with the given name.
+func (r *Revision) Stack(name string) *Stack {
+	return &Stack{
+		name:     name,
+		revision: r,
+	}
+}
+
+// StackName returns the name of the CloudFormation stack.
+func (s *Stack) StackName() string {
+	return s.name
+}
+
+// Template returns the recorded template of the revision.
+func (s *Stack) Template() (string, error) {
+	return s.revision.Template, nil
+}
+
+// Parameters returns the recorded parameter values of the revision.
+func (s *Stack) Parameters() ([]*cloudformation.Parameter, error) {
+	params := make([]*cloudformation.Parameter, 0, len(s.revision.Parameters))
+	for _, key := range sortedKeys(s.revision.Parameters) {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(s.revision.Parameters[key]),
+		})
+	}
+	return params, nil
+}
+
+// Tags returns the recorded tags of the revision.
+func (s *Stack) Tags() []*cloudformation.Tag {
+	tags := make([]*cloudformation.Tag, 0, len(s.revision.Tags))
+	for _, key := range sortedKeys(s.revision.Tags) {
+		tags = append(tags, &cloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(s.revision.Tags[key]),
+		})
+	}
+	return tags
+}
+
+// SerializedParameters returns the recorded parameters and tags of the revision serialized to a JSON document.
+func (s *Stack) SerializedParameters() (string, error) {
+	config := struct {
+		Parameters map[string]string `json:"Parameters"`
+		Tags       map[string]string `json:"Tags,omitempty"`
+	}{
+		Parameters: s.revision.Parameters,
+		Tags:       s.revision.Tags,
+	}
+	str, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal stack parameters to JSON: %v", err)
+	}
+	return string(str), nil
+}
+
+// ErrNotFound is returned when a revision doesn't exist.
+type ErrNotFound struct {
+	Workload string
+	Env      string
+	Number   int
+}
+
+func (e *ErrNotFound) Error() string {
+	return fmt.Sprintf("revision %d of %s in environment %s not found", e.Number, e.Workload, e.Env)
+}
+
+// maxRecordAttempts is the number of revision numbers tried when deployments of a workload are recorded concurrently.
+const maxRecordAttempts = 5
+
+type s3Client interface {
+	UploadIfNotExists(bucket, key string, data io.Reader) (string, error)
+	ListObjects(bucket, prefix string) ([]s3.Object, error)
+	GetObject(bucket, key string) ([]byte, error)
+}
+
+// Store records and retrieves the revisions of workloads in the S3 bucket of an application's regional resources.
+type Store struct {
+	s3     s3Client
+	bucket string
+	now    func() time.Time
+}
+
+// NewStore returns a Store that keeps revisions in the bucket.
+func NewStore(client s3Client, bucket string) *Store {
+	return &Store{
+		s3:     client,
+		bucket: bucket,
+		now:    time.Now,
+	}
+}
+
+// Record stores the revision as the latest revision of the workload in the environment.
+// The number and deployment time of the revision are set by the store.
+// If another deployment records the same number first, the revision is recorded under the next number.
+func (s *Store) Record(env, workload string, rev *Revision) error {
+	for attempt := 1; ; attempt++ {
+		numbers, err := s.numbers(env, workload)
+		if err != nil {
+			return err
+		}
+		rev.Number = 1
+		if len(numbers) > 0 {
+			rev.Number = numbers[len(numbers)-1] + 1
+		}
+		rev.DeployedAt = s.now().UTC()
+		data, err := json.Marshal(rev)
+		if err != nil {
+			return fmt.Errorf("marshal revision %d of %s: %w", rev.Number, workload, err)
+		}
+		_, err = s.s3.UploadIfNotExists(s.bucket, artifactpath.Deployment(env, workload, rev.Number), bytes.NewReader(data))
+		var errExists *s3.ErrObjectExists
+		if errors.As(err, &errExists) && attempt < maxRecordAttempts {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("upload revision %d of %s: %w", rev.Number, workload, err)
+		}
+		return nil
+	}
+}
+
+// Get returns a revision of the workload in the environment.
+// If the revision doesn't exist, returns an ErrNotFound.
+func (s *Store) Get(env, workload string, number int) (*Revision, error) {
+	data, err := s.s3.GetObject(s.bucket, artifactpath.Deployment(env, workload, number))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, &ErrNotFound{
+				Workload: workload,
+				Env:      env,
+				Number:   number,
+			}
+		}
+		return nil, fmt.Errorf("get revision %d of %s: %w", number, workload, err)
+	}
+	var rev Revision
+	if err := json.Unmarshal(data, &rev); err != nil {
+		return nil, fmt.Errorf("unmarshal revision %d of %s: %w", number, workload, err)
+	}
+	return &rev, nil
+}
+
+// List returns up to limit revisions of the workload in the environment, from the most recent to the oldest.
+// If limit is not positive, all the revisions are returned.
+func (s *Store) List(env, workload string, limit int) ([]*Revision, error) {
+	numbers, err := s.numbers(env, workload)
+	if err != nil {
+		return nil, err
+	}
+	var revs []*Revision
+	for i := len(numbers) - 1; i >= 0; i-- {
+		if limit > 0 && len(revs) == limit {
+			break
+		}
+		rev, err := s.Get(env, workload, numbers[i])
+		if err != nil {
+			return nil, err
+		}
+		revs = append(revs, rev)
+	}
+	return revs, nil
+}
+
+// numbers returns the sorted numbers of the recorded revisions of the workload.
+func (s *Store) numbers(env, workload string) ([]int, error) {
+	objects, err := s.s3.ListObjects(s.bucket, artifactpath.Deployments(env, workload))
+	if err != nil {
+		return nil, fmt.Errorf("list revisions of %s: %w", workload, err)
+	}
+	var numbers []int
+	for _, obj := range objects {
+		number, err := strconv.Atoi(strings.TrimSuffix(path.Base(obj.Key), ".json"))
+		if err != nil {
+			continue // Not a revision.
+		}
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,297 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package revision
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBucket struct {
+	objects map[string][]byte
+
+	listErr   error
+	uploadErr error
+	conflicts int // Number of uploads that lose the race against a concurrent upload to the same key.
+}
+
+func (b *fakeBucket) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	if b.uploadErr != nil {
+		return "", b.uploadErr
+	}
+	if b.conflicts > 0 {
+		b.conflicts--
+		b.objects[key] = []byte("{}")
+	}
+	if _, ok := b.objects[key]; ok {
+		return "", &s3.ErrObjectExists{Bucket: bucket, Key: key}
+	}
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return "", err
+	}
+	b.objects[key] = content
+	return "https://" + bucket + "/" + key, nil
+}
+
+func (b *fakeBucket) ListObjects(_, prefix string) ([]s3.Object, error) {
+	if b.listErr != nil {
+		return nil, b.listErr
+	}
+	var objects []s3.Object
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, s3.Object{Key: key})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (b *fakeBucket) GetObject(bucket, key string) ([]byte, error) {
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, &s3.ErrObjectNotFound{Bucket: bucket, Key: key}
+	}
+	return content, nil
+}
+
+type fakeStack struct{}
+
+func (s *fakeStack) StackName() string {
+	return "phonetool-test-api"
+}
+
+func (s *fakeStack) Template() (string, error) {
+	return "Resources: {}", nil
+}
+
+func (s *fakeStack) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("test")},
+		{ParameterKey: aws.String("AddonsTemplateURL"), ParameterValue: aws.String("")},
+	}, nil
+}
+
+func (s *fakeStack) Tags() []*cloudformation.Tag {
+	return []*cloudformation.Tag{
+		{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+	}
+}
+
+func TestNewRevision(t *testing.T) {
+	// WHEN
+	rev, err := NewRevision(&fakeStack{}, map[string]string{"api": "sha256:abc"})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, &Revision{
+		Template: "Resources: {}",
+		Parameters: map[string]string{
+			"EnvName":           "test",
+			"AddonsTemplateURL": "",
+		},
+		Tags: map[string]string{
+			"copilot-application": "phonetool",
+		},
+		Images: map[string]string{"api": "sha256:abc"},
+	}, rev)
+
+	stack := rev.Stack("phonetool-test-api")
+	params, err := stack.Parameters()
+	require.NoError(t, err)
+	require.Equal(t, []*cloudformation.Parameter{
+		{ParameterKey: aws.String("AddonsTemplateURL"), ParameterValue: aws.String("")},
+		{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("test")},
+	}, params)
+	require.Equal(t, (&fakeStack{}).Tags(), stack.Tags())
+	serialized, err := stack.SerializedParameters()
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "Parameters": {
+    "AddonsTemplateURL": "",
+    "EnvName": "test"
+  },
+  "Tags": {
+    "copilot-application": "phonetool"
+  }
+}`, serialized)
+}
+
+func TestStore_Record(t *testing.T) {
+	mockNow := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inBucket *fakeBucket
+
+		wantedNumber int
+		wantedErr    error
+	}{
+		"should wrap the error if revisions can't be listed": {
+			inBucket:  &fakeBucket{listErr: errors.New("some error")},
+			wantedErr: errors.New("list revisions of api: some error"),
+		},
+		"should wrap the error if the revision can't be uploaded": {
+			inBucket:  &fakeBucket{uploadErr: errors.New("some error")},
+			wantedErr: errors.New("upload revision 1 of api: some error"),
+		},
+		"should record the first revision": {
+			inBucket: &fakeBucket{
+				objects: map[string][]byte{
+					"manual/deployments/test/api-v2/1.json": []byte("{}"),
+				},
+			},
+			wantedNumber: 1,
+		},
+		"should record the revision after the latest one": {
+			inBucket: &fakeBucket{
+				objects: map[string][]byte{
+					"manual/deployments/test/api/2.json":  []byte("{}"),
+					"manual/deployments/test/api/10.json": []byte("{}"),
+					"manual/deployments/test/api/9.json":  []byte("{}"),
+				},
+			},
+			wantedNumber: 11,
+		},
+		"should record the revision under the next number if a concurrent deployment recorded the same number": {
+			inBucket: &fakeBucket{
+				objects: map[string][]byte{
+					"manual/deployments/test/api/1.json": []byte("{}"),
+				},
+				conflicts: 2,
+			},
+			wantedNumber: 4,
+		},
+		"should return an error if the revision keeps conflicting with concurrent deployments": {
+			inBucket: &fakeBucket{
+				objects:   map[string][]byte{},
+				conflicts: 5,
+			},
+			wantedErr: errors.New("upload revision 5 of api: object manual/deployments/test/api/5.json already exists in bucket mockBucket"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := NewStore(tc.inBucket, "mockBucket")
+			store.now = func() time.Time { return mockNow }
+			rev := &Revision{
+				Template:   "Resources: {}",
+				Parameters: map[string]string{"EnvName": "test"},
+			}
+
+			// WHEN
+			err := store.Record("test", "api", rev)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			got, err := store.Get("test", "api", tc.wantedNumber)
+			require.NoError(t, err)
+			require.Equal(t, &Revision{
+				Number:     tc.wantedNumber,
+				DeployedAt: mockNow,
+				Template:   "Resources: {}",
+				Parameters: map[string]string{"EnvName": "test"},
+			}, got)
+		})
+	}
+}
+
+func TestStore_Get(t *testing.T) {
+	testCases := map[string]struct {
+		inObjects map[string][]byte
+
+		wanted    *Revision
+		wantedErr error
+	}{
+		"should return ErrNotFound if the revision doesn't exist": {
+			inObjects: map[string][]byte{},
+			wantedErr: &ErrNotFound{Workload: "api", Env: "test", Number: 2},
+		},
+		"should wrap the error if the revision is malformed": {
+			inObjects: map[string][]byte{
+				"manual/deployments/test/api/2.json": []byte("{"),
+			},
+			wantedErr: errors.New("unmarshal revision 2 of api: unexpected end of JSON input"),
+		},
+		"should return the revision": {
+			inObjects: map[string][]byte{
+				"manual/deployments/test/api/2.json": []byte(`{"number":2,"template":"Resources: {}","rolledBack":1}`),
+			},
+			wanted: &Revision{
+				Number:     2,
+				Template:   "Resources: {}",
+				RolledBack: 1,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := NewStore(&fakeBucket{objects: tc.inObjects}, "mockBucket")
+
+			// WHEN
+			got, err := store.Get("test", "api", 2)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	bucket := &fakeBucket{
+		objects: map[string][]byte{
+			"manual/deployments/test/api/1.json":  []byte(`{"number":1}`),
+			"manual/deployments/test/api/2.json":  []byte(`{"number":2}`),
+			"manual/deployments/test/api/3.json":  []byte(`{"number":3}`),
+			"manual/deployments/test/api/notes":   []byte(`hello`),
+			"manual/deployments/prod/api/4.json":  []byte(`{"number":4}`),
+			"manual/deployments/test/api2/5.json": []byte(`{"number":5}`),
+		},
+	}
+	testCases := map[string]struct {
+		inLimit int
+
+		wanted []*Revision
+	}{
+		"should return all the revisions from the most recent one": {
+			wanted: []*Revision{{Number: 3}, {Number: 2}, {Number: 1}},
+		},
+		"should return the most recent revisions up to the limit": {
+			inLimit: 2,
+			wanted:  []*Revision{{Number: 3}, {Number: 2}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := NewStore(bucket, "mockBucket")
+
+			// WHEN
+			got, err := store.List("test", "api", tc.inLimit)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
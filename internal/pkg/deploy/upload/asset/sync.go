@@ -0,0 +1,459 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package asset
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/spf13/afero"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultSyncConcurrency = 10
+	// defaultPartSize matches the default part size of the S3 upload manager,
+	// so that the entity tags of files uploaded in multiple parts can be computed locally.
+	defaultPartSize = 5 * 1024 * 1024
+)
+
+// ListFunc is the function signature to list the entity tags of the objects whose key begins with prefix, keyed by object key.
+type ListFunc func(prefix string) (map[string]string, error)
+
+// DeleteFunc is the function signature to delete objects by key.
+type DeleteFunc func(keys []string) error
+
+// MetadataFunc is the function signature to get the system-defined metadata of an existing object.
+type MetadataFunc func(key string) (Metadata, error)
+
+// DownloadFunc is the function signature to download the contents of an object.
+type DownloadFunc func(key string) ([]byte, error)
+
+// SyncUploadFunc is the function signature to upload contents to a destination with the system-defined metadata of the object.
+type SyncUploadFunc func(dest string, contents io.Reader, metadata Metadata) (url string, err error)
+
+// Metadata holds the system-defined metadata of an uploaded object.
+type Metadata struct {
+	ContentType     string
+	CacheControl    string
+	ContentEncoding string
+}
+
+// MetadataRule sets the metadata of the files matching Pattern.
+// The pattern is matched against the slash-separated path relative to the source,
+// or against the file name if it doesn't contain a "/".
+type MetadataRule struct {
+	Pattern string
+	Metadata
+}
+
+// SyncMapping represents local files under Source to sync with the objects under Destination.
+type SyncMapping struct {
+	Source      string
+	Destination string
+	Reincludes  []string // Relative path under source to reinclude files that are excluded in the sync.
+	Excludes    []string // Relative path under source to exclude in the sync.
+	Recursive   bool     // Whether to walk recursively.
+	Prune       bool     // Whether to delete the objects under Destination that don't exist locally.
+	// Metadata rules applied in order, so later rules override the fields set by earlier ones.
+	// The content type defaults to the MIME type of the file extension.
+	Metadata []MetadataRule
+}
+
+// SyncOpts holds the functions to interact with the remote storage and the tuning options of a sync.
+type SyncOpts struct {
+	UploadFn SyncUploadFunc
+	ListFn   ListFunc
+	DeleteFn DeleteFunc // Only invoked if a mapping is pruned.
+	// MetadataFn is invoked for the files whose contents are unchanged to upload them again if their metadata changed.
+	// It's not invoked if the metadata of the last sync is recorded. If nil, only contents are compared.
+	MetadataFn MetadataFunc
+	// MetadataRecordKey is the key of the object that records the metadata of the synced files, so that the next sync
+	// compares the metadata of unchanged files against the record instead of getting the metadata of each object.
+	MetadataRecordKey string
+	DownloadFn        DownloadFunc // Downloads the record of the metadata. Only invoked if MetadataRecordKey is set.
+	Concurrency       int          // Maximum number of files hashed and uploaded at once. Defaults to 10.
+	PartSize          int64        // Size of a part in a multipart upload. Defaults to 5 MiB.
+}
+
+// SyncOutput holds the keys of the objects modified by a sync.
+type SyncOutput struct {
+	Uploaded  []string
+	Deleted   []string
+	Unchanged int
+}
+
+type localFile struct {
+	path     string
+	key      string
+	metadata Metadata
+}
+
+// Sync uploads the local files that are new or whose contents differ from the existing objects, and
+// deletes the objects that were removed locally for pruned mappings.
+// If multiple mappings resolve to the same object key, the file from the last mapping is used.
+func Sync(fs afero.Fs, mappings []SyncMapping, opts *SyncOpts) (*SyncOutput, error) {
+	files, err := localFiles(fs, mappings)
+	if err != nil {
+		return nil, err
+	}
+	etags, err := remoteETags(mappings, opts.ListFn)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := metadataRecord(opts)
+	if err != nil {
+		return nil, err
+	}
+	metadataChanged := metadataComparer(recorded, opts.MetadataFn)
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultSyncConcurrency
+	}
+	partSize := opts.PartSize
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+	out := &SyncOutput{}
+	var mu sync.Mutex
+	g := new(errgroup.Group)
+	g.SetLimit(concurrency)
+	for _, f := range files {
+		f := f
+		g.Go(func() error {
+			uploaded, err := syncFile(fs, f, etags[f.key], partSize, opts.UploadFn, metadataChanged)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if uploaded {
+				out.Uploaded = append(out.Uploaded, f.key)
+			} else {
+				out.Unchanged++
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Strings(out.Uploaded)
+
+	out.Deleted = staleKeys(mappings, files, etags)
+	if len(out.Deleted) > 0 {
+		if err := opts.DeleteFn(out.Deleted); err != nil {
+			return nil, fmt.Errorf("delete %d objects removed locally: %w", len(out.Deleted), err)
+		}
+	}
+	if err := recordMetadata(files, recorded, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// localFiles returns the files to sync, sorted by object key.
+func localFiles(fs afero.Fs, mappings []SyncMapping) ([]localFile, error) {
+	byKey := make(map[string]localFile)
+	for _, m := range mappings {
+		matcher := buildCompositeMatchers(buildReincludeMatchers(m.Reincludes), buildExcludeMatchers(m.Excludes))
+		info, err := fs.Stat(m.Source)
+		if err != nil {
+			return nil, fmt.Errorf("get stat for file %q: %w", m.Source, err)
+		}
+		paths := []string{m.Source}
+		if info.IsDir() {
+			files, err := afero.ReadDir(fs, m.Source)
+			if err != nil {
+				return nil, fmt.Errorf("read directory %q: %w", m.Source, err)
+			}
+			paths = make([]string, len(files))
+			for i, f := range files {
+				paths[i] = filepath.Join(m.Source, f.Name())
+			}
+		}
+		for _, path := range paths {
+			if err := afero.Walk(fs, path, collectFn(m, matcher, byKey)); err != nil {
+				return nil, fmt.Errorf("walk the file tree rooted at %q: %w", m.Source, err)
+			}
+		}
+	}
+	files := make([]localFile, 0, len(byKey))
+	for _, f := range byKey {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+	return files, nil
+}
+
+func collectFn(m SyncMapping, matcher filepathMatcher, byKey map[string]localFile) filepath.WalkFunc {
+	return func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if !m.Recursive {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		ok, err := matcher.match(path)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		fileRel, err := filepath.Rel(m.Source, path)
+		if err != nil {
+			return fmt.Errorf("get relative path for %q against %q: %w", path, m.Source, err)
+		}
+		md, err := fileMetadata(filepath.ToSlash(fileRel), m.Metadata)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(filepath.Join(m.Destination, fileRel))
+		byKey[key] = localFile{
+			path:     path,
+			key:      key,
+			metadata: md,
+		}
+		return nil
+	}
+}
+
+// fileMetadata returns the metadata of the file at the slash-separated relative path rel after applying the rules in order.
+func fileMetadata(rel string, rules []MetadataRule) (Metadata, error) {
+	md := Metadata{
+		ContentType: mime.TypeByExtension(path.Ext(rel)),
+	}
+	for _, rule := range rules {
+		name := rel
+		if !strings.Contains(rule.Pattern, "/") {
+			name = path.Base(rel)
+		}
+		ok, err := path.Match(rule.Pattern, name)
+		if err != nil {
+			return Metadata{}, fmt.Errorf("match metadata pattern %q: %w", rule.Pattern, err)
+		}
+		if !ok {
+			continue
+		}
+		if rule.ContentType != "" {
+			md.ContentType = rule.ContentType
+		}
+		if rule.CacheControl != "" {
+			md.CacheControl = rule.CacheControl
+		}
+		if rule.ContentEncoding != "" {
+			md.ContentEncoding = rule.ContentEncoding
+		}
+	}
+	return md, nil
+}
+
+// remoteETags returns the entity tags of the existing objects under the destinations of the mappings.
+func remoteETags(mappings []SyncMapping, list ListFunc) (map[string]string, error) {
+	etags := make(map[string]string)
+	listed := make(map[string]bool)
+	for _, m := range mappings {
+		prefix := filepath.ToSlash(m.Destination)
+		if listed[prefix] {
+			continue
+		}
+		listed[prefix] = true
+		objects, err := list(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("list objects under destination %q: %w", m.Destination, err)
+		}
+		for key, etag := range objects {
+			if artifactpath.IsReserved(key) {
+				// Copilot's own artifacts are never synced nor pruned.
+				continue
+			}
+			etags[key] = etag
+		}
+	}
+	return etags, nil
+}
+
+// syncFile uploads the file if its entity tag or metadata differs from the existing object, and returns whether it was uploaded.
+func syncFile(fs afero.Fs, f localFile, existingETag string, partSize int64, upload SyncUploadFunc, metadataChanged func(localFile) (bool, error)) (bool, error) {
+	file, err := fs.Open(f.path)
+	if err != nil {
+		return false, fmt.Errorf("open file on path %q: %w", f.path, err)
+	}
+	defer file.Close()
+	if existingETag != "" {
+		tag, err := etag(file, partSize)
+		if err != nil {
+			return false, fmt.Errorf("compute entity tag of file %q: %w", f.path, err)
+		}
+		if tag == existingETag {
+			changed, err := metadataChanged(f)
+			if err != nil {
+				return false, err
+			}
+			if !changed {
+				return false, nil
+			}
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return false, fmt.Errorf("rewind file %q: %w", f.path, err)
+		}
+	}
+	if _, err := upload(f.key, file, f.metadata); err != nil {
+		return false, fmt.Errorf("upload file %q to destination %q: %w", f.path, f.key, err)
+	}
+	return true, nil
+}
+
+// metadataRecord returns the metadata of the files recorded by the last sync, or nil if there is no record.
+func metadataRecord(opts *SyncOpts) (map[string]Metadata, error) {
+	if opts.MetadataRecordKey == "" {
+		return nil, nil
+	}
+	objects, err := opts.ListFn(opts.MetadataRecordKey)
+	if err != nil {
+		return nil, fmt.Errorf("list the metadata record %q: %w", opts.MetadataRecordKey, err)
+	}
+	if _, ok := objects[opts.MetadataRecordKey]; !ok {
+		return nil, nil
+	}
+	dat, err := opts.DownloadFn(opts.MetadataRecordKey)
+	if err != nil {
+		return nil, fmt.Errorf("download the metadata record %q: %w", opts.MetadataRecordKey, err)
+	}
+	var recorded map[string]Metadata
+	if err := json.Unmarshal(dat, &recorded); err != nil {
+		return nil, fmt.Errorf("unmarshal the metadata record %q: %w", opts.MetadataRecordKey, err)
+	}
+	return recorded, nil
+}
+
+// recordMetadata records the metadata of the synced files if it differs from the record of the last sync.
+func recordMetadata(files []localFile, recorded map[string]Metadata, opts *SyncOpts) error {
+	if opts.MetadataRecordKey == "" {
+		return nil
+	}
+	current := make(map[string]Metadata, len(files))
+	for _, f := range files {
+		current[f.key] = f.metadata
+	}
+	if recorded != nil && reflect.DeepEqual(recorded, current) {
+		return nil
+	}
+	dat, err := json.Marshal(current)
+	if err != nil {
+		return fmt.Errorf("marshal the metadata record: %w", err)
+	}
+	if _, err := opts.UploadFn(opts.MetadataRecordKey, bytes.NewReader(dat), Metadata{ContentType: "application/json"}); err != nil {
+		return fmt.Errorf("upload the metadata record %q: %w", opts.MetadataRecordKey, err)
+	}
+	return nil
+}
+
+// metadataComparer returns a function that reports whether the metadata of a file with unchanged contents changed.
+// The metadata is compared against the record of the last sync if there is one, otherwise against the existing object.
+func metadataComparer(recorded map[string]Metadata, get MetadataFunc) func(localFile) (bool, error) {
+	if recorded != nil {
+		return func(f localFile) (bool, error) {
+			md, ok := recorded[f.key]
+			return !ok || md != f.metadata, nil
+		}
+	}
+	return func(f localFile) (bool, error) {
+		return metadataChanged(f, get)
+	}
+}
+
+// metadataChanged returns true if the metadata of the existing object differs from the metadata resolved for the file.
+// The content type is only compared if it's resolved, since S3 assigns a default one to objects uploaded without it.
+func metadataChanged(f localFile, get MetadataFunc) (bool, error) {
+	if get == nil {
+		return false, nil
+	}
+	existing, err := get(f.key)
+	if err != nil {
+		return false, fmt.Errorf("get metadata of object %q: %w", f.key, err)
+	}
+	if f.metadata.ContentType != "" && f.metadata.ContentType != existing.ContentType {
+		return true, nil
+	}
+	return f.metadata.CacheControl != existing.CacheControl || f.metadata.ContentEncoding != existing.ContentEncoding, nil
+}
+
+// staleKeys returns the sorted keys of the existing objects under pruned destinations that don't exist locally.
+func staleKeys(mappings []SyncMapping, files []localFile, etags map[string]string) []string {
+	local := make(map[string]bool, len(files))
+	for _, f := range files {
+		local[f.key] = true
+	}
+	var stale []string
+	for key := range etags {
+		if local[key] {
+			continue
+		}
+		for _, m := range mappings {
+			if m.Prune && isUnder(key, filepath.ToSlash(m.Destination)) {
+				stale = append(stale, key)
+				break
+			}
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// isUnder returns true if key is the destination itself or an object nested under the destination.
+func isUnder(key, dest string) bool {
+	if dest == "" {
+		return true
+	}
+	return key == dest || strings.HasPrefix(key, strings.TrimSuffix(dest, "/")+"/")
+}
+
+// etag returns the entity tag that S3 assigns to an object with the contents of r when uploaded in parts of partSize.
+// The tag is the MD5 digest of the contents for single part uploads, and the MD5 digest of the concatenated
+// digests of each part followed by the number of parts otherwise.
+func etag(r io.Reader, partSize int64) (string, error) {
+	var sums [][]byte
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		if n > 0 || len(sums) == 0 {
+			sums = append(sums, h.Sum(nil))
+		}
+		if n < partSize {
+			break
+		}
+	}
+	if len(sums) == 1 {
+		return hex.EncodeToString(sums[0]), nil
+	}
+	h := md5.New()
+	for _, sum := range sums {
+		h.Write(sum)
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), len(sums)), nil
+}
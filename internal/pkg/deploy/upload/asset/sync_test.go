@@ -0,0 +1,497 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package asset
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBucket struct {
+	mu       sync.Mutex
+	etags    map[string]string   // Existing objects keyed by object key.
+	existing map[string]Metadata // Metadata of the existing objects keyed by object key.
+	listErr  error
+	putErr   error
+	delErr   error
+	headErr  error
+
+	recordKey string            // Key of the metadata record, if any.
+	contents  map[string]string // Contents of the existing objects that can be downloaded, keyed by object key.
+
+	uploaded map[string]string
+	metadata map[string]Metadata
+	deleted  []string
+}
+
+func (b *fakeBucket) opts() *SyncOpts {
+	return &SyncOpts{
+		UploadFn: func(key string, contents io.Reader, md Metadata) (string, error) {
+			if b.putErr != nil {
+				return "", b.putErr
+			}
+			dat, err := io.ReadAll(contents)
+			if err != nil {
+				return "", err
+			}
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if b.uploaded == nil {
+				b.uploaded = make(map[string]string)
+				b.metadata = make(map[string]Metadata)
+			}
+			b.uploaded[key] = string(dat)
+			b.metadata[key] = md
+			return "url/" + key, nil
+		},
+		ListFn: func(prefix string) (map[string]string, error) {
+			if b.listErr != nil {
+				return nil, b.listErr
+			}
+			out := make(map[string]string)
+			for key, tag := range b.etags {
+				if strings.HasPrefix(key, prefix) {
+					out[key] = tag
+				}
+			}
+			return out, nil
+		},
+		DeleteFn: func(keys []string) error {
+			if b.delErr != nil {
+				return b.delErr
+			}
+			b.deleted = keys
+			return nil
+		},
+		MetadataRecordKey: b.recordKey,
+		DownloadFn: func(key string) ([]byte, error) {
+			dat, ok := b.contents[key]
+			if !ok {
+				return nil, fmt.Errorf("object %s not found", key)
+			}
+			return []byte(dat), nil
+		},
+		MetadataFn: func(key string) (Metadata, error) {
+			if b.headErr != nil {
+				return Metadata{}, b.headErr
+			}
+			if md, ok := b.existing[key]; ok {
+				return md, nil
+			}
+			// Objects default to the metadata resolved without any rules.
+			return Metadata{ContentType: mime.TypeByExtension(path.Ext(key))}, nil
+		},
+	}
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestSync(t *testing.T) {
+	testCases := map[string]struct {
+		inMappings     []SyncMapping
+		inBucket       *fakeBucket
+		mockFileSystem func(fs afero.Fs)
+
+		wantedOutput   *SyncOutput
+		wantedUploaded map[string]string
+		wantedError    error
+	}{
+		"error if failed to list existing objects": {
+			inMappings: []SyncMapping{{Source: "dist", Destination: "site", Recursive: true}},
+			inBucket:   &fakeBucket{listErr: errors.New("some error")},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+			},
+			wantedError: errors.New(`list objects under destination "site": some error`),
+		},
+		"error if failed to upload": {
+			inMappings: []SyncMapping{{Source: "dist", Destination: "site", Recursive: true}},
+			inBucket:   &fakeBucket{putErr: errors.New("some error")},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+			},
+			wantedError: errors.New(`upload file "dist/index.html" to destination "site/index.html": some error`),
+		},
+		"uploads only new and changed files": {
+			inMappings: []SyncMapping{{Source: "dist", Destination: "site", Recursive: true}},
+			inBucket: &fakeBucket{
+				etags: map[string]string{
+					"site/index.html":   md5Hex("index"),
+					"site/css/main.css": md5Hex("old css"),
+					"site/removed.html": md5Hex("removed"),
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+				afero.WriteFile(fs, "dist/css/main.css", []byte("new css"), 0644)
+				afero.WriteFile(fs, "dist/js/main.js", []byte("js"), 0644)
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded:  []string{"site/css/main.css", "site/js/main.js"},
+				Unchanged: 1,
+			},
+			wantedUploaded: map[string]string{
+				"site/css/main.css": "new css",
+				"site/js/main.js":   "js",
+			},
+		},
+		"error if failed to get the metadata of an unchanged file": {
+			inMappings: []SyncMapping{{Source: "dist", Destination: "site", Recursive: true}},
+			inBucket: &fakeBucket{
+				etags:   map[string]string{"site/index.html": md5Hex("index")},
+				headErr: errors.New("some error"),
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+			},
+			wantedError: errors.New(`get metadata of object "site/index.html": some error`),
+		},
+		"uploads unchanged files again only if their metadata changed": {
+			inMappings: []SyncMapping{
+				{
+					Source:      "dist",
+					Destination: "site",
+					Recursive:   true,
+					Metadata: []MetadataRule{
+						{Pattern: "*.html", Metadata: Metadata{CacheControl: "no-cache"}},
+					},
+				},
+			},
+			inBucket: &fakeBucket{
+				etags: map[string]string{
+					"site/index.html": md5Hex("index"),
+					"site/about.html": md5Hex("about"),
+					"site/LICENSE":    md5Hex("data"),
+				},
+				existing: map[string]Metadata{
+					"site/index.html": {ContentType: mime.TypeByExtension(".html"), CacheControl: "no-cache"},
+					"site/about.html": {ContentType: mime.TypeByExtension(".html"), CacheControl: "max-age=60"},
+					"site/LICENSE":    {ContentType: "binary/octet-stream"},
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+				afero.WriteFile(fs, "dist/about.html", []byte("about"), 0644)
+				afero.WriteFile(fs, "dist/LICENSE", []byte("data"), 0644)
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded:  []string{"site/about.html"},
+				Unchanged: 2,
+			},
+			wantedUploaded: map[string]string{
+				"site/about.html": "about",
+			},
+		},
+		"deletes the objects removed locally only under pruned destinations": {
+			inMappings: []SyncMapping{
+				{Source: "dist", Destination: "site", Recursive: true, Prune: true},
+				{Source: "docs", Destination: "docs", Recursive: true},
+			},
+			inBucket: &fakeBucket{
+				etags: map[string]string{
+					"site/index.html":   md5Hex("index"),
+					"site/removed.html": md5Hex("removed"),
+					"sitemap.xml":       md5Hex("sitemap"),
+					"docs/removed.html": md5Hex("removed"),
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+				afero.WriteFile(fs, "docs/index.html", []byte("docs"), 0644)
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded:  []string{"docs/index.html"},
+				Deleted:   []string{"site/removed.html"},
+				Unchanged: 1,
+			},
+			wantedUploaded: map[string]string{
+				"docs/index.html": "docs",
+			},
+		},
+		"error if failed to delete the objects removed locally": {
+			inMappings: []SyncMapping{{Source: "dist", Destination: "site", Recursive: true, Prune: true}},
+			inBucket: &fakeBucket{
+				etags: map[string]string{
+					"site/removed.html": md5Hex("removed"),
+				},
+				delErr: errors.New("some error"),
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+			},
+			wantedError: errors.New("delete 1 objects removed locally: some error"),
+		},
+		"the last mapping wins if multiple mappings resolve to the same key": {
+			inMappings: []SyncMapping{
+				{Source: "dist", Recursive: true},
+				{Source: "overrides/index.html", Destination: "index.html"},
+			},
+			inBucket: &fakeBucket{},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+				afero.WriteFile(fs, "overrides/index.html", []byte("override"), 0644)
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded: []string{"index.html"},
+			},
+			wantedUploaded: map[string]string{
+				"index.html": "override",
+			},
+		},
+		"respects excludes, reincludes and recursive": {
+			inMappings: []SyncMapping{
+				{Source: "dist", Excludes: []string{"dist/*.map"}, Reincludes: []string{"dist/keep.map"}},
+			},
+			inBucket: &fakeBucket{},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "dist/main.js", []byte("js"), 0644)
+				afero.WriteFile(fs, "dist/main.js.map", []byte("map"), 0644)
+				afero.WriteFile(fs, "dist/keep.map", []byte("keep"), 0644)
+				afero.WriteFile(fs, "dist/nested/page.html", []byte("page"), 0644)
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded: []string{"keep.map", "main.js"},
+			},
+			wantedUploaded: map[string]string{
+				"keep.map": "keep",
+				"main.js":  "js",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFileSystem(fs)
+
+			// WHEN
+			out, err := Sync(fs, tc.inMappings, tc.inBucket.opts())
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out)
+			require.Equal(t, tc.wantedUploaded, tc.inBucket.uploaded)
+			require.Equal(t, tc.wantedOutput.Deleted, tc.inBucket.deleted)
+		})
+	}
+}
+
+func TestSync_metadata(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+	afero.WriteFile(fs, "dist/assets/main.js", []byte("js"), 0644)
+	afero.WriteFile(fs, "dist/assets/main.js.gz", []byte("gz"), 0644)
+	bucket := &fakeBucket{}
+	mappings := []SyncMapping{
+		{
+			Source:    "dist",
+			Recursive: true,
+			Metadata: []MetadataRule{
+				{Pattern: "assets/*", Metadata: Metadata{CacheControl: "max-age=31536000"}},
+				{Pattern: "*.gz", Metadata: Metadata{ContentType: "text/javascript", ContentEncoding: "gzip"}},
+				{Pattern: "index.html", Metadata: Metadata{CacheControl: "no-cache"}},
+			},
+		},
+	}
+
+	// WHEN
+	_, err := Sync(fs, mappings, bucket.opts())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, map[string]Metadata{
+		"index.html": {
+			ContentType:  mime.TypeByExtension(".html"),
+			CacheControl: "no-cache",
+		},
+		"assets/main.js": {
+			ContentType:  mime.TypeByExtension(".js"),
+			CacheControl: "max-age=31536000",
+		},
+		"assets/main.js.gz": {
+			ContentType:     "text/javascript",
+			CacheControl:    "max-age=31536000",
+			ContentEncoding: "gzip",
+		},
+	}, bucket.metadata)
+}
+
+func TestSync_metadataRecord(t *testing.T) {
+	const recordKey = "manual/static-sites/test/frontend/metadata.json"
+	htmlType := mime.TypeByExtension(".html")
+	testCases := map[string]struct {
+		inBucket *fakeBucket
+
+		wantedOutput *SyncOutput
+		wantedRecord map[string]Metadata // Nil if the record isn't uploaded.
+		wantedError  error
+	}{
+		"compares the metadata against the object of each unchanged file if there is no record yet": {
+			inBucket: &fakeBucket{
+				recordKey: recordKey,
+				etags: map[string]string{
+					"index.html": md5Hex("index"),
+					"about.html": md5Hex("about"),
+				},
+				existing: map[string]Metadata{
+					"index.html": {ContentType: htmlType, CacheControl: "no-cache"},
+					"about.html": {ContentType: htmlType},
+				},
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded:  []string{"about.html"},
+				Unchanged: 1,
+			},
+			wantedRecord: map[string]Metadata{
+				"index.html": {ContentType: htmlType, CacheControl: "no-cache"},
+				"about.html": {ContentType: htmlType, CacheControl: "no-cache"},
+			},
+		},
+		"compares the metadata against the record without getting the metadata of each object": {
+			inBucket: &fakeBucket{
+				recordKey: recordKey,
+				etags: map[string]string{
+					"index.html": md5Hex("index"),
+					"about.html": md5Hex("about"),
+					recordKey:    "recordETag",
+				},
+				contents: map[string]string{
+					recordKey: fmt.Sprintf(`{"index.html":{"ContentType":%q,"CacheControl":"no-cache"},"about.html":{"ContentType":%q}}`, htmlType, htmlType),
+				},
+				headErr: errors.New("some error"),
+			},
+			wantedOutput: &SyncOutput{
+				Uploaded:  []string{"about.html"},
+				Unchanged: 1,
+			},
+			wantedRecord: map[string]Metadata{
+				"index.html": {ContentType: htmlType, CacheControl: "no-cache"},
+				"about.html": {ContentType: htmlType, CacheControl: "no-cache"},
+			},
+		},
+		"doesn't upload the record again if the metadata didn't change": {
+			inBucket: &fakeBucket{
+				recordKey: recordKey,
+				etags: map[string]string{
+					"index.html": md5Hex("index"),
+					"about.html": md5Hex("about"),
+					recordKey:    "recordETag",
+				},
+				contents: map[string]string{
+					recordKey: fmt.Sprintf(`{"index.html":{"ContentType":%q,"CacheControl":"no-cache"},"about.html":{"ContentType":%q,"CacheControl":"no-cache"}}`, htmlType, htmlType),
+				},
+			},
+			wantedOutput: &SyncOutput{
+				Unchanged: 2,
+			},
+		},
+		"error if the record can't be downloaded": {
+			inBucket: &fakeBucket{
+				recordKey: recordKey,
+				etags: map[string]string{
+					recordKey: "recordETag",
+				},
+			},
+			wantedError: fmt.Errorf(`download the metadata record %q: object %s not found`, recordKey, recordKey),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			afero.WriteFile(fs, "dist/index.html", []byte("index"), 0644)
+			afero.WriteFile(fs, "dist/about.html", []byte("about"), 0644)
+			mappings := []SyncMapping{
+				{
+					Source:    "dist",
+					Recursive: true,
+					Prune:     true,
+					Metadata: []MetadataRule{
+						{Pattern: "*.html", Metadata: Metadata{CacheControl: "no-cache"}},
+					},
+				},
+			}
+
+			// WHEN
+			out, err := Sync(fs, mappings, tc.inBucket.opts())
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out)
+			require.Empty(t, tc.inBucket.deleted, "the record is never pruned")
+			record, ok := tc.inBucket.uploaded[recordKey]
+			if tc.wantedRecord == nil {
+				require.False(t, ok)
+				return
+			}
+			var got map[string]Metadata
+			require.NoError(t, json.Unmarshal([]byte(record), &got))
+			require.Equal(t, tc.wantedRecord, got)
+		})
+	}
+}
+
+func Test_etag(t *testing.T) {
+	testCases := map[string]struct {
+		inContent  string
+		inPartSize int64
+
+		wanted string
+	}{
+		"empty file": {
+			inContent:  "",
+			inPartSize: 4,
+			wanted:     md5Hex(""),
+		},
+		"single part": {
+			inContent:  "abcd",
+			inPartSize: 4,
+			wanted:     md5Hex("abcd"),
+		},
+		"multiple parts": {
+			inContent:  "abcdefghij",
+			inPartSize: 4,
+			wanted: func() string {
+				var concat []byte
+				for _, part := range []string{"abcd", "efgh", "ij"} {
+					sum := md5.Sum([]byte(part))
+					concat = append(concat, sum[:]...)
+				}
+				return fmt.Sprintf("%s-3", md5Hex(string(concat)))
+			}(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := etag(strings.NewReader(tc.inContent), tc.inPartSize)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
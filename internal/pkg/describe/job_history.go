@@ -0,0 +1,215 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	copilotecs "github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const maxJobExecutionCauseLength = 50 // Number of characters of a failure cause to display in a table.
+
+type jobStateMachineGetter interface {
+	StateMachineARN(app, env, job string) (string, error)
+}
+
+type stateMachineExecutionLister interface {
+	Executions(stateMachineARN string, limit int) ([]stepfunctions.Execution, error)
+	ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error)
+}
+
+// JobHistoryDescriber retrieves the recent executions of a job.
+type JobHistoryDescriber struct {
+	app   string
+	env   string
+	job   string
+	limit int
+
+	stateMachineGetter jobStateMachineGetter
+	executionLister    stateMachineExecutionLister
+}
+
+// NewJobHistoryConfig contains fields that initiates a JobHistoryDescriber struct.
+type NewJobHistoryConfig struct {
+	App         string
+	Env         string
+	Job         string
+	Limit       int // Maximum number of executions to describe.
+	ConfigStore ConfigStoreSvc
+}
+
+// JobHistory contains the recent executions of a job, starting with the latest one.
+type JobHistory struct {
+	Executions []JobExecution `json:"executions"`
+}
+
+// JobExecution contains the status of an execution of a job.
+type JobExecution struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	Duration  string     `json:"duration,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	Cause     string     `json:"cause,omitempty"`
+	TaskIDs   []string   `json:"taskIDs,omitempty"`
+}
+
+// NewJobHistoryDescriber instantiates a new JobHistoryDescriber struct.
+func NewJobHistoryDescriber(opt *NewJobHistoryConfig) (*JobHistoryDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &JobHistoryDescriber{
+		app:                opt.App,
+		env:                opt.Env,
+		job:                opt.Job,
+		limit:              opt.Limit,
+		stateMachineGetter: copilotecs.New(sess),
+		executionLister:    stepfunctions.New(sess),
+	}, nil
+}
+
+// Describe returns the recent executions of a job.
+func (d *JobHistoryDescriber) Describe() (HumanJSONStringer, error) {
+	arn, err := d.stateMachineGetter.StateMachineARN(d.app, d.env, d.job)
+	if err != nil {
+		return nil, fmt.Errorf("get state machine of job %s: %w", d.job, err)
+	}
+	executions, err := d.executionLister.Executions(arn, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("list executions of job %s: %w", d.job, err)
+	}
+	history := &JobHistory{
+		Executions: []JobExecution{},
+	}
+	for _, execution := range executions {
+		events, err := d.executionLister.ExecutionHistory(execution.ARN)
+		if err != nil {
+			return nil, fmt.Errorf("get history of execution %s: %w", execution.Name, err)
+		}
+		history.Executions = append(history.Executions, jobExecution(execution, events))
+	}
+	return history, nil
+}
+
+// jobExecution returns the status of an execution along with the failure cause and the IDs of the tasks
+// found in its history.
+func jobExecution(execution stepfunctions.Execution, events []stepfunctions.HistoryEvent) JobExecution {
+	out := JobExecution{
+		Name:      execution.Name,
+		Status:    execution.Status,
+		StartedAt: execution.StartDate,
+	}
+	if !execution.StopDate.IsZero() {
+		stoppedAt := execution.StopDate
+		out.StoppedAt = &stoppedAt
+		out.Duration = stoppedAt.Sub(execution.StartDate).Round(time.Second).String()
+	}
+	for _, event := range events {
+		switch event.Type {
+		case sfn.HistoryEventTypeTaskSubmitted:
+			out.TaskIDs = append(out.TaskIDs, submittedTaskIDs(event.Output)...)
+		case sfn.HistoryEventTypeExecutionFailed, sfn.HistoryEventTypeExecutionTimedOut, sfn.HistoryEventTypeExecutionAborted:
+			out.Error = event.Error
+			out.Cause = event.Cause
+		}
+	}
+	return out
+}
+
+// submittedTaskIDs returns the IDs of the tasks in the output of an ECS RunTask call submitted by Step Functions.
+func submittedTaskIDs(output string) []string {
+	var resp struct {
+		Tasks []struct {
+			TaskArn string
+		}
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return nil
+	}
+	var ids []string
+	for _, task := range resp.Tasks {
+		id, err := ecs.TaskID(task.TaskArn)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// JSONString returns stringified JobHistory struct with json format.
+func (h *JobHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal job history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified JobHistory struct with human readable format.
+func (h *JobHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Executions\n\n"))
+	writer.Flush()
+	if len(h.Executions) == 0 {
+		fmt.Fprintln(writer, "  No executions found.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Started At", "Duration", "Status", "Task ID", "Cause"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, execution := range h.Executions {
+		duration := execution.Duration
+		if duration == "" {
+			duration = "-"
+		}
+		cause := "-"
+		if execution.Cause != "" {
+			cause = execution.Cause
+			if runes := []rune(cause); len(runes) > maxJobExecutionCauseLength {
+				cause = string(runes[:maxJobExecutionCauseLength]) + "..."
+			}
+		}
+		// Retries start new tasks, show the latest one since it's the one that determined the status.
+		taskID := "-"
+		if len(execution.TaskIDs) > 0 {
+			taskID = execution.TaskIDs[len(execution.TaskIDs)-1]
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", humanizeTime(execution.StartedAt), duration,
+			executionStatusColor(execution.Status), taskID, cause)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func executionStatusColor(status string) string {
+	switch status {
+	case sfn.ExecutionStatusSucceeded:
+		return color.Green.Sprint(status)
+	case sfn.ExecutionStatusFailed, sfn.ExecutionStatusTimedOut, sfn.ExecutionStatusAborted:
+		return color.Red.Sprint(status)
+	default:
+		return color.Yellow.Sprint(status)
+	}
+}
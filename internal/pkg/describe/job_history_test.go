@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobHistoryDescriberMocks struct {
+	stateMachineGetter *mocks.MockjobStateMachineGetter
+	executionLister    *mocks.MockstateMachineExecutionLister
+}
+
+func TestJobHistoryDescriber_Describe(t *testing.T) {
+	startDate := time.Date(2022, time.March, 1, 2, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(2*time.Minute + 30*time.Second)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m jobHistoryDescriberMocks)
+
+		wantedHistory *JobHistory
+		wantedError   error
+	}{
+		"return error if fail to get the state machine of the job": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stateMachineGetter.EXPECT().StateMachineARN("phonetool", "test", "report").Return("", mockErr)
+			},
+			wantedError: fmt.Errorf("get state machine of job report: some error"),
+		},
+		"return error if fail to list executions": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				gomock.InOrder(
+					m.stateMachineGetter.EXPECT().StateMachineARN("phonetool", "test", "report").Return("mockStateMachineARN", nil),
+					m.executionLister.EXPECT().Executions("mockStateMachineARN", 5).Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("list executions of job report: some error"),
+		},
+		"return error if fail to get the history of an execution": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				gomock.InOrder(
+					m.stateMachineGetter.EXPECT().StateMachineARN("phonetool", "test", "report").Return("mockStateMachineARN", nil),
+					m.executionLister.EXPECT().Executions("mockStateMachineARN", 5).Return([]stepfunctions.Execution{
+						{ARN: "mockExecutionARN", Name: "nightly"},
+					}, nil),
+					m.executionLister.EXPECT().ExecutionHistory("mockExecutionARN").Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get history of execution nightly: some error"),
+		},
+		"success": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				gomock.InOrder(
+					m.stateMachineGetter.EXPECT().StateMachineARN("phonetool", "test", "report").Return("mockStateMachineARN", nil),
+					m.executionLister.EXPECT().Executions("mockStateMachineARN", 5).Return([]stepfunctions.Execution{
+						{ARN: "runningARN", Name: "running", Status: sfn.ExecutionStatusRunning, StartDate: startDate},
+						{ARN: "failedARN", Name: "failed", Status: sfn.ExecutionStatusFailed, StartDate: startDate, StopDate: stopDate},
+					}, nil),
+					m.executionLister.EXPECT().ExecutionHistory("runningARN").Return([]stepfunctions.HistoryEvent{
+						{Type: sfn.HistoryEventTypeExecutionStarted},
+					}, nil),
+					m.executionLister.EXPECT().ExecutionHistory("failedARN").Return([]stepfunctions.HistoryEvent{
+						{Type: sfn.HistoryEventTypeExecutionStarted},
+						{Type: sfn.HistoryEventTypeTaskSubmitted, Output: `{"Tasks":[{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/cluster/1a2b"}]}`},
+						{Type: sfn.HistoryEventTypeTaskFailed, Error: "States.TaskFailed"},
+						{Type: sfn.HistoryEventTypeTaskSubmitted, Output: `{"Tasks":[{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/cluster/3c4d"}]}`},
+						{Type: sfn.HistoryEventTypeExecutionFailed, Error: "States.TaskFailed", Cause: "Essential container in task exited"},
+					}, nil),
+				)
+			},
+			wantedHistory: &JobHistory{
+				Executions: []JobExecution{
+					{
+						Name:      "running",
+						Status:    sfn.ExecutionStatusRunning,
+						StartedAt: startDate,
+					},
+					{
+						Name:      "failed",
+						Status:    sfn.ExecutionStatusFailed,
+						StartedAt: startDate,
+						StoppedAt: &stopDate,
+						Duration:  "2m30s",
+						Error:     "States.TaskFailed",
+						Cause:     "Essential container in task exited",
+						TaskIDs:   []string{"1a2b", "3c4d"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobHistoryDescriberMocks{
+				stateMachineGetter: mocks.NewMockjobStateMachineGetter(ctrl),
+				executionLister:    mocks.NewMockstateMachineExecutionLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &JobHistoryDescriber{
+				app:                "phonetool",
+				env:                "test",
+				job:                "report",
+				limit:              5,
+				stateMachineGetter: m.stateMachineGetter,
+				executionLister:    m.executionLister,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHistory, got)
+		})
+	}
+}
+
+func TestJobHistory_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 hours ago"
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startDate := time.Date(2022, time.March, 1, 2, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(time.Minute)
+
+	testCases := map[string]struct {
+		history *JobHistory
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no executions": {
+			history: &JobHistory{Executions: []JobExecution{}},
+			wantedHumanString: `Executions
+
+  No executions found.
+`,
+			wantedJSONString: `{"executions":[]}
+`,
+		},
+		"executions": {
+			history: &JobHistory{
+				Executions: []JobExecution{
+					{
+						Name:      "running",
+						Status:    sfn.ExecutionStatusRunning,
+						StartedAt: startDate,
+					},
+					{
+						Name:      "failed",
+						Status:    sfn.ExecutionStatusFailed,
+						StartedAt: startDate,
+						StoppedAt: &stopDate,
+						Duration:  "1m0s",
+						Error:     "States.TaskFailed",
+						Cause:     "Essential container in task exited with a non-zero exit code",
+						TaskIDs:   []string{"1a2b", "3c4d"},
+					},
+				},
+			},
+			wantedHumanString: `Executions
+
+  Started At   Duration  Status    Task ID   Cause
+  ----------   --------  ------    -------   -----
+  2 hours ago  -         RUNNING   -         -
+  2 hours ago  1m0s      FAILED    3c4d      Essential container in task exited with a non-zero...
+`,
+			wantedJSONString: `{"executions":[{"name":"running","status":"RUNNING","startedAt":"2022-03-01T02:00:00Z"},{"name":"failed","status":"FAILED","startedAt":"2022-03-01T02:00:00Z","stoppedAt":"2022-03-01T02:01:00Z","duration":"1m0s","error":"States.TaskFailed","cause":"Essential container in task exited with a non-zero exit code","taskIDs":["1a2b","3c4d"]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.history.HumanString()
+			json, err := tc.history.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/job_history.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockjobStateMachineGetter is a mock of jobStateMachineGetter interface.
+type MockjobStateMachineGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobStateMachineGetterMockRecorder
+}
+
+// MockjobStateMachineGetterMockRecorder is the mock recorder for MockjobStateMachineGetter.
+type MockjobStateMachineGetterMockRecorder struct {
+	mock *MockjobStateMachineGetter
+}
+
+// NewMockjobStateMachineGetter creates a new mock instance.
+func NewMockjobStateMachineGetter(ctrl *gomock.Controller) *MockjobStateMachineGetter {
+	mock := &MockjobStateMachineGetter{ctrl: ctrl}
+	mock.recorder = &MockjobStateMachineGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobStateMachineGetter) EXPECT() *MockjobStateMachineGetterMockRecorder {
+	return m.recorder
+}
+
+// StateMachineARN mocks base method.
+func (m *MockjobStateMachineGetter) StateMachineARN(app, env, job string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineARN", app, env, job)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineARN indicates an expected call of StateMachineARN.
+func (mr *MockjobStateMachineGetterMockRecorder) StateMachineARN(app, env, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineARN", reflect.TypeOf((*MockjobStateMachineGetter)(nil).StateMachineARN), app, env, job)
+}
+
+// MockstateMachineExecutionLister is a mock of stateMachineExecutionLister interface.
+type MockstateMachineExecutionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineExecutionListerMockRecorder
+}
+
+// MockstateMachineExecutionListerMockRecorder is the mock recorder for MockstateMachineExecutionLister.
+type MockstateMachineExecutionListerMockRecorder struct {
+	mock *MockstateMachineExecutionLister
+}
+
+// NewMockstateMachineExecutionLister creates a new mock instance.
+func NewMockstateMachineExecutionLister(ctrl *gomock.Controller) *MockstateMachineExecutionLister {
+	mock := &MockstateMachineExecutionLister{ctrl: ctrl}
+	mock.recorder = &MockstateMachineExecutionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstateMachineExecutionLister) EXPECT() *MockstateMachineExecutionListerMockRecorder {
+	return m.recorder
+}
+
+// ExecutionHistory mocks base method.
+func (m *MockstateMachineExecutionLister) ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionHistory", executionARN)
+	ret0, _ := ret[0].([]stepfunctions.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionHistory indicates an expected call of ExecutionHistory.
+func (mr *MockstateMachineExecutionListerMockRecorder) ExecutionHistory(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionHistory", reflect.TypeOf((*MockstateMachineExecutionLister)(nil).ExecutionHistory), executionARN)
+}
+
+// Executions mocks base method.
+func (m *MockstateMachineExecutionLister) Executions(stateMachineARN string, limit int) ([]stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Executions", stateMachineARN, limit)
+	ret0, _ := ret[0].([]stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Executions indicates an expected call of Executions.
+func (mr *MockstateMachineExecutionListerMockRecorder) Executions(stateMachineARN, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Executions", reflect.TypeOf((*MockstateMachineExecutionLister)(nil).Executions), stateMachineARN, limit)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/secrets.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSecretReader is a mock of SecretReader interface.
+type MockSecretReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretReaderMockRecorder
+}
+
+// MockSecretReaderMockRecorder is the mock recorder for MockSecretReader.
+type MockSecretReaderMockRecorder struct {
+	mock *MockSecretReader
+}
+
+// NewMockSecretReader creates a new mock instance.
+func NewMockSecretReader(ctrl *gomock.Controller) *MockSecretReader {
+	mock := &MockSecretReader{ctrl: ctrl}
+	mock.recorder = &MockSecretReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretReader) EXPECT() *MockSecretReaderMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MockSecretReader) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MockSecretReaderMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MockSecretReader)(nil).GetSecretValue), name)
+}
+
+// ListSecrets mocks base method.
+func (m *MockSecretReader) ListSecrets(tags map[string]string) ([]ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockSecretReaderMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretReader)(nil).ListSecrets), tags)
+}
+
+// MockSecretsManagerReader is a mock of SecretsManagerReader interface.
+type MockSecretsManagerReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsManagerReaderMockRecorder
+}
+
+// MockSecretsManagerReaderMockRecorder is the mock recorder for MockSecretsManagerReader.
+type MockSecretsManagerReaderMockRecorder struct {
+	mock *MockSecretsManagerReader
+}
+
+// NewMockSecretsManagerReader creates a new mock instance.
+func NewMockSecretsManagerReader(ctrl *gomock.Controller) *MockSecretsManagerReader {
+	mock := &MockSecretsManagerReader{ctrl: ctrl}
+	mock.recorder = &MockSecretsManagerReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsManagerReader) EXPECT() *MockSecretsManagerReaderMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MockSecretsManagerReader) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MockSecretsManagerReaderMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MockSecretsManagerReader)(nil).GetSecretValue), name)
+}
+
+// ListSecrets mocks base method.
+func (m *MockSecretsManagerReader) ListSecrets(prefix string) ([]secretsmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", prefix)
+	ret0, _ := ret[0].([]secretsmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockSecretsManagerReaderMockRecorder) ListSecrets(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretsManagerReader)(nil).ListSecrets), prefix)
+}
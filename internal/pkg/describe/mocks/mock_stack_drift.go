@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/stack_drift.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackDriftDetector is a mock of stackDriftDetector interface.
+type MockstackDriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDetectorMockRecorder
+}
+
+// MockstackDriftDetectorMockRecorder is the mock recorder for MockstackDriftDetector.
+type MockstackDriftDetectorMockRecorder struct {
+	mock *MockstackDriftDetector
+}
+
+// NewMockstackDriftDetector creates a new mock instance.
+func NewMockstackDriftDetector(ctrl *gomock.Controller) *MockstackDriftDetector {
+	mock := &MockstackDriftDetector{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDetector) EXPECT() *MockstackDriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectDrift mocks base method.
+func (m *MockstackDriftDetector) DetectDrift() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockstackDriftDetectorMockRecorder) DetectDrift() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockstackDriftDetector)(nil).DetectDrift))
+}
+
+// Name mocks base method.
+func (m *MockstackDriftDetector) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockstackDriftDetectorMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockstackDriftDetector)(nil).Name))
+}
+
+// ResourceDrifts mocks base method.
+func (m *MockstackDriftDetector) ResourceDrifts() ([]*stack.ResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceDrifts")
+	ret0, _ := ret[0].([]*stack.ResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourceDrifts indicates an expected call of ResourceDrifts.
+func (mr *MockstackDriftDetectorMockRecorder) ResourceDrifts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceDrifts", reflect.TypeOf((*MockstackDriftDetector)(nil).ResourceDrifts))
+}
+
+// Resources mocks base method.
+func (m *MockstackDriftDetector) Resources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockstackDriftDetectorMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackDriftDetector)(nil).Resources))
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/svc_deployments.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	revision "github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRevisionLister is a mock of RevisionLister interface.
+type MockRevisionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionListerMockRecorder
+}
+
+// MockRevisionListerMockRecorder is the mock recorder for MockRevisionLister.
+type MockRevisionListerMockRecorder struct {
+	mock *MockRevisionLister
+}
+
+// NewMockRevisionLister creates a new mock instance.
+func NewMockRevisionLister(ctrl *gomock.Controller) *MockRevisionLister {
+	mock := &MockRevisionLister{ctrl: ctrl}
+	mock.recorder = &MockRevisionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionLister) EXPECT() *MockRevisionListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockRevisionLister) List(env, workload string, limit int) ([]*revision.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", env, workload, limit)
+	ret0, _ := ret[0].([]*revision.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRevisionListerMockRecorder) List(env, workload, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRevisionLister)(nil).List), env, workload, limit)
+}
@@ -0,0 +1,379 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// Prefixes of the name of the secrets created by "copilot secret init".
+	fmtSecretParameterPrefix      = "/copilot/%s/%s/secrets/"
+	fmtSecretsManagerSecretPrefix = "copilot/%s/%s/secrets/"
+
+	// Stores of the secrets, as named by the --store flag of "copilot secret init".
+	secretStoreSSM            = "ssm"
+	secretStoreSecretsManager = "secretsmanager"
+)
+
+// SecretReader lists the parameters of an environment and retrieves their values.
+type SecretReader interface {
+	ListSecrets(tags map[string]string) ([]ssm.Secret, error)
+	GetSecretValue(name string) (string, error)
+}
+
+// SecretsManagerReader lists the Secrets Manager secrets of an environment and retrieves their values.
+type SecretsManagerReader interface {
+	ListSecrets(prefix string) ([]secretsmanager.Secret, error)
+	GetSecretValue(name string) (string, error)
+}
+
+// ErrSecretNotFound occurs when a secret does not exist in any of the described environments.
+type ErrSecretNotFound struct {
+	name string
+	app  string
+}
+
+// Error implements the error interface.
+func (err *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("secret %s not found in any environment of application %s", err.name, err.app)
+}
+
+// AppSecretsDescriber retrieves the secrets of an application across its environments.
+type AppSecretsDescriber struct {
+	app       string
+	envs      []string
+	readers   map[string]SecretReader
+	smReaders map[string]SecretsManagerReader
+}
+
+// NewAppSecretsDescriberConfig contains fields that initiates an AppSecretsDescriber struct.
+type NewAppSecretsDescriberConfig struct {
+	App     string
+	Envs    []string                // Names of the environments to describe, in display order.
+	Readers map[string]SecretReader // Clients to read the parameters of each environment, keyed by environment name.
+
+	// Clients to read the Secrets Manager secrets of each environment, keyed by environment name.
+	SecretsManagerReaders map[string]SecretsManagerReader
+}
+
+// AppSecrets contains the secrets of an application.
+type AppSecrets struct {
+	Secrets []*AppSecret `json:"secrets"`
+}
+
+// AppSecret contains the parameters of a secret in each environment it exists in.
+type AppSecret struct {
+	Name         string             `json:"name"`
+	Environments []*SecretParameter `json:"environments"`
+}
+
+// SecretParameter contains the metadata, and optionally the value, of a secret in an environment.
+type SecretParameter struct {
+	Environment  string    `json:"environment"`
+	Store        string    `json:"store"`
+	Parameter    string    `json:"parameter"`         // Name of the SSM parameter or the Secrets Manager secret.
+	Version      int64     `json:"version,omitempty"` // Version of the SSM parameter.
+	LastModified time.Time `json:"lastModified"`
+	Value        string    `json:"value,omitempty"`
+}
+
+// SecretsDiff contains the secrets that are missing from some of the compared environments.
+type SecretsDiff struct {
+	Environments []string         `json:"environments"`
+	Missing      []*MissingSecret `json:"missing"`
+}
+
+// MissingSecret contains the environments a secret is present in and missing from.
+type MissingSecret struct {
+	Name      string   `json:"name"`
+	PresentIn []string `json:"presentIn"`
+	MissingIn []string `json:"missingIn"`
+}
+
+// NewAppSecretsDescriber instantiates a new AppSecretsDescriber struct.
+func NewAppSecretsDescriber(opt *NewAppSecretsDescriberConfig) *AppSecretsDescriber {
+	return &AppSecretsDescriber{
+		app:       opt.App,
+		envs:      opt.Envs,
+		readers:   opt.Readers,
+		smReaders: opt.SecretsManagerReaders,
+	}
+}
+
+// Describe returns every secret of the application sorted by name.
+func (d *AppSecretsDescriber) Describe() (HumanJSONStringer, error) {
+	secrets, err := d.secrets()
+	if err != nil {
+		return nil, err
+	}
+	return &AppSecrets{
+		Secrets: secrets,
+	}, nil
+}
+
+// DescribeSecret returns the parameters of a secret in each environment.
+// If decrypt is true, the value of the secret in each environment is retrieved as well.
+func (d *AppSecretsDescriber) DescribeSecret(name string, decrypt bool) (HumanJSONStringer, error) {
+	secrets, err := d.secrets()
+	if err != nil {
+		return nil, err
+	}
+	var secret *AppSecret
+	for _, s := range secrets {
+		if s.Name == name {
+			secret = s
+			break
+		}
+	}
+	if secret == nil {
+		return nil, &ErrSecretNotFound{
+			name: name,
+			app:  d.app,
+		}
+	}
+	if !decrypt {
+		return secret, nil
+	}
+	for _, param := range secret.Environments {
+		var value string
+		var err error
+		switch param.Store {
+		case secretStoreSecretsManager:
+			value, err = d.smReaders[param.Environment].GetSecretValue(param.Parameter)
+		default:
+			value, err = d.readers[param.Environment].GetSecretValue(param.Parameter)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get value of secret %s in environment %s: %w", name, param.Environment, err)
+		}
+		param.Value = value
+	}
+	return secret, nil
+}
+
+// Diff returns the secrets that exist in some environments but not in others.
+func (d *AppSecretsDescriber) Diff() (HumanJSONStringer, error) {
+	secrets, err := d.secrets()
+	if err != nil {
+		return nil, err
+	}
+	diff := &SecretsDiff{
+		Environments: d.envs,
+		Missing:      []*MissingSecret{},
+	}
+	for _, secret := range secrets {
+		present := make(map[string]bool)
+		for _, param := range secret.Environments {
+			present[param.Environment] = true // A secret can be both a parameter and a Secrets Manager secret in an environment.
+		}
+		if len(present) == len(d.envs) {
+			continue
+		}
+		missing := &MissingSecret{
+			Name: secret.Name,
+		}
+		for _, env := range d.envs {
+			if present[env] {
+				missing.PresentIn = append(missing.PresentIn, env)
+				continue
+			}
+			missing.MissingIn = append(missing.MissingIn, env)
+		}
+		diff.Missing = append(diff.Missing, missing)
+	}
+	return diff, nil
+}
+
+func (d *AppSecretsDescriber) secrets() ([]*AppSecret, error) {
+	byName := make(map[string]*AppSecret)
+	add := func(name string, param *SecretParameter) {
+		if _, ok := byName[name]; !ok {
+			byName[name] = &AppSecret{
+				Name: name,
+			}
+		}
+		byName[name].Environments = append(byName[name].Environments, param)
+	}
+	for _, env := range d.envs {
+		params, err := d.readers[env].ListSecrets(map[string]string{
+			deploy.AppTagKey: d.app,
+			deploy.EnvTagKey: env,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets in environment %s: %w", env, err)
+		}
+		for _, param := range params {
+			add(secretName(fmtSecretParameterPrefix, d.app, env, param.Name), &SecretParameter{
+				Environment:  env,
+				Store:        secretStoreSSM,
+				Parameter:    param.Name,
+				Version:      param.Version,
+				LastModified: param.LastModified,
+			})
+		}
+		smSecrets, err := d.smReaders[env].ListSecrets(fmt.Sprintf(fmtSecretsManagerSecretPrefix, d.app, env))
+		if err != nil {
+			return nil, fmt.Errorf("list Secrets Manager secrets in environment %s: %w", env, err)
+		}
+		for _, secret := range smSecrets {
+			add(secretName(fmtSecretsManagerSecretPrefix, d.app, env, secret.Name), &SecretParameter{
+				Environment:  env,
+				Store:        secretStoreSecretsManager,
+				Parameter:    secret.Name,
+				LastModified: secret.LastChanged,
+			})
+		}
+	}
+	secrets := make([]*AppSecret, 0, len(byName))
+	for _, secret := range byName {
+		secrets = append(secrets, secret)
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// secretName returns the name given to a secret by "copilot secret init",
+// or the full name of the parameter if it was not created by Copilot.
+func secretName(fmtPrefix, app, env, name string) string {
+	return strings.TrimPrefix(name, fmt.Sprintf(fmtPrefix, app, env))
+}
+
+// JSONString returns the stringified AppSecrets struct with json format.
+func (s *AppSecrets) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppSecrets struct with human readable format.
+func (s *AppSecrets) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Secrets\n\n"))
+	writer.Flush()
+	if len(s.Secrets) == 0 {
+		fmt.Fprintln(writer, "  No secrets found.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Name", "Environments", "Last Modified"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, secret := range s.Secrets {
+		var envs []string
+		var lastModified time.Time
+		for _, param := range secret.Environments {
+			envs = append(envs, param.Environment)
+			if param.LastModified.After(lastModified) {
+				lastModified = param.LastModified
+			}
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", secret.Name, strings.Join(envs, ", "), humanizeTime(lastModified))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// JSONString returns the stringified AppSecret struct with json format.
+func (s *AppSecret) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal secret: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppSecret struct with human readable format.
+func (s *AppSecret) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.Name)
+	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	withValues := false
+	for _, param := range s.Environments {
+		if param.Value != "" {
+			withValues = true
+		}
+	}
+	headers := []string{"Environment", "Store", "Name", "Version", "Last Modified"}
+	if withValues {
+		headers = append(headers, "Value")
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, param := range s.Environments {
+		version := "-"
+		if param.Version != 0 {
+			version = fmt.Sprintf("%d", param.Version)
+		}
+		fields := []string{param.Environment, param.Store, param.Parameter, version, humanizeTime(param.LastModified)}
+		if withValues {
+			fields = append(fields, param.Value)
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(fields, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// JSONString returns the stringified SecretsDiff struct with json format.
+func (d *SecretsDiff) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets diff: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified SecretsDiff struct with human readable format.
+func (d *SecretsDiff) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Missing Secrets\n\n"))
+	writer.Flush()
+	if len(d.Missing) == 0 {
+		fmt.Fprintf(writer, "  Every secret is present in %s.\n", strings.Join(d.Environments, ", "))
+		writer.Flush()
+		return b.String()
+	}
+	headers := append([]string{"Name"}, d.Environments...)
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, secret := range d.Missing {
+		present := make(map[string]bool)
+		for _, env := range secret.PresentIn {
+			present[env] = true
+		}
+		fields := []string{secret.Name}
+		for _, env := range d.Environments {
+			if present[env] {
+				fields = append(fields, "✔")
+				continue
+			}
+			fields = append(fields, color.Red.Sprint("✘"))
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(fields, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
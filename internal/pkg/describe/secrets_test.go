@@ -0,0 +1,382 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appSecretsDescriberMocks struct {
+	test   *mocks.MockSecretReader
+	prod   *mocks.MockSecretReader
+	testSM *mocks.MockSecretsManagerReader
+	prodSM *mocks.MockSecretsManagerReader
+}
+
+func TestAppSecretsDescriber(t *testing.T) {
+	lastModified := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+	testTags := map[string]string{"copilot-application": "phonetool", "copilot-environment": "test"}
+	prodTags := map[string]string{"copilot-application": "phonetool", "copilot-environment": "prod"}
+	listSecrets := func(m appSecretsDescriberMocks) {
+		m.test.EXPECT().ListSecrets(testTags).Return([]ssm.Secret{
+			{
+				Name:         "/copilot/phonetool/test/secrets/db-password",
+				Version:      2,
+				LastModified: lastModified,
+			},
+			{
+				Name:         "/copilot/phonetool/test/secrets/api-key",
+				Version:      1,
+				LastModified: lastModified,
+			},
+		}, nil)
+		m.prod.EXPECT().ListSecrets(prodTags).Return([]ssm.Secret{
+			{
+				Name:         "/copilot/phonetool/prod/secrets/db-password",
+				Version:      1,
+				LastModified: lastModified,
+			},
+			{
+				Name:         "/shared/github-token",
+				Version:      3,
+				LastModified: lastModified,
+			},
+		}, nil)
+		m.testSM.EXPECT().ListSecrets("copilot/phonetool/test/secrets/").Return([]secretsmanager.Secret{
+			{
+				Name:        "copilot/phonetool/test/secrets/redis-password",
+				LastChanged: lastModified,
+			},
+		}, nil)
+		m.prodSM.EXPECT().ListSecrets("copilot/phonetool/prod/secrets/").Return([]secretsmanager.Secret{
+			{
+				Name:        "copilot/phonetool/prod/secrets/api-key",
+				LastChanged: lastModified,
+			},
+		}, nil)
+	}
+
+	t.Run("Describe", func(t *testing.T) {
+		testCases := map[string]struct {
+			setupMocks func(m appSecretsDescriberMocks)
+
+			wanted    HumanJSONStringer
+			wantedErr error
+		}{
+			"errors if failed to list the secrets of an environment": {
+				setupMocks: func(m appSecretsDescriberMocks) {
+					m.test.EXPECT().ListSecrets(testTags).Return(nil, errors.New("some error"))
+				},
+				wantedErr: errors.New("list secrets in environment test: some error"),
+			},
+			"errors if failed to list the Secrets Manager secrets of an environment": {
+				setupMocks: func(m appSecretsDescriberMocks) {
+					m.test.EXPECT().ListSecrets(testTags).Return(nil, nil)
+					m.testSM.EXPECT().ListSecrets("copilot/phonetool/test/secrets/").Return(nil, errors.New("some error"))
+				},
+				wantedErr: errors.New("list Secrets Manager secrets in environment test: some error"),
+			},
+			"groups the secrets of every environment and store by name": {
+				setupMocks: listSecrets,
+				wanted: &AppSecrets{
+					Secrets: []*AppSecret{
+						{
+							Name: "/shared/github-token",
+							Environments: []*SecretParameter{
+								{Environment: "prod", Store: "ssm", Parameter: "/shared/github-token", Version: 3, LastModified: lastModified},
+							},
+						},
+						{
+							Name: "api-key",
+							Environments: []*SecretParameter{
+								{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/api-key", Version: 1, LastModified: lastModified},
+								{Environment: "prod", Store: "secretsmanager", Parameter: "copilot/phonetool/prod/secrets/api-key", LastModified: lastModified},
+							},
+						},
+						{
+							Name: "db-password",
+							Environments: []*SecretParameter{
+								{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/db-password", Version: 2, LastModified: lastModified},
+								{Environment: "prod", Store: "ssm", Parameter: "/copilot/phonetool/prod/secrets/db-password", Version: 1, LastModified: lastModified},
+							},
+						},
+						{
+							Name: "redis-password",
+							Environments: []*SecretParameter{
+								{Environment: "test", Store: "secretsmanager", Parameter: "copilot/phonetool/test/secrets/redis-password", LastModified: lastModified},
+							},
+						},
+					},
+				},
+			},
+		}
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				d, m := newTestAppSecretsDescriber(t)
+				tc.setupMocks(m)
+
+				got, err := d.Describe()
+
+				if tc.wantedErr != nil {
+					require.EqualError(t, err, tc.wantedErr.Error())
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			})
+		}
+	})
+
+	t.Run("DescribeSecret", func(t *testing.T) {
+		testCases := map[string]struct {
+			name       string
+			decrypt    bool
+			setupMocks func(m appSecretsDescriberMocks)
+
+			wanted    HumanJSONStringer
+			wantedErr error
+		}{
+			"errors if the secret does not exist": {
+				name:       "mongo-password",
+				setupMocks: listSecrets,
+				wantedErr:  errors.New("secret mongo-password not found in any environment of application phonetool"),
+			},
+			"errors if failed to decrypt the value": {
+				name:    "db-password",
+				decrypt: true,
+				setupMocks: func(m appSecretsDescriberMocks) {
+					listSecrets(m)
+					m.test.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db-password").Return("", errors.New("some error"))
+				},
+				wantedErr: errors.New("get value of secret db-password in environment test: some error"),
+			},
+			"returns the metadata without the values": {
+				name:       "db-password",
+				setupMocks: listSecrets,
+				wanted: &AppSecret{
+					Name: "db-password",
+					Environments: []*SecretParameter{
+						{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/db-password", Version: 2, LastModified: lastModified},
+						{Environment: "prod", Store: "ssm", Parameter: "/copilot/phonetool/prod/secrets/db-password", Version: 1, LastModified: lastModified},
+					},
+				},
+			},
+			"returns the decrypted values": {
+				name:    "db-password",
+				decrypt: true,
+				setupMocks: func(m appSecretsDescriberMocks) {
+					listSecrets(m)
+					m.test.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db-password").Return("hunter2", nil)
+					m.prod.EXPECT().GetSecretValue("/copilot/phonetool/prod/secrets/db-password").Return("correct horse", nil)
+				},
+				wanted: &AppSecret{
+					Name: "db-password",
+					Environments: []*SecretParameter{
+						{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/db-password", Version: 2, LastModified: lastModified, Value: "hunter2"},
+						{Environment: "prod", Store: "ssm", Parameter: "/copilot/phonetool/prod/secrets/db-password", Version: 1, LastModified: lastModified, Value: "correct horse"},
+					},
+				},
+			},
+			"returns the values of the secrets from their store": {
+				name:    "api-key",
+				decrypt: true,
+				setupMocks: func(m appSecretsDescriberMocks) {
+					listSecrets(m)
+					m.test.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/api-key").Return("abc", nil)
+					m.prodSM.EXPECT().GetSecretValue("copilot/phonetool/prod/secrets/api-key").Return("xyz", nil)
+				},
+				wanted: &AppSecret{
+					Name: "api-key",
+					Environments: []*SecretParameter{
+						{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/api-key", Version: 1, LastModified: lastModified, Value: "abc"},
+						{Environment: "prod", Store: "secretsmanager", Parameter: "copilot/phonetool/prod/secrets/api-key", LastModified: lastModified, Value: "xyz"},
+					},
+				},
+			},
+		}
+		for name, tc := range testCases {
+			t.Run(name, func(t *testing.T) {
+				d, m := newTestAppSecretsDescriber(t)
+				tc.setupMocks(m)
+
+				got, err := d.DescribeSecret(tc.name, tc.decrypt)
+
+				if tc.wantedErr != nil {
+					require.EqualError(t, err, tc.wantedErr.Error())
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			})
+		}
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		d, m := newTestAppSecretsDescriber(t)
+		listSecrets(m)
+
+		got, err := d.Diff()
+
+		require.NoError(t, err)
+		require.Equal(t, &SecretsDiff{
+			Environments: []string{"test", "prod"},
+			Missing: []*MissingSecret{
+				{
+					Name:      "/shared/github-token",
+					PresentIn: []string{"prod"},
+					MissingIn: []string{"test"},
+				},
+				{
+					Name:      "redis-password",
+					PresentIn: []string{"test"},
+					MissingIn: []string{"prod"},
+				},
+			},
+		}, got)
+	})
+}
+
+func newTestAppSecretsDescriber(t *testing.T) (*AppSecretsDescriber, appSecretsDescriberMocks) {
+	ctrl := gomock.NewController(t)
+	m := appSecretsDescriberMocks{
+		test:   mocks.NewMockSecretReader(ctrl),
+		prod:   mocks.NewMockSecretReader(ctrl),
+		testSM: mocks.NewMockSecretsManagerReader(ctrl),
+		prodSM: mocks.NewMockSecretsManagerReader(ctrl),
+	}
+	return NewAppSecretsDescriber(&NewAppSecretsDescriberConfig{
+		App:  "phonetool",
+		Envs: []string{"test", "prod"},
+		Readers: map[string]SecretReader{
+			"test": m.test,
+			"prod": m.prod,
+		},
+		SecretsManagerReaders: map[string]SecretsManagerReader{
+			"test": m.testSM,
+			"prod": m.prodSM,
+		},
+	}), m
+}
+
+func TestAppSecrets_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 hours ago"
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	lastModified := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		in HumanJSONStringer
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no secrets": {
+			in: &AppSecrets{Secrets: []*AppSecret{}},
+			wantedHumanString: `Secrets
+
+  No secrets found.
+`,
+			wantedJSONString: `{"secrets":[]}
+`,
+		},
+		"secrets": {
+			in: &AppSecrets{
+				Secrets: []*AppSecret{
+					{
+						Name: "db-password",
+						Environments: []*SecretParameter{
+							{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/db-password", Version: 2, LastModified: lastModified},
+							{Environment: "prod", Store: "secretsmanager", Parameter: "copilot/phonetool/prod/secrets/db-password", LastModified: lastModified},
+						},
+					},
+				},
+			},
+			wantedHumanString: `Secrets
+
+  Name         Environments  Last Modified
+  ----         ------------  -------------
+  db-password  test, prod    2 hours ago
+`,
+			wantedJSONString: `{"secrets":[{"name":"db-password","environments":[{"environment":"test","store":"ssm","parameter":"/copilot/phonetool/test/secrets/db-password","version":2,"lastModified":"2023-03-01T02:00:00Z"},{"environment":"prod","store":"secretsmanager","parameter":"copilot/phonetool/prod/secrets/db-password","lastModified":"2023-03-01T02:00:00Z"}]}]}
+`,
+		},
+		"secret with values": {
+			in: &AppSecret{
+				Name: "db-password",
+				Environments: []*SecretParameter{
+					{Environment: "test", Store: "ssm", Parameter: "/copilot/phonetool/test/secrets/db-password", Version: 2, LastModified: lastModified, Value: "hunter2"},
+					{Environment: "prod", Store: "secretsmanager", Parameter: "copilot/phonetool/prod/secrets/db-password", LastModified: lastModified, Value: "correct horse"},
+				},
+			},
+			wantedHumanString: `About
+
+  Name    db-password
+
+Environments
+
+  Environment  Store           Name                                         Version   Last Modified  Value
+  -----------  -----           ----                                         -------   -------------  -----
+  test         ssm             /copilot/phonetool/test/secrets/db-password  2         2 hours ago    hunter2
+  prod         secretsmanager  copilot/phonetool/prod/secrets/db-password   -         2 hours ago    correct horse
+`,
+			wantedJSONString: `{"name":"db-password","environments":[{"environment":"test","store":"ssm","parameter":"/copilot/phonetool/test/secrets/db-password","version":2,"lastModified":"2023-03-01T02:00:00Z","value":"hunter2"},{"environment":"prod","store":"secretsmanager","parameter":"copilot/phonetool/prod/secrets/db-password","lastModified":"2023-03-01T02:00:00Z","value":"correct horse"}]}
+`,
+		},
+		"no missing secrets": {
+			in: &SecretsDiff{
+				Environments: []string{"test", "prod"},
+				Missing:      []*MissingSecret{},
+			},
+			wantedHumanString: `Missing Secrets
+
+  Every secret is present in test, prod.
+`,
+			wantedJSONString: `{"environments":["test","prod"],"missing":[]}
+`,
+		},
+		"missing secrets": {
+			in: &SecretsDiff{
+				Environments: []string{"test", "prod"},
+				Missing: []*MissingSecret{
+					{
+						Name:      "api-key",
+						PresentIn: []string{"test"},
+						MissingIn: []string{"prod"},
+					},
+				},
+			},
+			wantedHumanString: `Missing Secrets
+
+  Name     test      prod
+  ----     ----      ----
+  api-key  ✔         ✘
+`,
+			wantedJSONString: `{"environments":["test","prod"],"missing":[{"name":"api-key","presentIn":["test"],"missingIn":["prod"]}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.in.HumanString()
+			json, err := tc.in.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
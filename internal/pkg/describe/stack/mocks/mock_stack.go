@@ -49,6 +49,21 @@ func (mr *MockcfnMockRecorder) Describe(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockcfn)(nil).Describe), name)
 }
 
+// DetectStackDrift mocks base method.
+func (m *Mockcfn) DetectStackDrift(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectStackDrift", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectStackDrift indicates an expected call of DetectStackDrift.
+func (mr *MockcfnMockRecorder) DetectStackDrift(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectStackDrift", reflect.TypeOf((*Mockcfn)(nil).DetectStackDrift), stackName)
+}
+
 // Metadata mocks base method.
 func (m *Mockcfn) Metadata(opt cloudformation.MetadataOpts) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +79,21 @@ func (mr *MockcfnMockRecorder) Metadata(opt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*Mockcfn)(nil).Metadata), opt)
 }
 
+// StackResourceDrifts mocks base method.
+func (m *Mockcfn) StackResourceDrifts(stackName string) ([]*cloudformation.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResourceDrifts", stackName)
+	ret0, _ := ret[0].([]*cloudformation.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResourceDrifts indicates an expected call of StackResourceDrifts.
+func (mr *MockcfnMockRecorder) StackResourceDrifts(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResourceDrifts", reflect.TypeOf((*Mockcfn)(nil).StackResourceDrifts), stackName)
+}
+
 // StackResources mocks base method.
 func (m *Mockcfn) StackResources(name string) ([]*cloudformation.StackResource, error) {
 	m.ctrl.T.Helper()
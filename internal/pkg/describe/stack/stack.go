@@ -15,6 +15,8 @@ type cfn interface {
 	Describe(name string) (*cloudformation.StackDescription, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	DetectStackDrift(stackName string) (string, error)
+	StackResourceDrifts(stackName string) ([]*cloudformation.StackResourceDrift, error)
 }
 
 // StackDescription is the description of a cloudformation stack.
@@ -36,6 +38,24 @@ func (c Resource) HumanString() string {
 	return fmt.Sprintf("%s\t%s\n", c.Type, c.PhysicalID)
 }
 
+// ResourceDrift contains the drift of a cloudformation stack resource that was modified or deleted
+// outside of CloudFormation.
+type ResourceDrift struct {
+	LogicalID   string               `json:"logicalID"`
+	PhysicalID  string               `json:"physicalID"`
+	Type        string               `json:"type"`
+	Status      string               `json:"status"`
+	Differences []PropertyDifference `json:"differences,omitempty"`
+}
+
+// PropertyDifference is a resource property whose actual value differs from the value expected by the template.
+type PropertyDifference struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"` // One of ADD, REMOVE or NOT_EQUAL.
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
 // StackDescriber retrieves information about a stack.
 type StackDescriber struct {
 	name string
@@ -102,6 +122,47 @@ func (d *StackDescriber) StackSetMetadata() (string, error) {
 	return metadata, nil
 }
 
+// Name returns the name of the stack.
+func (d *StackDescriber) Name() string {
+	return d.name
+}
+
+// DetectDrift starts a drift detection operation on the stack and returns the ID of the operation.
+func (d *StackDescriber) DetectDrift() (string, error) {
+	id, err := d.cfn.DetectStackDrift(d.name)
+	if err != nil {
+		return "", fmt.Errorf("start drift detection for stack %s: %w", d.name, err)
+	}
+	return id, nil
+}
+
+// ResourceDrifts returns the resources of the stack that drifted according to the latest drift detection operation.
+func (d *StackDescriber) ResourceDrifts() ([]*ResourceDrift, error) {
+	drifts, err := d.cfn.StackResourceDrifts(d.name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resource drifts for stack %s: %w", d.name, err)
+	}
+	var resources []*ResourceDrift
+	for _, drift := range drifts {
+		resource := &ResourceDrift{
+			LogicalID:  aws.StringValue(drift.LogicalResourceId),
+			PhysicalID: aws.StringValue(drift.PhysicalResourceId),
+			Type:       aws.StringValue(drift.ResourceType),
+			Status:     aws.StringValue(drift.StackResourceDriftStatus),
+		}
+		for _, diff := range drift.PropertyDifferences {
+			resource.Differences = append(resource.Differences, PropertyDifference{
+				Path:     aws.StringValue(diff.PropertyPath),
+				Type:     aws.StringValue(diff.DifferenceType),
+				Expected: aws.StringValue(diff.ExpectedValue),
+				Actual:   aws.StringValue(diff.ActualValue),
+			})
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
 func flattenResources(stackResources []*cloudformation.StackResource) []*Resource {
 	var resources []*Resource
 	for _, stackResource := range stackResources {
@@ -228,3 +228,97 @@ func TestStackDescriber_Metadata(t *testing.T) {
 		})
 	}
 }
+
+func TestStackDescriber_ResourceDrifts(t *testing.T) {
+	const mockStackName = "phonetool"
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedDrifts []*ResourceDrift
+		wantedError  error
+	}{
+		"return error if fail to get resource drifts": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().StackResourceDrifts(mockStackName).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve resource drifts for stack phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().StackResourceDrifts(mockStackName).Return([]*cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("EnvironmentSecurityGroup"),
+						PhysicalResourceId:       aws.String("sg-1234"),
+						ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+						StackResourceDriftStatus: aws.String(sdkcfn.StackResourceDriftStatusModified),
+						PropertyDifferences: []*sdkcfn.PropertyDifference{
+							{
+								PropertyPath:   aws.String("/SecurityGroupIngress/0/FromPort"),
+								DifferenceType: aws.String(sdkcfn.DifferenceTypeNotEqual),
+								ExpectedValue:  aws.String("80"),
+								ActualValue:    aws.String("8080"),
+							},
+						},
+					},
+					{
+						LogicalResourceId:        aws.String("Queue"),
+						PhysicalResourceId:       aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/queue"),
+						ResourceType:             aws.String("AWS::SQS::Queue"),
+						StackResourceDriftStatus: aws.String(sdkcfn.StackResourceDriftStatusDeleted),
+					},
+				}, nil)
+			},
+			wantedDrifts: []*ResourceDrift{
+				{
+					LogicalID:  "EnvironmentSecurityGroup",
+					PhysicalID: "sg-1234",
+					Type:       "AWS::EC2::SecurityGroup",
+					Status:     "MODIFIED",
+					Differences: []PropertyDifference{
+						{
+							Path:     "/SecurityGroupIngress/0/FromPort",
+							Type:     "NOT_EQUAL",
+							Expected: "80",
+							Actual:   "8080",
+						},
+					},
+				},
+				{
+					LogicalID:  "Queue",
+					PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/queue",
+					Type:       "AWS::SQS::Queue",
+					Status:     "DELETED",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			tc.setupMocks(stackDescriberMocks{
+				cfn: mockcfn,
+			})
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.ResourceDrifts()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, actual)
+			}
+		})
+	}
+}
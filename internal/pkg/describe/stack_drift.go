@@ -0,0 +1,267 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	awsarn "github.com/aws/aws-sdk-go/aws/arn"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"golang.org/x/sync/errgroup"
+)
+
+const nestedStackResourceType = "AWS::CloudFormation::Stack"
+
+type stackDriftDetector interface {
+	Name() string
+	Resources() ([]*stack.Resource, error)
+	DetectDrift() (string, error)
+	ResourceDrifts() ([]*stack.ResourceDrift, error)
+}
+
+// StackDriftDescriber detects and describes the drift of an environment or workload stack and its nested stacks,
+// such as the addons stack.
+type StackDriftDescriber struct {
+	stackName string
+
+	newStackDescriber  func(stackName string) stackDriftDetector
+	detectionDescriber stream.StackDriftDetectionDescriber
+}
+
+// NewStackDriftConfig contains fields that initiates a StackDriftDescriber struct.
+type NewStackDriftConfig struct {
+	App         string
+	Env         string
+	Workload    string // Name of the workload, empty to describe the drift of the environment stack.
+	ConfigStore ConfigStoreSvc
+}
+
+// StackDriftReport contains the drift status of a stack and its nested stacks.
+type StackDriftReport struct {
+	Stacks []StackDrift `json:"stacks"`
+}
+
+// StackDrift contains the drift status of a stack along with its drifted resources.
+type StackDrift struct {
+	Name      string                 `json:"name"`
+	Status    string                 `json:"status"`
+	Reason    string                 `json:"reason,omitempty"`
+	Resources []*stack.ResourceDrift `json:"resources,omitempty"`
+}
+
+// NewStackDriftDescriber instantiates a new StackDriftDescriber struct.
+func NewStackDriftDescriber(opt NewStackDriftConfig) (*StackDriftDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	stackName := cfnstack.NameForEnv(opt.App, opt.Env)
+	if opt.Workload != "" {
+		stackName = cfnstack.NameForService(opt.App, opt.Env, opt.Workload)
+	}
+	return &StackDriftDescriber{
+		stackName: stackName,
+		newStackDescriber: func(name string) stackDriftDetector {
+			return stack.NewStackDescriber(name, sess)
+		},
+		detectionDescriber: cloudformation.New(sess),
+	}, nil
+}
+
+// Describe starts drift detection on the stack and its nested stacks, waits until the detections complete,
+// and returns the drifted resources of each stack.
+func (d *StackDriftDescriber) Describe() (*StackDriftReport, error) {
+	root := d.newStackDescriber(d.stackName)
+	resources, err := root.Resources()
+	if err != nil {
+		return nil, err
+	}
+	stacks := []stackDriftDetector{root}
+	for _, resource := range resources {
+		if resource.Type != nestedStackResourceType || resource.PhysicalID == "" {
+			continue
+		}
+		stacks = append(stacks, d.newStackDescriber(resource.PhysicalID))
+	}
+
+	report := &StackDriftReport{
+		Stacks: make([]StackDrift, len(stacks)),
+	}
+	g, ctx := errgroup.WithContext(context.Background())
+	for i := range stacks {
+		i := i
+		g.Go(func() error {
+			drift, err := d.describeStack(ctx, stacks[i])
+			if err != nil {
+				return err
+			}
+			report.Stacks[i] = *drift
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (d *StackDriftDescriber) describeStack(ctx context.Context, s stackDriftDetector) (*StackDrift, error) {
+	name := stackNameFromID(s.Name())
+	id, err := s.DetectDrift()
+	if err != nil {
+		return nil, err
+	}
+	streamer := stream.NewStackDriftDetectionStreamer(d.detectionDescriber, name, id)
+
+	// Keep track of the latest status to report the result of the detection.
+	var last stream.StackDriftDetection
+	statuses := streamer.Subscribe()
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for status := range statuses {
+			last = status
+		}
+		return nil
+	})
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	drift := &StackDrift{
+		Name:   name,
+		Status: last.StackDriftStatus,
+	}
+	if last.Status == sdkcfn.StackDriftDetectionStatusDetectionFailed {
+		drift.Reason = last.StatusReason
+	}
+	if drift.Status != sdkcfn.StackDriftStatusDrifted {
+		return drift, nil
+	}
+	drift.Resources, err = s.ResourceDrifts()
+	if err != nil {
+		return nil, err
+	}
+	return drift, nil
+}
+
+// stackNameFromID returns the name of a stack given its ARN, or the input if it's already a name.
+func stackNameFromID(id string) string {
+	parsed, err := awsarn.Parse(id)
+	if err != nil {
+		return id
+	}
+	// The resource of a stack ARN is formatted as "stack/<name>/<uuid>".
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 {
+		return id
+	}
+	return parts[1]
+}
+
+// HasDrift returns true if any of the stacks drifted.
+func (r *StackDriftReport) HasDrift() bool {
+	for _, s := range r.Stacks {
+		if s.Status == sdkcfn.StackDriftStatusDrifted {
+			return true
+		}
+	}
+	return false
+}
+
+// UndetectedStacks returns the names of the stacks whose drift status could not be determined,
+// such as the stacks whose drift detection failed or whose status is UNKNOWN.
+func (r *StackDriftReport) UndetectedStacks() []string {
+	var names []string
+	for _, s := range r.Stacks {
+		if s.Status == sdkcfn.StackDriftStatusInSync || s.Status == sdkcfn.StackDriftStatusDrifted {
+			continue
+		}
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// JSONString returns stringified StackDriftReport struct with json format.
+func (r *StackDriftReport) JSONString() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshal stack drift report: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified StackDriftReport struct with human readable format.
+func (r *StackDriftReport) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Stacks\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Drift Status", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, s := range r.Stacks {
+		reason := "-"
+		if s.Reason != "" {
+			reason = s.Reason
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", s.Name, driftStatusColor(s.Status), reason)
+	}
+	writer.Flush()
+	if !r.HasDrift() {
+		return b.String()
+	}
+
+	fmt.Fprint(writer, color.Bold.Sprint("\nDrifted Resources\n"))
+	writer.Flush()
+	for _, s := range r.Stacks {
+		if len(s.Resources) == 0 {
+			continue
+		}
+		fmt.Fprintf(writer, "\n  %s\n", s.Name)
+		for _, resource := range s.Resources {
+			fmt.Fprintf(writer, "    %s\t%s\t%s\n", resource.LogicalID, resource.Type, driftStatusColor(resource.Status))
+			writer.Flush()
+			for _, diff := range resource.Differences {
+				fmt.Fprintf(writer, "      %s\n", diff.Path)
+				if diff.Expected != "" {
+					fmt.Fprintf(writer, "        %s\n", color.Red.Sprintf("- %s", diff.Expected))
+				}
+				if diff.Actual != "" {
+					fmt.Fprintf(writer, "        %s\n", color.Green.Sprintf("+ %s", diff.Actual))
+				}
+			}
+			writer.Flush()
+		}
+	}
+	return b.String()
+}
+
+func driftStatusColor(status string) string {
+	switch status {
+	case sdkcfn.StackDriftStatusInSync:
+		return color.Green.Sprint(status)
+	case sdkcfn.StackDriftStatusDrifted, sdkcfn.StackResourceDriftStatusModified, sdkcfn.StackResourceDriftStatusDeleted:
+		return color.Red.Sprint(status)
+	default:
+		return color.Yellow.Sprint(status)
+	}
+}
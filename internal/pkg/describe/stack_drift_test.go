@@ -0,0 +1,266 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDriftDetectionDescriber struct {
+	detections map[string]*cloudformation.DriftDetection
+}
+
+func (f *fakeDriftDetectionDescriber) DriftDetectionStatus(detectionID string) (*cloudformation.DriftDetection, error) {
+	detection, ok := f.detections[detectionID]
+	if !ok {
+		return nil, errors.New("some error")
+	}
+	return detection, nil
+}
+
+func TestStackDriftDescriber_Describe(t *testing.T) {
+	const (
+		mockStackName  = "phonetool-test-api"
+		mockAddonsARN  = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1A2B3C/abc-123"
+		mockAddonsName = "phonetool-test-api-AddonsStack-1A2B3C"
+	)
+	mockDrift := &stack.ResourceDrift{
+		LogicalID:  "EnvironmentSecurityGroup",
+		PhysicalID: "sg-1234",
+		Type:       "AWS::EC2::SecurityGroup",
+		Status:     "MODIFIED",
+		Differences: []stack.PropertyDifference{
+			{
+				Path:     "/SecurityGroupIngress/0/FromPort",
+				Type:     "NOT_EQUAL",
+				Expected: "80",
+				Actual:   "8080",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(root, addons *mocks.MockstackDriftDetector)
+		detections map[string]*cloudformation.DriftDetection
+
+		wantedReport *StackDriftReport
+		wantedError  error
+	}{
+		"return error if fail to list the resources of the stack": {
+			setupMocks: func(root, addons *mocks.MockstackDriftDetector) {
+				root.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return error if fail to start drift detection": {
+			setupMocks: func(root, addons *mocks.MockstackDriftDetector) {
+				root.EXPECT().Resources().Return(nil, nil)
+				root.EXPECT().Name().Return(mockStackName)
+				root.EXPECT().DetectDrift().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return error if fail to wait for drift detection": {
+			setupMocks: func(root, addons *mocks.MockstackDriftDetector) {
+				root.EXPECT().Resources().Return(nil, nil)
+				root.EXPECT().Name().Return(mockStackName)
+				root.EXPECT().DetectDrift().Return("unknown-detection", nil)
+			},
+			wantedError: errors.New("fetch drift detection status of stack phonetool-test-api: some error"),
+		},
+		"describes the drift of the stack and its nested stacks": {
+			setupMocks: func(root, addons *mocks.MockstackDriftDetector) {
+				root.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-1234",
+						LogicalID:  "EnvironmentSecurityGroup",
+					},
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: mockAddonsARN,
+						LogicalID:  "AddonsStack",
+					},
+				}, nil)
+				root.EXPECT().Name().Return(mockStackName)
+				root.EXPECT().DetectDrift().Return("root-detection", nil)
+				root.EXPECT().ResourceDrifts().Return([]*stack.ResourceDrift{mockDrift}, nil)
+				addons.EXPECT().Name().Return(mockAddonsARN)
+				addons.EXPECT().DetectDrift().Return("addons-detection", nil)
+			},
+			detections: map[string]*cloudformation.DriftDetection{
+				"root-detection": {
+					Status:               "DETECTION_COMPLETE",
+					StackDriftStatus:     "DRIFTED",
+					DriftedResourceCount: 1,
+				},
+				"addons-detection": {
+					Status:           "DETECTION_FAILED",
+					StatusReason:     "Failed to detect drift on resource [Table]",
+					StackDriftStatus: "IN_SYNC",
+				},
+			},
+			wantedReport: &StackDriftReport{
+				Stacks: []StackDrift{
+					{
+						Name:      mockStackName,
+						Status:    "DRIFTED",
+						Resources: []*stack.ResourceDrift{mockDrift},
+					},
+					{
+						Name:   mockAddonsName,
+						Status: "IN_SYNC",
+						Reason: "Failed to detect drift on resource [Table]",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			root := mocks.NewMockstackDriftDetector(ctrl)
+			addons := mocks.NewMockstackDriftDetector(ctrl)
+			tc.setupMocks(root, addons)
+
+			d := &StackDriftDescriber{
+				stackName: mockStackName,
+				newStackDescriber: func(name string) stackDriftDetector {
+					if name == mockAddonsARN {
+						return addons
+					}
+					return root
+				},
+				detectionDescriber: &fakeDriftDetectionDescriber{
+					detections: tc.detections,
+				},
+			}
+
+			// WHEN
+			report, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedReport, report)
+		})
+	}
+}
+
+func TestStackDriftReport_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		report *StackDriftReport
+
+		wanted string
+	}{
+		"stacks in sync": {
+			report: &StackDriftReport{
+				Stacks: []StackDrift{
+					{Name: "phonetool-test", Status: "IN_SYNC"},
+				},
+			},
+			wanted: `Stacks
+
+  Name            Drift Status  Reason
+  ----            ------------  ------
+  phonetool-test  IN_SYNC       -
+`,
+		},
+		"drifted stacks": {
+			report: &StackDriftReport{
+				Stacks: []StackDrift{
+					{
+						Name:   "phonetool-test",
+						Status: "DRIFTED",
+						Resources: []*stack.ResourceDrift{
+							{
+								LogicalID: "EnvironmentSecurityGroup",
+								Type:      "AWS::EC2::SecurityGroup",
+								Status:    "MODIFIED",
+								Differences: []stack.PropertyDifference{
+									{
+										Path:     "/SecurityGroupIngress/0/FromPort",
+										Type:     "NOT_EQUAL",
+										Expected: "80",
+										Actual:   "8080",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: `Stacks
+
+  Name            Drift Status  Reason
+  ----            ------------  ------
+  phonetool-test  DRIFTED       -
+
+Drifted Resources
+
+  phonetool-test
+    EnvironmentSecurityGroup  AWS::EC2::SecurityGroup  MODIFIED
+      /SecurityGroupIngress/0/FromPort
+        - 80
+        + 8080
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.report.HumanString())
+		})
+	}
+}
+
+func TestStackDriftReport_JSONString(t *testing.T) {
+	report := &StackDriftReport{
+		Stacks: []StackDrift{
+			{
+				Name:   "phonetool-test",
+				Status: "DRIFTED",
+				Resources: []*stack.ResourceDrift{
+					{
+						LogicalID:  "Queue",
+						PhysicalID: "queue-url",
+						Type:       "AWS::SQS::Queue",
+						Status:     "DELETED",
+					},
+				},
+			},
+		},
+	}
+
+	out, err := report.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"stacks":[{"name":"phonetool-test","status":"DRIFTED","resources":[{"logicalID":"Queue","physicalID":"queue-url","type":"AWS::SQS::Queue","status":"DELETED"}]}]}`+"\n", out)
+	require.True(t, report.HasDrift())
+}
+
+func TestStackDriftReport_UndetectedStacks(t *testing.T) {
+	report := &StackDriftReport{
+		Stacks: []StackDrift{
+			{Name: "phonetool-test", Status: "IN_SYNC"},
+			{Name: "phonetool-test-api", Status: "DRIFTED"},
+			{Name: "phonetool-test-api-AddonsStack-1ABCDEF", Status: "UNKNOWN"},
+			{Name: "phonetool-test-api-AddonsStack-2ABCDEF", Status: "", Reason: "Drift detection failed"},
+		},
+	}
+
+	require.Equal(t, []string{"phonetool-test-api-AddonsStack-1ABCDEF", "phonetool-test-api-AddonsStack-2ABCDEF"}, report.UndetectedStacks())
+	require.Nil(t, (&StackDriftReport{Stacks: report.Stacks[:2]}).UndetectedStacks())
+}
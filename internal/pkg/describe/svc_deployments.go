@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const shortImageDigestLength = 12 // Number of hexadecimal characters of an image digest to display in a table.
+
+// RevisionLister lists the recorded deployments of a workload.
+type RevisionLister interface {
+	List(env, workload string, limit int) ([]*revision.Revision, error)
+}
+
+// SvcDeploymentsDescriber retrieves the deployment history of a service.
+type SvcDeploymentsDescriber struct {
+	env   string
+	svc   string
+	limit int
+
+	revisions RevisionLister
+}
+
+// NewSvcDeploymentsConfig contains fields that initiates a SvcDeploymentsDescriber struct.
+type NewSvcDeploymentsConfig struct {
+	Env       string
+	Svc       string
+	Limit     int // Maximum number of deployments to describe.
+	Revisions RevisionLister
+}
+
+// SvcDeployments contains the recorded deployments of a service, starting with the latest one.
+type SvcDeployments struct {
+	Deployments []SvcDeployment `json:"deployments"`
+}
+
+// SvcDeployment contains a revision of a service that can be rolled back to.
+type SvcDeployment struct {
+	Revision   int               `json:"revision"`
+	DeployedAt time.Time         `json:"deployedAt"`
+	Images     map[string]string `json:"images,omitempty"`
+	RolledBack int               `json:"rolledBack,omitempty"`
+}
+
+// NewSvcDeploymentsDescriber instantiates a new SvcDeploymentsDescriber struct.
+func NewSvcDeploymentsDescriber(opt *NewSvcDeploymentsConfig) *SvcDeploymentsDescriber {
+	return &SvcDeploymentsDescriber{
+		env:       opt.Env,
+		svc:       opt.Svc,
+		limit:     opt.Limit,
+		revisions: opt.Revisions,
+	}
+}
+
+// Describe returns the recent deployments of a service.
+func (d *SvcDeploymentsDescriber) Describe() (HumanJSONStringer, error) {
+	revs, err := d.revisions.List(d.env, d.svc, d.limit)
+	if err != nil {
+		return nil, fmt.Errorf("list deployments of service %s: %w", d.svc, err)
+	}
+	deployments := &SvcDeployments{
+		Deployments: []SvcDeployment{},
+	}
+	for _, rev := range revs {
+		deployments.Deployments = append(deployments.Deployments, SvcDeployment{
+			Revision:   rev.Number,
+			DeployedAt: rev.DeployedAt,
+			Images:     rev.Images,
+			RolledBack: rev.RolledBack,
+		})
+	}
+	return deployments, nil
+}
+
+// JSONString returns stringified SvcDeployments struct with json format.
+func (d *SvcDeployments) JSONString() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("marshal service deployments: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified SvcDeployments struct with human readable format.
+func (d *SvcDeployments) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Deployments\n\n"))
+	writer.Flush()
+	if len(d.Deployments) == 0 {
+		fmt.Fprintln(writer, "  No deployments found.")
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Revision", "Deployed At", "Images", "Note"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, deployment := range d.Deployments {
+		note := "-"
+		if deployment.RolledBack != 0 {
+			note = fmt.Sprintf("Rollback to revision %d", deployment.RolledBack)
+		}
+		fmt.Fprintf(writer, "  %d\t%s\t%s\t%s\n", deployment.Revision, humanizeTime(deployment.DeployedAt),
+			shortImageDigests(deployment.Images), note)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// shortImageDigests returns the abbreviated image digest of each container sorted by container name.
+func shortImageDigests(images map[string]string) string {
+	if len(images) == 0 {
+		return "-"
+	}
+	containers := make([]string, 0, len(images))
+	for container := range images {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+	digests := make([]string, len(containers))
+	for i, container := range containers {
+		digest := strings.TrimPrefix(images[container], "sha256:")
+		if len(digest) > shortImageDigestLength {
+			digest = digest[:shortImageDigestLength]
+		}
+		digests[i] = fmt.Sprintf("%s@%s", container, digest)
+	}
+	return strings.Join(digests, ", ")
+}
@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDeploymentsDescriber_Describe(t *testing.T) {
+	deployedAt := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockRevisionLister)
+
+		wanted    HumanJSONStringer
+		wantedErr error
+	}{
+		"errors if failed to list the revisions": {
+			setupMocks: func(m *mocks.MockRevisionLister) {
+				m.EXPECT().List("test", "api", 5).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list deployments of service api: some error"),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockRevisionLister) {
+				m.EXPECT().List("test", "api", 5).Return([]*revision.Revision{
+					{
+						Number:     2,
+						DeployedAt: deployedAt,
+						Template:   "Resources: {}",
+						RolledBack: 1,
+					},
+					{
+						Number:     1,
+						DeployedAt: deployedAt,
+						Images:     map[string]string{"api": "sha256:abc"},
+					},
+				}, nil)
+			},
+			wanted: &SvcDeployments{
+				Deployments: []SvcDeployment{
+					{
+						Revision:   2,
+						DeployedAt: deployedAt,
+						RolledBack: 1,
+					},
+					{
+						Revision:   1,
+						DeployedAt: deployedAt,
+						Images:     map[string]string{"api": "sha256:abc"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockRevisionLister(ctrl)
+			tc.setupMocks(m)
+			d := NewSvcDeploymentsDescriber(&NewSvcDeploymentsConfig{
+				Env:       "test",
+				Svc:       "api",
+				Limit:     5,
+				Revisions: m,
+			})
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSvcDeployments_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 hours ago"
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	deployedAt := time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		deployments *SvcDeployments
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"no deployments": {
+			deployments: &SvcDeployments{Deployments: []SvcDeployment{}},
+			wantedHumanString: `Deployments
+
+  No deployments found.
+`,
+			wantedJSONString: `{"deployments":[]}
+`,
+		},
+		"deployments": {
+			deployments: &SvcDeployments{
+				Deployments: []SvcDeployment{
+					{
+						Revision:   3,
+						DeployedAt: deployedAt,
+						Images: map[string]string{
+							"nginx": "sha256:0123456789abcdef",
+							"api":   "sha256:fedcba9876543210",
+						},
+						RolledBack: 1,
+					},
+					{
+						Revision:   2,
+						DeployedAt: deployedAt,
+					},
+				},
+			},
+			wantedHumanString: `Deployments
+
+  Revision  Deployed At  Images                                Note
+  --------  -----------  ------                                ----
+  3         2 hours ago  api@fedcba987654, nginx@0123456789ab  Rollback to revision 1
+  2         2 hours ago  -                                     -
+`,
+			wantedJSONString: `{"deployments":[{"revision":3,"deployedAt":"2023-03-01T02:00:00Z","images":{"api":"sha256:fedcba9876543210","nginx":"sha256:0123456789abcdef"},"rolledBack":1},{"revision":2,"deployedAt":"2023-03-01T02:00:00Z"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.deployments.HumanString()
+			json, err := tc.deployments.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
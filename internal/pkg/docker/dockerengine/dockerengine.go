@@ -8,11 +8,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -36,11 +38,16 @@ const (
 
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
+
+	buildxDriverDocker          = "docker"
+	buildxDriverDockerContainer = "docker-container"
+	multiPlatformBuilderName    = "copilot-multi-platform" // Builder created when the current builder can't build multi-platform images.
 )
 
 // CmdClient represents the docker client to interact with the server via external commands.
 type CmdClient struct {
-	runner Cmd
+	runner    Cmd
+	logWriter io.Writer // Where the informational messages are written, defaults to the diagnostic writer.
 	// Override in unit tests.
 	buf       *bytes.Buffer
 	homePath  string
@@ -56,6 +63,13 @@ func New(cmd Cmd) CmdClient {
 	}
 }
 
+// WithLogWriter returns a copy of the client that writes its informational messages to w instead of the diagnostic writer,
+// for example to keep the messages of concurrent builds apart.
+func (c CmdClient) WithLogWriter(w io.Writer) CmdClient {
+	c.logWriter = w
+	return c
+}
+
 // BuildArguments holds the arguments that can be passed while building a container.
 type BuildArguments struct {
 	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
@@ -65,9 +79,33 @@ type BuildArguments struct {
 	Target     string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
 	Platform   string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms  []string          // Optional. List of OS/Arch to build a multi-platform image for with `docker buildx build`.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 }
 
+// RunOptions holds the options to run a container.
+type RunOptions struct {
+	ImageURI       string              // Required. The image to run.
+	ContainerName  string              // Required. The name of the container.
+	NetworkName    string              // Optional. The network to connect the container to.
+	NetworkAliases []string            // Optional. Names to reach the container from the other containers of the network.
+	PortMappings   map[string]string   // Optional. Container ports to publish on the host, keyed by host port.
+	EnvVars        map[string]string   // Optional. Environment variables to set in the container.
+	Secrets        map[string]string   // Optional. Environment variables whose values must not appear in the command line.
+	EntryPoint     []string            // Optional. Overrides the entrypoint of the image.
+	Command        []string            // Optional. Overrides the command of the image.
+	HealthCheck    *HealthCheckOptions // Optional. Overrides the health check of the image.
+}
+
+// HealthCheckOptions holds the options of a container health check.
+type HealthCheckOptions struct {
+	Command     []string // Required. Formatted as in a task definition, for example ["CMD-SHELL", "curl -f http://localhost/ || exit 1"].
+	Interval    time.Duration
+	Retries     int
+	Timeout     time.Duration
+	StartPeriod time.Duration
+}
+
 type dockerConfig struct {
 	CredsStore  string            `json:"credsStore,omitempty"`
 	CredHelpers map[string]string `json:"credHelpers,omitempty"`
@@ -75,15 +113,105 @@ type dockerConfig struct {
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
 func (c CmdClient) Build(in *BuildArguments) error {
+	args := append([]string{"build"}, c.buildFlags(in, in.Platform)...)
+	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
+	if in.Platform != "" {
+		log.New(log.WriterOrDiagnostic(c.logWriter)).Infof("Building your container image: docker %s\n", strings.Join(args, " "))
+	}
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("building image: %w", err)
+	}
+
+	return nil
+}
+
+// BuildAndPushMultiPlatform will run a `docker buildx build` command to build an image for each of the platforms of the build arguments,
+// push the images along with their manifest list to the ecr repo URI, and return the digest of the manifest list.
+func (c CmdClient) BuildAndPushMultiPlatform(in *BuildArguments) (digest string, err error) {
+	builder, err := c.multiPlatformBuilder()
+	if err != nil {
+		return "", err
+	}
+	args := []string{"buildx", "build"}
+	if builder != "" {
+		args = append(args, "--builder", builder)
+	}
+	// Multi-platform images can't be loaded in the local image store, so buildx pushes them as part of the build.
+	args = append(args, "--push")
+	args = append(args, c.buildFlags(in, strings.Join(in.Platforms, ","))...)
+	log.New(log.WriterOrDiagnostic(c.logWriter)).Infof("Building your multi-platform container image: docker %s\n", strings.Join(args, " "))
+	if err := c.runner.Run("docker", args); err != nil {
+		return "", fmt.Errorf("building multi-platform image: %w", err)
+	}
+
+	img := taggedImage(in.URI, in.Tags)
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", []string{"buildx", "imagetools", "inspect", img, "--format", "{{json .Manifest}}"}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect manifest list digest for %s: %w", img, err)
+	}
+	var manifestList struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal([]byte(buf.String()), &manifestList); err != nil || manifestList.Digest == "" {
+		return "", fmt.Errorf("parse the digest from the manifest list '%s'", strings.TrimSpace(buf.String()))
+	}
+	return manifestList.Digest, nil
+}
+
+// multiPlatformBuilder returns the name of the buildx builder to build multi-platform images with,
+// or an empty string if the current builder can build them.
+func (c CmdClient) multiPlatformBuilder() (string, error) {
+	driver, err := c.builderDriver("")
+	if err != nil {
+		return "", fmt.Errorf("docker buildx is required to build images for multiple platforms: %w", err)
+	}
+	if driver != buildxDriverDocker {
+		return "", nil
+	}
+	// The "docker" driver can only build images for the platform of the engine, so we build with a
+	// "docker-container" builder instead, and create it the first time.
+	if _, err := c.builderDriver(multiPlatformBuilderName); err == nil {
+		return multiPlatformBuilderName, nil
+	}
+	if err := c.runner.Run("docker", []string{"buildx", "create", "--name", multiPlatformBuilderName, "--driver", buildxDriverDockerContainer}); err != nil {
+		return "", fmt.Errorf("create buildx builder %s with the %s driver to build images for multiple platforms: %w", multiPlatformBuilderName, buildxDriverDockerContainer, err)
+	}
+	return multiPlatformBuilderName, nil
+}
+
+// builderDriver returns the driver of the buildx builder with the name, or of the current builder if the name is empty.
+func (c CmdClient) builderDriver(name string) (string, error) {
+	args := []string{"buildx", "inspect"}
+	if name != "" {
+		args = append(args, name)
+	}
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", args, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect buildx builder: %w", err)
+	}
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if driver, ok := strings.CutPrefix(strings.TrimSpace(line), "Driver:"); ok {
+			return strings.TrimSpace(driver), nil
+		}
+	}
+	return "", nil
+}
+
+// buildFlags returns the flags and the positional argument shared by `docker build` and `docker buildx build`.
+func (c CmdClient) buildFlags(in *BuildArguments, platform string) []string {
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
-	args := []string{"build"}
+	var args []string
 
 	// Add additional image tags to the docker build call.
-	args = append(args, "-t", in.URI)
+	// The URI alone is only tagged without additional tags, as it implies the "latest" tag which
+	// the images of other containers in the same repository may be built with at the same time.
+	if len(in.Tags) == 0 {
+		args = append(args, "-t", in.URI)
+	}
 	for _, tag := range in.Tags {
 		args = append(args, "-t", imageName(in.URI, tag))
 	}
@@ -99,8 +227,8 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	}
 
 	// Add platform option.
-	if in.Platform != "" {
-		args = append(args, "--platform", in.Platform)
+	if platform != "" {
+		args = append(args, "--platform", platform)
 	}
 
 	// Plain display if we're in a CI environment.
@@ -119,16 +247,7 @@ func (c CmdClient) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
-	args = append(args, dfDir, "-f", in.Dockerfile)
-	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
-	if in.Platform != "" {
-		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
-	}
-	if err := c.runner.Run("docker", args); err != nil {
-		return fmt.Errorf("building image: %w", err)
-	}
-
-	return nil
+	return append(args, dfDir, "-f", in.Dockerfile)
 }
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
@@ -160,9 +279,10 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 			return "", fmt.Errorf("docker push %s: %w", img, err)
 		}
 	}
+	img := taggedImage(uri, tags)
 	buf := new(strings.Builder)
-	if err := c.runner.Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", uri}, exec.Stdout(buf)); err != nil {
-		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
+	if err := c.runner.Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", img}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect image digest for %s: %w", img, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
 	parts := strings.SplitAfter(repoDigest, "@")
@@ -224,10 +344,132 @@ func (c CmdClient) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+// Run runs a container in the foreground until it exits or is stopped, and removes it once it exits.
+func (c CmdClient) Run(in *RunOptions) error {
+	args := []string{"run", "--rm", "--name", in.ContainerName}
+	if in.NetworkName != "" {
+		args = append(args, "--network", in.NetworkName)
+	}
+	for _, alias := range in.NetworkAliases {
+		args = append(args, "--network-alias", alias)
+	}
+	for _, hostPort := range sortedKeys(in.PortMappings) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, in.PortMappings[hostPort]))
+	}
+	for _, k := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+	// Only the names of the secrets are passed as arguments, docker reads their values from the environment of the command.
+	var secrets []string
+	for _, k := range sortedKeys(in.Secrets) {
+		args = append(args, "--env", k)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", k, in.Secrets[k]))
+	}
+	args = append(args, healthCheckArgs(in.HealthCheck)...)
+	command := in.Command
+	if len(in.EntryPoint) > 0 {
+		// docker only accepts an executable as the entrypoint, the rest of the entrypoint precedes the command.
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		command = append(append([]string{}, in.EntryPoint[1:]...), in.Command...)
+	}
+	args = append(args, in.ImageURI)
+	args = append(args, command...)
+
+	var opts []exec.CmdOption
+	if len(secrets) > 0 {
+		opts = append(opts, exec.Env(secrets...))
+	}
+	if err := c.runner.Run("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ContainerName, err)
+	}
+	return nil
+}
+
+// Stop stops a running container.
+func (c CmdClient) Stop(containerName string) error {
+	if err := c.runner.Run("docker", []string{"stop", containerName}); err != nil {
+		return fmt.Errorf("stop container %s: %w", containerName, err)
+	}
+	return nil
+}
+
+// CreateNetwork creates a bridge network that containers can connect to.
+func (c CmdClient) CreateNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "create", name}); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// NetworkExists returns true if a network with the name exists.
+func (c CmdClient) NetworkExists(name string) (bool, error) {
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", []string{"network", "ls", "--quiet", "--filter", fmt.Sprintf("name=^%s$", name)}, exec.Stdout(buf)); err != nil {
+		return false, fmt.Errorf("list networks named %s: %w", name, err)
+	}
+	return strings.TrimSpace(buf.String()) != "", nil
+}
+
+// RemoveNetwork removes a network.
+func (c CmdClient) RemoveNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "rm", name}); err != nil {
+		return fmt.Errorf("remove network %s: %w", name, err)
+	}
+	return nil
+}
+
+func healthCheckArgs(hc *HealthCheckOptions) []string {
+	if hc == nil || len(hc.Command) == 0 {
+		return nil
+	}
+	var cmd string
+	switch hc.Command[0] {
+	case "NONE":
+		return []string{"--no-healthcheck"}
+	case "CMD", "CMD-SHELL":
+		// docker runs the health check command in a shell.
+		cmd = strings.Join(hc.Command[1:], " ")
+	default:
+		cmd = strings.Join(hc.Command, " ")
+	}
+	args := []string{"--health-cmd", cmd}
+	if hc.Interval != 0 {
+		args = append(args, "--health-interval", hc.Interval.String())
+	}
+	if hc.Retries != 0 {
+		args = append(args, "--health-retries", fmt.Sprintf("%d", hc.Retries))
+	}
+	if hc.Timeout != 0 {
+		args = append(args, "--health-timeout", hc.Timeout.String())
+	}
+	if hc.StartPeriod != 0 {
+		args = append(args, "--health-start-period", hc.StartPeriod.String())
+	}
+	return args
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
 
+// taggedImage returns the image with the first of its tags, so that the image is inspected by its own tag
+// rather than by the "latest" tag that the URI alone implies, which other images may be tagged with.
+func taggedImage(uri string, tags []string) string {
+	if len(tags) == 0 {
+		return uri
+	}
+	return imageName(uri, tags[0])
+}
+
 // IsEcrCredentialHelperEnabled return true if ecr-login is enabled either globally or registry level
 func (c CmdClient) IsEcrCredentialHelperEnabled(uri string) bool {
 	// Make sure the program is able to obtain the home directory
@@ -10,6 +10,7 @@ import (
 	osexec "os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -50,7 +51,6 @@ func TestDockerCommand_Build(t *testing.T) {
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
 				mockCmd.EXPECT().Run("docker", []string{"build",
-					"-t", mockURI,
 					"-t", mockURI + ":" + mockTag1,
 					filepath.FromSlash("mockPath/to"),
 					"-f", "mockPath/to/mockDockerfile"}).Return(mockError)
@@ -65,7 +65,6 @@ func TestDockerCommand_Build(t *testing.T) {
 				mockCmd = NewMockCmd(controller)
 
 				mockCmd.EXPECT().Run("docker", []string{"build",
-					"-t", mockURI,
 					"-t", "mockURI:tag1", filepath.FromSlash("mockPath/to"),
 					"-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
@@ -105,7 +104,6 @@ func TestDockerCommand_Build(t *testing.T) {
 				mockCmd = NewMockCmd(controller)
 
 				mockCmd.EXPECT().Run("docker", []string{"build",
-					"-t", mockURI,
 					"-t", mockURI + ":" + mockTag1,
 					"mockPath/to",
 					"-f", "mockPath/to/mockDockerfile"}).Return(nil)
@@ -118,7 +116,6 @@ func TestDockerCommand_Build(t *testing.T) {
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
 				mockCmd.EXPECT().Run("docker", []string{"build",
-					"-t", mockURI,
 					"-t", mockURI + ":" + mockTag1,
 					"-t", mockURI + ":" + mockTag2,
 					"-t", mockURI + ":" + mockTag3,
@@ -252,7 +249,7 @@ func TestDockerCommand_Push(t *testing.T) {
 		m := NewMockCmd(ctrl)
 		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest"}).Return(nil)
 		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:g123bfc"}).Return(nil)
-		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"}, gomock.Any()).
+		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest"}, gomock.Any()).
 			Do(func(_ string, _ []string, opt exec.CmdOption) {
 				cmd := &osexec.Cmd{}
 				opt(cmd)
@@ -276,7 +273,7 @@ func TestDockerCommand_Push(t *testing.T) {
 		defer ctrl.Finish()
 		m := NewMockCmd(ctrl)
 		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest", "--quiet"}).Return(nil)
-		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"}, gomock.Any()).
+		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest"}, gomock.Any()).
 			Do(func(_ string, _ []string, opt exec.CmdOption) {
 				cmd := &osexec.Cmd{}
 				opt(cmd)
@@ -322,7 +319,7 @@ func TestDockerCommand_Push(t *testing.T) {
 		defer ctrl.Finish()
 		m := NewMockCmd(ctrl)
 		m.EXPECT().Run("docker", []string{"push", "uri:latest"}).Return(nil)
-		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "uri"}, gomock.Any()).Return(errors.New("some error"))
+		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "uri:latest"}, gomock.Any()).Return(errors.New("some error"))
 
 		// WHEN
 		cmd := CmdClient{
@@ -332,7 +329,7 @@ func TestDockerCommand_Push(t *testing.T) {
 		_, err := cmd.Push("uri", "latest")
 
 		// THEN
-		require.EqualError(t, err, "inspect image digest for uri: some error")
+		require.EqualError(t, err, "inspect image digest for uri:latest: some error")
 	})
 	t.Run("returns an error if the repo digest cannot be parsed for the pushed image", func(t *testing.T) {
 		// GIVEN
@@ -341,7 +338,7 @@ func TestDockerCommand_Push(t *testing.T) {
 		m := NewMockCmd(ctrl)
 		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest"}).Return(nil)
 		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:g123bfc"}).Return(nil)
-		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"}, gomock.Any()).
+		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:latest"}, gomock.Any()).
 			Do(func(_ string, _ []string, opt exec.CmdOption) {
 				cmd := &osexec.Cmd{}
 				opt(cmd)
@@ -360,6 +357,141 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_BuildAndPushMultiPlatform(t *testing.T) {
+	const mockURI = "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"
+	mockArgs := &BuildArguments{
+		URI:        mockURI,
+		Tags:       []string{"latest", "g123bfc"},
+		Dockerfile: "mockPath/to/mockDockerfile",
+		Platforms:  []string{"linux/amd64", "linux/arm64"},
+		Args: map[string]string{
+			"GOPROXY": "direct",
+		},
+	}
+	wantedBuildArgs := []string{"buildx", "build", "--push",
+		"-t", mockURI + ":latest",
+		"-t", mockURI + ":g123bfc",
+		"--platform", "linux/amd64,linux/arm64",
+		"--build-arg", "GOPROXY=direct",
+		"mockPath/to", "-f", "mockPath/to/mockDockerfile"}
+	wantedInspectArgs := []string{"buildx", "imagetools", "inspect", mockURI + ":latest", "--format", "{{json .Manifest}}"}
+	builderWithDriver := func(driver string) func(string, []string, exec.CmdOption) {
+		return func(_ string, _ []string, opt exec.CmdOption) {
+			cmd := &osexec.Cmd{}
+			opt(cmd)
+			_, _ = cmd.Stdout.Write([]byte(fmt.Sprintf("Name:   default\nDriver: %s\n\nNodes:\nName:      default\n", driver)))
+		}
+	}
+	currentBuilder := func(m *MockCmd) {
+		m.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any()).Do(builderWithDriver("docker-container")).Return(nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"returns an actionable error if buildx is not available": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any()).Return(errors.New("unknown command"))
+			},
+			wantedError: errors.New("docker buildx is required to build images for multiple platforms: inspect buildx builder: unknown command"),
+		},
+		"returns a wrapped error if fail to create a docker-container builder": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any()).Do(builderWithDriver("docker")).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "inspect", "copilot-multi-platform"}, gomock.Any()).Return(errors.New("no builder found"))
+				m.EXPECT().Run("docker", []string{"buildx", "create", "--name", "copilot-multi-platform", "--driver", "docker-container"}).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("create buildx builder copilot-multi-platform with the docker-container driver to build images for multiple platforms: some error"),
+		},
+		"builds with a new docker-container builder if the current builder uses the docker driver": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any()).Do(builderWithDriver("docker")).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "inspect", "copilot-multi-platform"}, gomock.Any()).Return(errors.New("no builder found"))
+				m.EXPECT().Run("docker", []string{"buildx", "create", "--name", "copilot-multi-platform", "--driver", "docker-container"}).Return(nil)
+				m.EXPECT().Run("docker", append([]string{"buildx", "build", "--builder", "copilot-multi-platform"}, wantedBuildArgs[2:]...)).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("building multi-platform image: some error"),
+		},
+		"builds with the existing docker-container builder if the current builder uses the docker driver": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "inspect"}, gomock.Any()).Do(builderWithDriver("docker")).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "inspect", "copilot-multi-platform"}, gomock.Any()).Do(builderWithDriver("docker-container")).Return(nil)
+				m.EXPECT().Run("docker", append([]string{"buildx", "build", "--builder", "copilot-multi-platform"}, wantedBuildArgs[2:]...)).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("building multi-platform image: some error"),
+		},
+		"returns a wrapped error on failed build": {
+			setupMocks: func(m *MockCmd) {
+				currentBuilder(m)
+				m.EXPECT().Run("docker", wantedBuildArgs).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("building multi-platform image: some error"),
+		},
+		"returns a wrapped error on failure to inspect the manifest list": {
+			setupMocks: func(m *MockCmd) {
+				currentBuilder(m)
+				m.EXPECT().Run("docker", wantedBuildArgs).Return(nil)
+				m.EXPECT().Run("docker", wantedInspectArgs, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("inspect manifest list digest for %s:latest: some error", mockURI),
+		},
+		"returns an error if the digest can't be parsed": {
+			setupMocks: func(m *MockCmd) {
+				currentBuilder(m)
+				m.EXPECT().Run("docker", wantedBuildArgs).Return(nil)
+				m.EXPECT().Run("docker", wantedInspectArgs, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte("{}\n"))
+					}).Return(nil)
+			},
+			wantedError: errors.New("parse the digest from the manifest list '{}'"),
+		},
+		"builds and pushes the images and returns the digest of the manifest list": {
+			setupMocks: func(m *MockCmd) {
+				currentBuilder(m)
+				m.EXPECT().Run("docker", wantedBuildArgs).Return(nil)
+				m.EXPECT().Run("docker", wantedInspectArgs, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`{"mediaType":"application/vnd.oci.image.index.v1+json","digest":"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807","size":855}` + "\n"))
+					}).Return(nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			tc.setupMocks(m)
+			cmd := CmdClient{
+				runner: m,
+				lookupEnv: func(key string) (string, bool) {
+					return "", false
+				},
+			}
+
+			// WHEN
+			digest, err := cmd.BuildAndPushMultiPlatform(mockArgs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
@@ -494,6 +626,187 @@ func TestDockerCommand_GetPlatform(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_Run(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	var mockCmd *MockCmd
+
+	tests := map[string]struct {
+		in         *RunOptions
+		setupMocks func(controller *gomock.Controller)
+
+		wantedErr error
+	}{
+		"wrap error returned from Run()": {
+			in: &RunOptions{
+				ImageURI:      "nginx",
+				ContainerName: "mockContainer",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--rm", "--name", "mockContainer", "nginx"}).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("run container mockContainer: %w", mockError),
+		},
+		"runs a container with all the options": {
+			in: &RunOptions{
+				ImageURI:       "mockImage",
+				ContainerName:  "mockContainer",
+				NetworkName:    "mockNetwork",
+				NetworkAliases: []string{"api"},
+				PortMappings: map[string]string{
+					"8080": "80",
+				},
+				EnvVars: map[string]string{
+					"LOG_LEVEL":                "debug",
+					"COPILOT_APPLICATION_NAME": "phonetool",
+				},
+				Secrets: map[string]string{
+					"DB_PASSWORD": "hunter2",
+				},
+				EntryPoint: []string{"/bin/sh", "-c"},
+				Command:    []string{"npm start"},
+				HealthCheck: &HealthCheckOptions{
+					Command:  []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+					Interval: 10 * time.Second,
+					Retries:  2,
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--rm", "--name", "mockContainer",
+					"--network", "mockNetwork",
+					"--network-alias", "api",
+					"--publish", "8080:80",
+					"--env", "COPILOT_APPLICATION_NAME=phonetool",
+					"--env", "LOG_LEVEL=debug",
+					"--env", "DB_PASSWORD",
+					"--health-cmd", "curl -f http://localhost/ || exit 1",
+					"--health-interval", "10s",
+					"--health-retries", "2",
+					"--entrypoint", "/bin/sh",
+					"mockImage",
+					"-c", "npm start",
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		"disables the health check of the image": {
+			in: &RunOptions{
+				ImageURI:      "nginx",
+				ContainerName: "mockContainer",
+				HealthCheck: &HealthCheckOptions{
+					Command: []string{"NONE"},
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--rm", "--name", "mockContainer", "--no-healthcheck", "nginx"}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			tc.setupMocks(controller)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.Run(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDockerCommand_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCmd := NewMockCmd(ctrl)
+	mockCmd.EXPECT().Run("docker", []string{"stop", "mockContainer"}).Return(errors.New("some error"))
+	s := CmdClient{
+		runner: mockCmd,
+	}
+
+	err := s.Stop("mockContainer")
+
+	require.EqualError(t, err, "stop container mockContainer: some error")
+}
+
+func TestDockerCommand_Network(t *testing.T) {
+	t.Run("create a network", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "create", "mockNetwork"}).Return(errors.New("some error"))
+		s := CmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.CreateNetwork("mockNetwork")
+
+		require.EqualError(t, err, "create network mockNetwork: some error")
+	})
+	t.Run("network exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("1a2b3c4d5e6f\n"))
+			}).Return(nil)
+		s := CmdClient{
+			runner: mockCmd,
+		}
+
+		exists, err := s.NetworkExists("mockNetwork")
+
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+	t.Run("network does not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).Return(nil)
+		s := CmdClient{
+			runner: mockCmd,
+		}
+
+		exists, err := s.NetworkExists("mockNetwork")
+
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+	t.Run("returns error if fail to list the networks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).Return(errors.New("some error"))
+		s := CmdClient{
+			runner: mockCmd,
+		}
+
+		_, err := s.NetworkExists("mockNetwork")
+
+		require.EqualError(t, err, "list networks named mockNetwork: some error")
+	})
+	t.Run("remove a network", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockCmd := NewMockCmd(ctrl)
+		mockCmd.EXPECT().Run("docker", []string{"network", "rm", "mockNetwork"}).Return(nil)
+		s := CmdClient{
+			runner: mockCmd,
+		}
+
+		err := s.RemoveNetwork("mockNetwork")
+
+		require.NoError(t, err)
+	})
+}
+
 func TestIsEcrCredentialHelperEnabled(t *testing.T) {
 	var mockCmd *MockCmd
 	workspace := "test/copilot/.docker"
@@ -80,6 +80,11 @@ func (c Client) ClusterARN(app, env string) (string, error) {
 	return c.clusterARN(app, env)
 }
 
+// StateMachineARN returns the ARN of the state machine of a job in an environment.
+func (c Client) StateMachineARN(app, env, job string) (string, error) {
+	return c.stateMachineARN(app, env, job)
+}
+
 // ForceUpdateService forces a new update for an ECS service given Copilot service info.
 func (c Client) ForceUpdateService(app, env, svc string) error {
 	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
@@ -89,6 +94,16 @@ func (c Client) ForceUpdateService(app, env, svc string) error {
 	return c.ecsClient.UpdateService(clusterName, serviceName, ecs.WithForceUpdate())
 }
 
+// UpdateServiceDesiredCount sets the number of tasks an ECS service should run given Copilot service info,
+// and waits until the service becomes stable.
+func (c Client) UpdateServiceDesiredCount(app, env, svc string, count int64) error {
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return err
+	}
+	return c.ecsClient.UpdateService(clusterName, serviceName, ecs.WithDesiredCount(count))
+}
+
 // DescribeService returns the description of an ECS service given Copilot service info.
 func (c Client) DescribeService(app, env, svc string) (*ServiceDesc, error) {
 	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
@@ -35,12 +35,16 @@ type CmdOption func(cmd *exec.Cmd)
 
 // NewCmd returns a Cmd that can run external commands.
 // By default the output of the commands is piped to stderr.
-func NewCmd() *Cmd {
+// The default options are applied to every command before the options passed to Run.
+func NewCmd(defaults ...CmdOption) *Cmd {
 	return &Cmd{
 		command: func(name string, args []string, opts ...CmdOption) cmdRunner {
 			cmd := exec.Command(name, args...)
 			cmd.Stdout = os.Stderr
 			cmd.Stderr = os.Stderr
+			for _, opt := range defaults {
+				opt(cmd)
+			}
 			for _, opt := range opts {
 				opt(cmd)
 			}
@@ -70,6 +74,17 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env appends the environment variables, formatted as "KEY=value", to the environment of the current process
+// and sets them as the internal *exec.Cmd's Env field.
+func Env(vars ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		if c.Env == nil {
+			c.Env = os.Environ()
+		}
+		c.Env = append(c.Env, vars...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
@@ -4,6 +4,7 @@
 package exec
 
 import (
+	"os/exec"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,3 +33,18 @@ func TestCmd_Run(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestEnv(t *testing.T) {
+	t.Run("should append the variables to the environment of the current process", func(t *testing.T) {
+		// GIVEN
+		t.Setenv("COPILOT_TEST_ENV", "parent")
+		cmd := exec.Command("ls")
+
+		// WHEN
+		Env("SECRET=value")(cmd)
+
+		// THEN
+		require.Contains(t, cmd.Env, "COPILOT_TEST_ENV=parent")
+		require.Equal(t, "SECRET=value", cmd.Env[len(cmd.Env)-1])
+	})
+}
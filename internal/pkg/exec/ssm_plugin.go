@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +60,38 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartSessionWithOutput starts a non-interactive session using the ssm plugin and writes the output of the session to out.
+func (s SSMPluginCommand) StartSessionWithOutput(ssmSess *ecs.Session, out io.Writer) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	if err := s.runner.Run(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}, Stdout(out), Stderr(out)); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// StartPortForwardingSession starts a port forwarding session using the ssm plugin.
+// The plugin reads the parameters of the session to know which local port to listen on.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, params *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	parameters, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal session parameters: %w", err)
+	}
+	// The empty argument is the AWS profile, the plugin uses the default credential chain when it's not provided.
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction, "", string(parameters)}); err != nil {
+		return fmt.Errorf("start port forwarding session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
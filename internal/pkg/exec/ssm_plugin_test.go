@@ -4,6 +4,7 @@
 package exec
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +85,108 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartSessionWithOutput(t *testing.T) {
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	wantedArgs := []string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(ssmPluginBinaryName, wantedArgs, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(ssmPluginBinaryName, wantedArgs, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartSessionWithOutput(mockSession, &bytes.Buffer{})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockParams := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Target:       aws.String("ecs:cluster_task_runtime"),
+		Parameters: map[string][]*string{
+			"localPortNumber": aws.StringSlice([]string{"8080"}),
+		},
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSessionToRemoteHost","Parameters":{"localPortNumber":["8080"]},"Reason":null,"Target":"ecs:cluster_task_runtime"}`,
+	}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start port forwarding session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartPortForwardingSession(mockSession, mockParams)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
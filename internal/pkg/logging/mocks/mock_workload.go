@@ -49,6 +49,21 @@ func (mr *MocklogGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogGetter)(nil).LogEvents), opts)
 }
 
+// Query mocks base method.
+func (m *MocklogGetter) Query(opts cloudwatchlogs.QueryOpts) ([]*cloudwatchlogs.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", opts)
+	ret0, _ := ret[0].([]*cloudwatchlogs.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MocklogGetterMockRecorder) Query(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MocklogGetter)(nil).Query), opts)
+}
+
 // MockserviceARNGetter is a mock of serviceARNGetter interface.
 type MockserviceARNGetter struct {
 	ctrl     *gomock.Controller
@@ -20,6 +20,8 @@ import (
 
 const (
 	defaultServiceLogsLimit = 10
+	// defaultQueryWindow is how far back a Logs Insights query searches if no start time is given.
+	defaultQueryWindow = time.Hour
 
 	fmtWkldLogGroupName         = "/copilot/%s-%s-%s"
 	wkldLogStreamPrefix         = "copilot"
@@ -28,6 +30,7 @@ const (
 
 type logGetter interface {
 	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+	Query(opts cloudwatchlogs.QueryOpts) ([]*cloudwatchlogs.QueryResult, error)
 }
 
 type serviceARNGetter interface {
@@ -87,6 +90,33 @@ func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOp
 	}
 }
 
+// writeQueryResults runs the Logs Insights query of the options against the log group and writes the resulting rows.
+func (s *workloadLogger) writeQueryResults(logGroup string, opts WriteLogEventsOpts) error {
+	endTime := s.now().UnixMilli()
+	if opts.EndTime != nil {
+		endTime = aws.Int64Value(opts.EndTime)
+	}
+	startTime := time.UnixMilli(endTime).Add(-defaultQueryWindow).UnixMilli()
+	if opts.StartTime != nil {
+		startTime = aws.Int64Value(opts.StartTime)
+	}
+	results, err := s.eventsGetter.Query(cloudwatchlogs.QueryOpts{
+		LogGroup:    logGroup,
+		QueryString: opts.Query,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Limit:       opts.Limit,
+	})
+	if err != nil {
+		return fmt.Errorf("query log group %s: %w", logGroup, err)
+	}
+	stringers := make([]HumanJSONStringer, len(results))
+	for i, result := range results {
+		stringers[i] = result
+	}
+	return opts.OnEvents(s.w, stringers)
+}
+
 func ecsLogStreamPrefixes(taskIDs []string, service, container string) []string {
 	// By default, we only want logs from copilot task log streams.
 	// This filters out log stream not starting with `copilot/`, or `copilot/datadog` if container is set.
@@ -122,6 +152,9 @@ func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
+	if opts.Query != "" {
+		return s.writeQueryResults(logGroup, opts)
+	}
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
 		LogGroup:               logGroup,
 		Limit:                  opts.limit(),
@@ -130,6 +163,7 @@ func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		StreamLastEventTime:    nil,
 		LogStreamLimit:         opts.LogStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.ContainerName),
+		FilterPattern:          opts.FilterPattern,
 	}
 	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
 }
@@ -191,6 +225,9 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 	default:
 		logGroup = opts.LogGroup
 	}
+	if opts.Query != "" {
+		return s.writeQueryResults(logGroup, opts)
+	}
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
 		LogGroup:            logGroup,
 		Limit:               opts.limit(),
@@ -198,6 +235,7 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		EndTime:             opts.EndTime,
 		StreamLastEventTime: nil,
 		LogStreamLimit:      opts.LogStreamLimit,
+		FilterPattern:       opts.FilterPattern,
 	}
 	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
 }
@@ -224,6 +262,9 @@ func (s *JobLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
+	if opts.Query != "" {
+		return s.writeQueryResults(logGroup, opts)
+	}
 	logEventsOpts := cloudwatchlogs.LogEventsOpts{
 		LogGroup:               logGroup,
 		Limit:                  opts.limit(),
@@ -232,6 +273,7 @@ func (s *JobLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		StreamLastEventTime:    nil,
 		LogStreamLimit:         logStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.IncludeStateMachineLogs),
+		FilterPattern:          opts.FilterPattern,
 	}
 	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
 }
@@ -258,6 +300,11 @@ type WriteLogEventsOpts struct {
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 	LogGroup string
+	// FilterPattern is an optional CloudWatch Logs filter pattern that the events must match.
+	FilterPattern string
+	// Query is an optional Logs Insights query to run against the log group instead of retrieving the events.
+	// Results are written once, so it can't be combined with Follow.
+	Query string
 
 	// Job specific options.
 	IncludeStateMachineLogs bool
@@ -59,6 +59,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		jsonOutput    bool
 		taskIDs       []string
 		containerName string
+		filterPattern string
+		query         string
 		setupMocks    func(mocks workloadLogsMocks)
 
 		wantedError   error
@@ -190,6 +192,43 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			},
 			wantedContent: logEventsHumanString,
 		},
+		"success with a filter pattern": {
+			filterPattern: "ERROR",
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, "ERROR", param.FilterPattern)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: mockLogEvents,
+					}, nil)
+			},
+			wantedContent: logEventsHumanString,
+		},
+		"failed to run a query": {
+			query: "stats count(*)",
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().Query(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("query log group mockLogGroup: some error"),
+		},
+		"success with a query over the last hour by default": {
+			query: "stats count(*)",
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().Query(cloudwatchlogs.QueryOpts{
+					LogGroup:    mockLogGroupName,
+					QueryString: "stats count(*)",
+					StartTime:   mockCurrentTimestamp.Add(-time.Hour).UnixMilli(),
+					EndTime:     mockCurrentTimestamp.UnixMilli(),
+				}).Return([]*cloudwatchlogs.QueryResult{
+					{
+						Fields: []cloudwatchlogs.QueryResultField{{Name: "count(*)", Value: "3"}},
+					},
+				}, nil)
+			},
+			jsonOutput:    true,
+			wantedContent: `{"count(*)":"3"}` + "\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -232,6 +271,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				OnEvents:      logWriter,
 				ContainerName: tc.containerName,
 				LogGroup:      mockLogGroupName,
+				FilterPattern: tc.filterPattern,
+				Query:         tc.query,
 			})
 
 			// THEN
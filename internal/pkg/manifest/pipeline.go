@@ -17,6 +17,9 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	GitLabProviderName     = "GitLab"
+	S3ProviderName         = "S3"
+	ECRProviderName        = "ECR"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
@@ -26,6 +29,9 @@ var PipelineProviders = []string{
 	GithubProviderName,
 	CodeCommitProviderName,
 	BitbucketProviderName,
+	GitLabProviderName,
+	S3ProviderName,
+	ECRProviderName,
 }
 
 // Provider defines a source of the artifacts
@@ -92,6 +98,48 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type gitlabProvider struct {
+	properties *GitLabProperties
+}
+
+func (p *gitlabProvider) Name() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) String() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type s3Provider struct {
+	properties *S3Properties
+}
+
+func (p *s3Provider) Name() string {
+	return S3ProviderName
+}
+func (p *s3Provider) String() string {
+	return S3ProviderName
+}
+func (p *s3Provider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type ecrProvider struct {
+	properties *ECRProperties
+}
+
+func (p *ecrProvider) Name() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) String() string {
+	return ECRProviderName
+}
+func (p *ecrProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -123,6 +171,27 @@ type CodeCommitProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// GitLabProperties contains information for configuring a GitLab
+// source provider.
+type GitLabProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
+// S3Properties contains information for configuring an S3
+// source provider.
+type S3Properties struct {
+	Bucket    string `structs:"bucket" yaml:"bucket"`
+	ObjectKey string `structs:"object_key" yaml:"object_key"`
+}
+
+// ECRProperties contains information for configuring an ECR
+// source provider.
+type ECRProperties struct {
+	Repository string `structs:"repository" yaml:"repository"`
+	Tag        string `structs:"tag" yaml:"tag"`
+}
+
 // NewProvider creates a source provider based on the type of
 // the provided provider-specific configurations
 func NewProvider(configs interface{}) (Provider, error) {
@@ -143,6 +212,18 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *GitLabProperties:
+		return &gitlabProvider{
+			properties: props,
+		}, nil
+	case *S3Properties:
+		return &s3Provider{
+			properties: props,
+		}, nil
+	case *ECRProperties:
+		return &ecrProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -187,10 +268,12 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string      `yaml:"name"`
-	RequiresApproval bool        `yaml:"requires_approval,omitempty"`
-	TestCommands     []string    `yaml:"test_commands,omitempty"`
-	Deployments      Deployments `yaml:"deployments,omitempty"`
+	Name             string             `yaml:"name"`
+	RequiresApproval bool               `yaml:"requires_approval,omitempty"`
+	TestCommands     []string           `yaml:"test_commands,omitempty"`
+	PreDeployments   PrePostDeployments `yaml:"pre_deployments,omitempty"`
+	Deployments      Deployments        `yaml:"deployments,omitempty"`
+	PostDeployments  PrePostDeployments `yaml:"post_deployments,omitempty"`
 }
 
 // Deployments represent a directed graph of cloudformation deployments.
@@ -204,6 +287,17 @@ type Deployment struct {
 	DependsOn      []string `yaml:"depends_on"`
 }
 
+// PrePostDeployments represent a directed graph of actions that run before or after the deployments of a stage.
+type PrePostDeployments map[string]*PrePostDeployment
+
+// PrePostDeployment is the configuration of a CodeBuild action that runs before or after the deployments of a stage.
+type PrePostDeployment struct {
+	BuildspecPath string            `yaml:"buildspec"`
+	Image         string            `yaml:"image"`
+	Variables     map[string]string `yaml:"variables"`
+	DependsOn     []string          `yaml:"depends_on"`
+}
+
 // NewPipeline returns a pipeline manifest object.
 func NewPipeline(pipelineName string, provider Provider, stages []PipelineStage) (*Pipeline, error) {
 	// TODO: #221 Do more validations
@@ -264,6 +358,8 @@ func (s Source) IsCodeStarConnection() bool {
 		return true
 	case BitbucketProviderName:
 		return true
+	case GitLabProviderName:
+		return true
 	default:
 		return false
 	}
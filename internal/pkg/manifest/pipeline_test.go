@@ -39,6 +39,24 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create GitLab provider": {
+			providerConfig: &GitLabProperties{
+				RepositoryURL: "https://gitlab.com/aws/amazon-ecs-cli-v2",
+				Branch:        defaultGHBranch,
+			},
+		},
+		"successfully create S3 provider": {
+			providerConfig: &S3Properties{
+				Bucket:    "my-bucket",
+				ObjectKey: "source/frontend.zip",
+			},
+		},
+		"successfully create ECR provider": {
+			providerConfig: &ECRProperties{
+				Repository: "shared/frontend",
+				Tag:        "latest",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -303,6 +321,68 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with pre and post deployments": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: chicken
+      pre_deployments:
+        migrate:
+          buildspec: copilot/migrate/buildspec.yml
+          image: aws/codebuild/standard:6.0
+          variables:
+            DB_NAME: chickens
+      post_deployments:
+        warmup:
+          buildspec: copilot/warmup/buildspec.yml
+        smoke:
+          buildspec: copilot/smoke/buildspec.yml
+          depends_on: [warmup]
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "chicken",
+						PreDeployments: map[string]*PrePostDeployment{
+							"migrate": {
+								BuildspecPath: "copilot/migrate/buildspec.yml",
+								Image:         "aws/codebuild/standard:6.0",
+								Variables: map[string]string{
+									"DB_NAME": "chickens",
+								},
+							},
+						},
+						PostDeployments: map[string]*PrePostDeployment{
+							"warmup": {
+								BuildspecPath: "copilot/warmup/buildspec.yml",
+							},
+							"smoke": {
+								BuildspecPath: "copilot/smoke/buildspec.yml",
+								DependsOn:     []string{"warmup"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -37,6 +37,17 @@ type FileUpload struct {
 	Recursive   bool                `yaml:"recursive"`
 	Reinclude   StringSliceOrString `yaml:"reinclude"`
 	Exclude     StringSliceOrString `yaml:"exclude"`
+	Prune       bool                `yaml:"prune"` // Delete the objects under destination that were removed locally.
+	Metadata    []FileMetadata      `yaml:"metadata"`
+}
+
+// FileMetadata represents the metadata to set on the uploaded files that match a glob pattern.
+// The pattern is matched against the path relative to the source, or against the file name if it doesn't contain a "/".
+type FileMetadata struct {
+	Match           string `yaml:"match"`
+	ContentType     string `yaml:"content_type"`
+	CacheControl    string `yaml:"cache_control"`
+	ContentEncoding string `yaml:"content_encoding"`
 }
 
 // NewStaticSite creates a new static site service.
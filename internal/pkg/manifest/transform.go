@@ -175,10 +175,17 @@ func (t platformArgsOrStringTransformer) Transformer(typ reflect.Type) func(dst,
 
 		if srcStruct.PlatformString != nil {
 			dstStruct.PlatformArgs = PlatformArgs{}
+			dstStruct.PlatformList = nil
 		}
 
 		if !srcStruct.PlatformArgs.isEmpty() {
 			dstStruct.PlatformString = nil
+			dstStruct.PlatformList = nil
+		}
+
+		if srcStruct.PlatformList != nil {
+			dstStruct.PlatformString = nil
+			dstStruct.PlatformArgs = PlatformArgs{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -425,6 +425,28 @@ func TestPlatformArgsOrStringTransformer_Transformer(t *testing.T) {
 				}
 			},
 		},
+		"string and args set to empty if list is not nil": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+		},
+		"list set to empty if string is not nil": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+		},
 		"args set to empty if string is not nil": {
 			original: func(p *PlatformArgsOrString) {
 				p.PlatformArgs = PlatformArgs{
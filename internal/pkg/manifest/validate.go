@@ -7,15 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 	"github.com/dustin/go-humanize/english"
 )
 
@@ -51,12 +54,18 @@ var (
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
 
+	// Validates the name of a CodePipeline action, and the prefixes and names of the actions that Copilot generates in a pipeline stage.
+	pipelineActionNameRegexp           = regexp.MustCompile(`^[A-Za-z0-9.@_-]{1,100}$`)
+	reservedPipelineActionNamePrefixes = []string{"CreateOrUpdate-", "ApprovePromotionTo-"}
+	reservedPipelineActionNames        = []string{"TestCommands"}
+
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 	nlbValidProtocols                        = []string{TCP, TLS}
 	validContainerProtocols                  = []string{TCP, udp}
 	TracingValidVendors                      = []string{awsXRAY}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	ecsTrafficShiftingStrategies             = []string{ECSCanaryDeploymentStrategy, ECSLinearDeploymentStrategy, ECSBlueGreenDeploymentStrategy}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
@@ -125,6 +134,69 @@ func (d DeploymentConfig) validate() error {
 	if err := d.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "rolling": %w`, err)
 	}
+	if d.Rolling != nil && d.Strategy != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "rolling",
+			secondField: "strategy",
+		}
+	}
+	if d.Strategy == nil {
+		if !d.TrafficShift.IsEmpty() {
+			return &errFieldMustBeSpecified{
+				missingField:      "strategy",
+				conditionalFields: []string{"traffic_shift"},
+			}
+		}
+		if d.BakeTime != nil {
+			return &errFieldMustBeSpecified{
+				missingField:      "strategy",
+				conditionalFields: []string{"bake_time"},
+			}
+		}
+		return nil
+	}
+	strategy := strings.ToLower(aws.StringValue(d.Strategy))
+	if !contains(strategy, ecsTrafficShiftingStrategies) {
+		return fmt.Errorf(`validate "strategy": invalid deployment strategy %q, must be one of %s`,
+			aws.StringValue(d.Strategy), english.WordSeries(ecsTrafficShiftingStrategies, "or"))
+	}
+	if strategy == ECSBlueGreenDeploymentStrategy && !d.TrafficShift.IsEmpty() {
+		return fmt.Errorf(`"traffic_shift" cannot be specified with "strategy" %q`, strategy)
+	}
+	if err := d.TrafficShift.validate(); err != nil {
+		return fmt.Errorf(`validate "traffic_shift": %w`, err)
+	}
+	if d.BakeTime != nil {
+		if err := validateDeploymentMinutes(*d.BakeTime); err != nil {
+			return fmt.Errorf(`validate "bake_time": %w`, err)
+		}
+	}
+	return nil
+}
+
+func (t TrafficShift) validate() error {
+	if t.IsEmpty() {
+		return nil
+	}
+	if t.Percent != nil && (aws.IntValue(t.Percent) < 1 || aws.IntValue(t.Percent) > 99) {
+		return fmt.Errorf(`"percent" must be between 1 and 99, got %d`, aws.IntValue(t.Percent))
+	}
+	if t.Interval != nil {
+		if err := validateDeploymentMinutes(*t.Interval); err != nil {
+			return fmt.Errorf(`validate "interval": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validateDeploymentMinutes returns nil if the duration is a whole number of minutes between 0 and 24 hours.
+func validateDeploymentMinutes(d time.Duration) error {
+	if d%time.Minute != 0 {
+		return fmt.Errorf("duration %s must be a whole number of minutes", d)
+	}
+	if d < 0 || d > 24*time.Hour {
+		return fmt.Errorf("duration %s must be between 0m and 1440m", d)
+	}
 	return nil
 }
 
@@ -227,6 +299,27 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if err = l.validateTrafficShiftedDeployment(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	return nil
+}
+
+// validateTrafficShiftedDeployment returns nil if the load balancer of the service supports shifting traffic
+// between two target groups during deployments.
+func (l LoadBalancedWebServiceConfig) validateTrafficShiftedDeployment() error {
+	if l.DeployConfig.Strategy == nil {
+		return nil
+	}
+	if l.RoutingRule.Disabled() {
+		return fmt.Errorf(`"strategy" %q requires "http" to be enabled`, aws.StringValue(l.DeployConfig.Strategy))
+	}
+	if !l.NLBConfig.IsEmpty() {
+		return fmt.Errorf(`"strategy" %q cannot be used with "nlb"`, aws.StringValue(l.DeployConfig.Strategy))
+	}
+	if l.RoutingRule.RedirectToHTTPS != nil && !aws.BoolValue(l.RoutingRule.RedirectToHTTPS) {
+		return fmt.Errorf(`"strategy" %q cannot be used with "http.redirect_to_https" set to false`, aws.StringValue(l.DeployConfig.Strategy))
+	}
 	return nil
 }
 
@@ -236,6 +329,9 @@ func (b BackendService) validate() error {
 	if err = b.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if b.DeployConfig.Strategy != nil {
+		return errors.New(`validate "deployment": "strategy" is only supported by Load Balanced Web Services`)
+	}
 	if err = b.BackendServiceConfig.validate(); err != nil {
 		return err
 	}
@@ -553,6 +649,41 @@ func (s StaticSiteConfig) validate() error {
 }
 
 func (f FileUpload) validate() error {
+	if f.Prune && f.Destination == "" {
+		return &errFieldMustBeSpecified{
+			missingField:      "destination",
+			conditionalFields: []string{"prune"},
+		}
+	}
+	if artifactpath.IsReserved(filepath.ToSlash(f.Destination)) {
+		return fmt.Errorf(`"destination" %q must not be under the "manual" directory reserved for Copilot artifacts`, f.Destination)
+	}
+	for idx, metadata := range f.Metadata {
+		if err := metadata.validate(); err != nil {
+			return fmt.Errorf(`validate "metadata[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
+func (f FileMetadata) validate() error {
+	if f.Match == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "match",
+		}
+	}
+	if _, err := path.Match(f.Match, ""); err != nil {
+		return fmt.Errorf(`invalid "match" pattern %q: %w`, f.Match, err)
+	}
+	if strings.Contains(f.Match, "**") {
+		return fmt.Errorf(`invalid "match" pattern %q: "**" is not supported, "*" matches any sequence of characters except "/"`, f.Match)
+	}
+	if f.ContentType == "" && f.CacheControl == "" && f.ContentEncoding == "" {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"content_type", "cache_control", "content_encoding"},
+			conditionalField: "match",
+		}
+	}
 	return nil
 }
 
@@ -565,6 +696,17 @@ func (p Pipeline) Validate() error {
 		if err := stg.Deployments.validate(); err != nil {
 			return fmt.Errorf(`validate "deployments" for pipeline stage %s: %w`, stg.Name, err)
 		}
+		if err := stg.PreDeployments.validate(); err != nil {
+			return fmt.Errorf(`validate "pre_deployments" for pipeline stage %s: %w`, stg.Name, err)
+		}
+		if err := stg.PostDeployments.validate(); err != nil {
+			return fmt.Errorf(`validate "post_deployments" for pipeline stage %s: %w`, stg.Name, err)
+		}
+		for name := range stg.PreDeployments {
+			if _, ok := stg.PostDeployments[name]; ok {
+				return fmt.Errorf(`validate pipeline stage %s: action %q cannot be in both "pre_deployments" and "post_deployments"`, stg.Name, name)
+			}
+		}
 	}
 	return nil
 }
@@ -589,6 +731,43 @@ func (d Deployments) validate() error {
 	return nil
 }
 
+// validate returns nil if pre or post deployments are configured correctly.
+func (d PrePostDeployments) validate() error {
+	for name, conf := range d {
+		if err := validatePipelineActionName(name); err != nil {
+			return err
+		}
+		if conf == nil || conf.BuildspecPath == "" {
+			return fmt.Errorf(`validate %q: %w`, name, &errFieldMustBeSpecified{
+				missingField: "buildspec",
+			})
+		}
+		for _, dependency := range conf.DependsOn {
+			if _, ok := d[dependency]; !ok {
+				return fmt.Errorf("dependency action named '%s' of '%s' does not exist", dependency, name)
+			}
+		}
+	}
+	return nil
+}
+
+func validatePipelineActionName(name string) error {
+	if !pipelineActionNameRegexp.MatchString(name) {
+		return fmt.Errorf(`action name %q must be at most 100 characters long and can only contain letters, numbers, and the characters ".@_-"`, name)
+	}
+	for _, prefix := range reservedPipelineActionNamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf(`action name %q cannot start with %q, which is reserved for the actions generated by Copilot`, name, prefix)
+		}
+	}
+	for _, reserved := range reservedPipelineActionNames {
+		if name == reserved {
+			return fmt.Errorf(`action name %q is reserved for the action generated by Copilot`, name)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if Workload is configured correctly.
 func (w Workload) validate() error {
 	if w.Name == nil {
@@ -596,6 +775,11 @@ func (w Workload) validate() error {
 			missingField: "name",
 		}
 	}
+	for _, dependency := range w.DependsOn {
+		if dependency == aws.StringValue(w.Name) {
+			return fmt.Errorf(`validate "depends_on": workload %q cannot depend on itself`, dependency)
+		}
+	}
 	return nil
 }
 
@@ -931,6 +1115,9 @@ func (p PlatformArgsOrString) validate() error {
 	if p.IsEmpty() {
 		return nil
 	}
+	if p.IsMultiPlatform() {
+		return validatePlatformList(p.PlatformList)
+	}
 	if !p.PlatformArgs.isEmpty() {
 		return p.PlatformArgs.validate()
 	}
@@ -940,6 +1127,24 @@ func (p PlatformArgsOrString) validate() error {
 	return nil
 }
 
+// validatePlatformList returns nil if the platforms of a multi-platform image are configured correctly.
+func validatePlatformList(platforms []PlatformString) error {
+	seen := make(map[string]bool, len(platforms))
+	for _, p := range platforms {
+		platform := strings.ToLower(string(p))
+		if !contains(platform, validMultiPlatforms) {
+			return fmt.Errorf("platform '%s' is invalid; multi-platform images can only be built for %s", p, english.WordSeries(validMultiPlatforms, "and"))
+		}
+		// Docker treats x86_64 as an alias of amd64.
+		platform = strings.Replace(platform, ArchX86, ArchAMD64, 1)
+		if seen[platform] {
+			return fmt.Errorf("platform '%s' is specified more than once", p)
+		}
+		seen[platform] = true
+	}
+	return nil
+}
+
 // validate returns nil if PlatformArgs is configured correctly.
 func (p PlatformArgs) validate() error {
 	if !p.bothSpecified() {
@@ -1493,6 +1698,9 @@ func (r AppRunnerInstanceConfig) validate() error {
 	if err := r.Platform.validate(); err != nil {
 		return fmt.Errorf(`validate "platform": %w`, err)
 	}
+	if r.Platform.IsMultiPlatform() {
+		return errors.New("App Runner services do not support multi-platform images")
+	}
 	// Error out if user added Windows as platform in manifest.
 	if isWindowsPlatform(r.Platform) {
 		return ErrAppRunnerInvalidPlatformWindows
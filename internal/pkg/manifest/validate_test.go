@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -346,6 +347,70 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment"`,
 		},
+		"error if traffic shifting strategy is used with nlb": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+					DeployConfig: DeploymentConfig{
+						Strategy: aws.String("canary"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": "strategy" "canary" cannot be used with "nlb"`),
+		},
+		"error if traffic shifting strategy is used without redirecting http to https": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path:            stringP("/"),
+							RedirectToHTTPS: aws.Bool(false),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Strategy: aws.String("bluegreen"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": "strategy" "bluegreen" cannot be used with "http.redirect_to_https" set to false`),
+		},
+		"ok if traffic shifting strategy is used with http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Strategy: aws.String("linear"),
+						TrafficShift: TrafficShift{
+							Percent:  aws.Int(20),
+							Interval: durationp(time.Minute),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1202,6 +1267,124 @@ func TestScheduledJob_validate(t *testing.T) {
 	}
 }
 
+func TestStaticSiteConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config StaticSiteConfig
+
+		wantedError error
+	}{
+		"error if prune is enabled without a destination": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source:      "assets",
+						Destination: "static",
+						Prune:       true,
+					},
+					{
+						Source: "dist",
+						Prune:  true,
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[1]": "destination" must be specified if "prune" is specified`),
+		},
+		"error if the destination is reserved for Copilot artifacts": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source:      "dist",
+						Destination: "manual/",
+						Prune:       true,
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[0]": "destination" "manual/" must not be under the "manual" directory reserved for Copilot artifacts`),
+		},
+		"error if a metadata rule is missing a pattern": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source: "dist",
+						Metadata: []FileMetadata{
+							{CacheControl: "no-cache"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[0]": validate "metadata[0]": "match" must be specified`),
+		},
+		"error if a metadata rule has a malformed pattern": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source: "dist",
+						Metadata: []FileMetadata{
+							{Match: "[*.js", CacheControl: "no-cache"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[0]": validate "metadata[0]": invalid "match" pattern "[*.js": syntax error in pattern`),
+		},
+		"error if a metadata rule uses a double star": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source: "dist",
+						Metadata: []FileMetadata{
+							{Match: "assets/**/*.js", CacheControl: "no-cache"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[0]": validate "metadata[0]": invalid "match" pattern "assets/**/*.js": "**" is not supported, "*" matches any sequence of characters except "/"`),
+		},
+		"error if a metadata rule sets no metadata": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source: "dist",
+						Metadata: []FileMetadata{
+							{Match: "*.js"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "files[0]": validate "metadata[0]": must specify at least one of "content_type", "cache_control" or "content_encoding" if "match" is specified`),
+		},
+		"success": {
+			config: StaticSiteConfig{
+				FileUploads: []FileUpload{
+					{
+						Source:      "assets",
+						Destination: "static",
+						Prune:       true,
+					},
+					{
+						Source: "dist",
+						Metadata: []FileMetadata{
+							{Match: "*.js", ContentType: "application/javascript"},
+							{Match: "assets/*", CacheControl: "max-age=31536000"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestPipelineManifest_validate(t *testing.T) {
 	testCases := map[string]struct {
 		Pipeline Pipeline
@@ -1231,6 +1414,58 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployments" for pipeline stage test:`,
 		},
+		"should validate pre-deployments of pipeline stages": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: map[string]*PrePostDeployment{
+							"migrate": {},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "pre_deployments" for pipeline stage test:`,
+		},
+		"should validate post-deployments of pipeline stages": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PostDeployments: map[string]*PrePostDeployment{
+							"smoke": {
+								BuildspecPath: "copilot/smoke/buildspec.yml",
+								DependsOn:     []string{"warmup"},
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "post_deployments" for pipeline stage test:`,
+		},
+		"should return an error if an action is both a pre-deployment and a post-deployment": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: map[string]*PrePostDeployment{
+							"warmup": {
+								BuildspecPath: "copilot/warmup/buildspec.yml",
+							},
+						},
+						PostDeployments: map[string]*PrePostDeployment{
+							"warmup": {
+								BuildspecPath: "copilot/warmup/buildspec.yml",
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate pipeline stage test: action "warmup" cannot be in both "pre_deployments" and "post_deployments"`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1285,6 +1520,100 @@ func TestDeployments_validate(t *testing.T) {
 	}
 }
 
+func TestPrePostDeployments_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     PrePostDeployments
+		wanted error
+	}{
+		"should return nil on empty actions": {},
+		"should return an error when the buildspec is missing": {
+			in: map[string]*PrePostDeployment{
+				"migrate": {
+					Image: "aws/codebuild/standard:6.0",
+				},
+			},
+			wanted: errors.New(`validate "migrate": "buildspec" must be specified`),
+		},
+		"should return an error when the action has no configuration": {
+			in: map[string]*PrePostDeployment{
+				"migrate": nil,
+			},
+			wanted: errors.New(`validate "migrate": "buildspec" must be specified`),
+		},
+		"should return an error when a dependency does not exist": {
+			in: map[string]*PrePostDeployment{
+				"smoke": {
+					BuildspecPath: "copilot/smoke/buildspec.yml",
+					DependsOn:     []string{"warmup"},
+				},
+			},
+			wanted: errors.New("dependency action named 'warmup' of 'smoke' does not exist"),
+		},
+		"should return an error when the name contains invalid characters": {
+			in: map[string]*PrePostDeployment{
+				"run migrations": {
+					BuildspecPath: "copilot/migrations/buildspec.yml",
+				},
+			},
+			wanted: errors.New(`action name "run migrations" must be at most 100 characters long and can only contain letters, numbers, and the characters ".@_-"`),
+		},
+		"should return an error when the name is too long": {
+			in: map[string]*PrePostDeployment{
+				strings.Repeat("a", 101): {
+					BuildspecPath: "copilot/migrations/buildspec.yml",
+				},
+			},
+			wanted: fmt.Errorf(`action name %q must be at most 100 characters long and can only contain letters, numbers, and the characters ".@_-"`, strings.Repeat("a", 101)),
+		},
+		"should return an error when the name collides with a deploy action": {
+			in: map[string]*PrePostDeployment{
+				"CreateOrUpdate-api-test": {
+					BuildspecPath: "copilot/migrations/buildspec.yml",
+				},
+			},
+			wanted: errors.New(`action name "CreateOrUpdate-api-test" cannot start with "CreateOrUpdate-", which is reserved for the actions generated by Copilot`),
+		},
+		"should return an error when the name collides with an approval action": {
+			in: map[string]*PrePostDeployment{
+				"ApprovePromotionTo-test": {
+					BuildspecPath: "copilot/migrations/buildspec.yml",
+				},
+			},
+			wanted: errors.New(`action name "ApprovePromotionTo-test" cannot start with "ApprovePromotionTo-", which is reserved for the actions generated by Copilot`),
+		},
+		"should return an error when the name collides with the test commands action": {
+			in: map[string]*PrePostDeployment{
+				"TestCommands": {
+					BuildspecPath: "copilot/smoke/buildspec.yml",
+				},
+			},
+			wanted: errors.New(`action name "TestCommands" is reserved for the action generated by Copilot`),
+		},
+		"should return nil when all dependencies are present": {
+			in: map[string]*PrePostDeployment{
+				"smoke": {
+					BuildspecPath: "copilot/smoke/buildspec.yml",
+					DependsOn:     []string{"warmup"},
+				},
+				"warmup": {
+					BuildspecPath: "copilot/warmup/buildspec.yml",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.in.validate()
+
+			if tc.wanted == nil {
+				require.NoError(t, actual)
+			} else {
+				require.EqualError(t, actual, tc.wanted.Error())
+			}
+		})
+	}
+}
+
 func TestImageWithPort_validate(t *testing.T) {
 	testCases := map[string]struct {
 		ImageWithPort ImageWithPort
@@ -1736,6 +2065,17 @@ func TestPlatformArgsOrString_validate(t *testing.T) {
 		"return nil if platform string valid": {
 			in: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/amd64"))},
 		},
+		"error if a platform of the list is invalid": {
+			in:     PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "windows/amd64"}},
+			wanted: fmt.Errorf("platform 'windows/amd64' is invalid; multi-platform images can only be built for linux/amd64, linux/x86_64 and linux/arm64"),
+		},
+		"error if a platform of the list is specified twice": {
+			in:     PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "linux/arm64", "linux/x86_64"}},
+			wanted: fmt.Errorf("platform 'linux/x86_64' is specified more than once"),
+		},
+		"return nil if platform list valid": {
+			in: PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "linux/arm64"}},
+		},
 		"return nil if platform args valid": {
 			in: PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -2785,6 +3125,42 @@ func TestPublishConfig_validate(t *testing.T) {
 	}
 }
 
+func TestWorkload_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Workload
+		wanted error
+	}{
+		"should return an error if name is empty": {
+			in:     Workload{},
+			wanted: errors.New(`"name" must be specified`),
+		},
+		"should return an error if the workload depends on itself": {
+			in: Workload{
+				Name:      aws.String("api"),
+				DependsOn: []string{"db", "api"},
+			},
+			wanted: errors.New(`validate "depends_on": workload "api" cannot depend on itself`),
+		},
+		"should not return an error if the workload depends on other workloads": {
+			in: Workload{
+				Name:      aws.String("api"),
+				DependsOn: []string{"db"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestTopic_validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     Topic
@@ -3434,6 +3810,74 @@ func TestDeploymentConfig_validate(t *testing.T) {
 			deployConfig: DeploymentConfig{
 				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
 		},
+		"error if both rolling and strategy are specified": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("default"),
+				},
+				Strategy: aws.String("canary"),
+			},
+			wanted: `must specify one, not both, of "rolling" and "strategy"`,
+		},
+		"error if deploy config has invalid traffic shifting strategy": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("rolling"),
+			},
+			wanted: `validate "strategy": invalid deployment strategy "rolling", must be one of canary, linear or bluegreen`,
+		},
+		"error if traffic shift is specified without strategy": {
+			deployConfig: DeploymentConfig{
+				TrafficShift: TrafficShift{
+					Percent: aws.Int(10),
+				},
+			},
+			wanted: `"strategy" must be specified if "traffic_shift" is specified`,
+		},
+		"error if traffic shift is specified with bluegreen strategy": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("bluegreen"),
+				TrafficShift: TrafficShift{
+					Percent: aws.Int(10),
+				},
+			},
+			wanted: `"traffic_shift" cannot be specified with "strategy" "bluegreen"`,
+		},
+		"error if traffic shift percent is out of range": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("linear"),
+				TrafficShift: TrafficShift{
+					Percent: aws.Int(100),
+				},
+			},
+			wanted: `validate "traffic_shift": "percent" must be between 1 and 99, got 100`,
+		},
+		"error if traffic shift interval is not in whole minutes": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("canary"),
+				TrafficShift: TrafficShift{
+					Interval: durationp(90 * time.Second),
+				},
+			},
+			wanted: `validate "traffic_shift": validate "interval": duration 1m30s must be a whole number of minutes`,
+		},
+		"error if bake time is longer than a day": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("bluegreen"),
+				BakeTime: durationp(25 * time.Hour),
+			},
+			wanted: `validate "bake_time": duration 25h0m0s must be between 0m and 1440m`,
+		},
+		"ok if canary strategy is specified with traffic shift and bake time": {
+			deployConfig: DeploymentConfig{
+				Strategy: aws.String("canary"),
+				TrafficShift: TrafficShift{
+					Percent:  aws.Int(10),
+					Interval: durationp(5 * time.Minute),
+				},
+				BakeTime:       durationp(10 * time.Minute),
+				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"}),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -49,7 +49,7 @@ var (
 	ErrAppRunnerInvalidPlatformWindows = errors.New("Windows is not supported for App Runner services")
 
 	errUnmarshalBuildOpts          = errors.New("unable to unmarshal build field into string or compose-style map")
-	errUnmarshalPlatformOpts       = errors.New("unable to unmarshal platform field into string or compose-style map")
+	errUnmarshalPlatformOpts       = errors.New("unable to unmarshal platform field into string, slice of strings or compose-style map")
 	errUnmarshalSecurityGroupOpts  = errors.New(`unable to unmarshal "security_groups" field into slice of strings or compose-style map`)
 	errUnmarshalPlacementOpts      = errors.New("unable to unmarshal placement field into string or compose-style map")
 	errUnmarshalServiceConnectOpts = errors.New(`unable to unmarshal "connect" field into boolean or compose-style map`)
@@ -121,8 +121,9 @@ type WorkloadProps struct {
 
 // Workload holds the basic data that every workload manifest file needs to have.
 type Workload struct {
-	Name *string `yaml:"name"`
-	Type *string `yaml:"type"` // must be one of the supported manifest types.
+	Name      *string  `yaml:"name"`
+	Type      *string  `yaml:"type"`                 // must be one of the supported manifest types.
+	DependsOn []string `yaml:"depends_on,omitempty"` // names of the workloads that must be deployed before this one.
 }
 
 // Image represents the workload's container image.
@@ -711,10 +712,11 @@ func (v *vpcConfig) isEmpty() bool {
 }
 
 // PlatformArgsOrString is a custom type which supports unmarshaling yaml which
-// can either be of type string or type PlatformArgs.
+// can either be of type string, type PlatformArgs, or a list of strings for multi-platform images.
 type PlatformArgsOrString struct {
 	*PlatformString
 	PlatformArgs PlatformArgs
+	PlatformList []PlatformString
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the PlatformArgsOrString
@@ -728,18 +730,45 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 		}
 	}
 	if !p.PlatformArgs.isEmpty() {
-		// Unmarshaled successfully to p.PlatformArgs, unset p.PlatformString, and return.
+		// Unmarshaled successfully to p.PlatformArgs, unset p.PlatformString and p.PlatformList, and return.
+		p.PlatformString = nil
+		p.PlatformList = nil
+		return nil
+	}
+	if value.Kind == yaml.SequenceNode {
+		if err := value.Decode(&p.PlatformList); err != nil {
+			return errUnmarshalPlatformOpts
+		}
 		p.PlatformString = nil
 		return nil
 	}
 	if err := value.Decode(&p.PlatformString); err != nil {
 		return errUnmarshalPlatformOpts
 	}
+	p.PlatformList = nil
 	return nil
 }
 
+// IsMultiPlatform returns true if the image is built for a list of platforms.
+func (p *PlatformArgsOrString) IsMultiPlatform() bool {
+	return len(p.PlatformList) > 0
+}
+
+// Platforms returns the "<os>/<arch>" platforms to build a multi-platform image for.
+func (p *PlatformArgsOrString) Platforms() []string {
+	platforms := make([]string, len(p.PlatformList))
+	for i, platform := range p.PlatformList {
+		platforms[i] = strings.ToLower(string(platform))
+	}
+	return platforms
+}
+
 // OS returns the operating system family.
+// For a multi-platform image, the tasks run on the first platform of the list.
 func (p *PlatformArgsOrString) OS() string {
+	if len(p.PlatformList) > 0 {
+		return strings.ToLower(strings.Split(string(p.PlatformList[0]), "/")[0])
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[0])
@@ -748,7 +777,15 @@ func (p *PlatformArgsOrString) OS() string {
 }
 
 // Arch returns the architecture of PlatformArgsOrString.
+// For a multi-platform image, the tasks run on the first platform of the list.
 func (p *PlatformArgsOrString) Arch() string {
+	if len(p.PlatformList) > 0 {
+		args := strings.Split(string(p.PlatformList[0]), "/")
+		if len(args) < 2 {
+			return ""
+		}
+		return strings.ToLower(args[1])
+	}
 	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[1])
@@ -772,7 +809,7 @@ func (p *PlatformArgs) String() string {
 
 // IsEmpty returns if the platform field is empty.
 func (p *PlatformArgsOrString) IsEmpty() bool {
-	return p.PlatformString == nil && p.PlatformArgs.isEmpty()
+	return p.PlatformString == nil && p.PlatformArgs.isEmpty() && len(p.PlatformList) == 0
 }
 
 func (p *PlatformArgs) isEmpty() bool {
@@ -41,6 +41,11 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+
+	// traffic shifting deployment strategies
+	ECSCanaryDeploymentStrategy    = "canary"
+	ECSLinearDeploymentStrategy    = "linear"
+	ECSBlueGreenDeploymentStrategy = "bluegreen"
 )
 
 // Platform related settings.
@@ -55,6 +60,11 @@ var (
 		dockerengine.PlatformString(OSWindows, ArchAMD64),
 		dockerengine.PlatformString(OSWindows, ArchX86),
 	}
+	validMultiPlatforms = []string{ // All of the os/arch combinations that a multi-platform image may be built for.
+		dockerengine.PlatformString(OSLinux, ArchAMD64),
+		dockerengine.PlatformString(OSLinux, ArchX86),
+		dockerengine.PlatformString(OSLinux, ArchARM64),
+	}
 	validAdvancedPlatforms = []PlatformArgs{ // All of the OsFamily/Arch combinations that the PlatformArgs field may accept.
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchX86)},
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchAMD64)},
@@ -105,17 +115,32 @@ type DeploymentControllerConfig struct {
 // DeploymentConfig represents the deployment config for an ECS service.
 type DeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
-	RollbackAlarms             Union[[]string, AlarmArgs]  `yaml:"rollback_alarms"`
+	RollbackAlarms             Union[[]string, AlarmArgs] `yaml:"rollback_alarms"`
+	Strategy                   *string                    `yaml:"strategy"`
+	TrafficShift               TrafficShift               `yaml:"traffic_shift"`
+	BakeTime                   *time.Duration             `yaml:"bake_time"`
+}
+
+// TrafficShift represents how traffic moves from the old tasks to the new tasks in a canary or linear deployment.
+type TrafficShift struct {
+	Percent  *int           `yaml:"percent"`
+	Interval *time.Duration `yaml:"interval"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (t *TrafficShift) IsEmpty() bool {
+	return t.Percent == nil && t.Interval == nil
 }
 
 // WorkerDeploymentConfig represents the deployment strategies for a worker service.
 type WorkerDeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
-	WorkerRollbackAlarms       Union[[]string, WorkerAlarmArgs]  `yaml:"rollback_alarms"`
+	WorkerRollbackAlarms       Union[[]string, WorkerAlarmArgs] `yaml:"rollback_alarms"`
 }
 
 func (d *DeploymentConfig) isEmpty() bool {
-	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero())
+	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero() &&
+		d.Strategy == nil && d.TrafficShift.IsEmpty() && d.BakeTime == nil)
 }
 
 func (d *DeploymentControllerConfig) isEmpty() bool {
@@ -195,6 +220,14 @@ func (t *TaskConfig) ContainerPlatform() string {
 	return platformString(t.Platform.OS(), t.Platform.Arch())
 }
 
+// ContainerPlatforms returns the platforms to build a multi-platform image for, or nil if the image is built for a single platform.
+func (t *TaskConfig) ContainerPlatforms() []string {
+	if !t.Platform.IsMultiPlatform() {
+		return nil
+	}
+	return t.Platform.Platforms()
+}
+
 // IsWindows returns whether or not the service is building with a Windows OS.
 func (t TaskConfig) IsWindows() bool {
 	return isWindowsPlatform(t.Platform)
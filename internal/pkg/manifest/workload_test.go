@@ -340,6 +340,12 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlatformOpts,
 		},
+		"unmarshals a list of platforms": {
+			inContent: []byte(`platform: [linux/amd64, linux/arm64]`),
+			wantedStruct: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -352,6 +358,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.PlatformString, p.Platform.PlatformString)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.OSFamily, p.Platform.PlatformArgs.OSFamily)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.Arch, p.Platform.PlatformArgs.Arch)
+				require.Equal(t, tc.wantedStruct.PlatformList, p.Platform.PlatformList)
 			}
 		})
 	}
@@ -549,6 +556,12 @@ func TestPlatformArgsOrString_OS(t *testing.T) {
 			},
 			wanted: "linux",
 		},
+		"should return os of the first platform when platform is a list": {
+			in: &PlatformArgsOrString{
+				PlatformList: []PlatformString{"Linux/arm64", "linux/amd64"},
+			},
+			wanted: "linux",
+		},
 		"should return OS when platform is a map 2019 core": {
 			in: &PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -640,6 +653,12 @@ func TestPlatformArgsOrString_Arch(t *testing.T) {
 			},
 			wanted: "arm",
 		},
+		"should return arch of the first platform when platform is a list": {
+			in: &PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/ARM64", "linux/amd64"},
+			},
+			wanted: "arm64",
+		},
 		"should return arch when platform is a map 2019 core": {
 			in: &PlatformArgsOrString{
 				PlatformArgs: PlatformArgs{
@@ -28,3 +28,19 @@ type ErrNotExist struct {
 func (err *ErrNotExist) Error() string {
 	return fmt.Sprintf("overrider does not exist: %v", err.parent)
 }
+
+type errYAMLPatchOp struct {
+	index  int
+	op     string
+	path   string
+	parent error
+}
+
+func (err *errYAMLPatchOp) Error() string {
+	return fmt.Sprintf("apply YAML patch operation at index %d (%q on path %q): %v", err.index, err.op, err.path, err.parent)
+}
+
+// Unwrap returns the underlying error.
+func (err *errYAMLPatchOp) Unwrap() error {
+	return err.parent
+}
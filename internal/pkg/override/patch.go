@@ -0,0 +1,368 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported YAML patch operations as defined in RFC 6902.
+// See https://www.rfc-editor.org/rfc/rfc6902#section-4.
+const (
+	opAdd     = "add"
+	opRemove  = "remove"
+	opReplace = "replace"
+	opMove    = "move"
+	opCopy    = "copy"
+	opTest    = "test"
+)
+
+// YAMLPatch is an Overrider that can transform a CloudFormation template with a YAML patch document.
+type YAMLPatch struct {
+	filePath string   // Absolute path to the YAML patch document.
+	fs       afero.Fs // OS file system.
+}
+
+// YAMLPatchOpts is optional configuration for initializing a YAMLPatch Overrider.
+type YAMLPatchOpts struct {
+	FS afero.Fs // File system interface. If nil, defaults to the OS file system.
+}
+
+// WithYAMLPatch instantiates a new YAMLPatch Overrider with filePath being the path to the YAML patch document.
+func WithYAMLPatch(filePath string, opts YAMLPatchOpts) *YAMLPatch {
+	fs := afero.NewOsFs()
+	if opts.FS != nil {
+		fs = opts.FS
+	}
+	return &YAMLPatch{
+		filePath: filePath,
+		fs:       fs,
+	}
+}
+
+// yamlPatchOp represents a single operation in a YAML patch document.
+type yamlPatchOp struct {
+	Op    string    `yaml:"op"`
+	Path  string    `yaml:"path"`
+	From  string    `yaml:"from"`
+	Value yaml.Node `yaml:"value"`
+}
+
+// Override returns the CloudFormation template body after applying each operation in the YAML patch document in order.
+func (p *YAMLPatch) Override(body []byte) ([]byte, error) {
+	content, err := afero.ReadFile(p.fs, p.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read YAML patch document at %q: %w", p.filePath, err)
+	}
+	var ops []yamlPatchOp
+	if err := yaml.Unmarshal(content, &ops); err != nil {
+		return nil, fmt.Errorf("unmarshal YAML patch document at %q: %w", p.filePath, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal CloudFormation template: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, errors.New("cannot apply YAML patch document on an empty CloudFormation template")
+	}
+
+	for i, op := range ops {
+		if err := op.apply(&doc); err != nil {
+			return nil, &errYAMLPatchOp{
+				index:  i,
+				op:     op.Op,
+				path:   op.Path,
+				parent: err,
+			}
+		}
+	}
+
+	out := new(bytes.Buffer)
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc.Content[0]); err != nil {
+		return nil, fmt.Errorf("marshal CloudFormation template after applying YAML patches: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+func (o yamlPatchOp) apply(doc *yaml.Node) error {
+	switch o.Op {
+	case opAdd:
+		if o.Value.IsZero() {
+			return fmt.Errorf(`"value" is required for the %q operation`, o.Op)
+		}
+		return add(doc, o.Path, &o.Value)
+	case opRemove:
+		_, err := remove(doc, o.Path)
+		return err
+	case opReplace:
+		if o.Value.IsZero() {
+			return fmt.Errorf(`"value" is required for the %q operation`, o.Op)
+		}
+		return replace(doc, o.Path, &o.Value)
+	case opMove:
+		if o.From == o.Path {
+			return nil
+		}
+		if strings.HasPrefix(o.Path, o.From+"/") {
+			return fmt.Errorf(`cannot move %q into one of its children`, o.From)
+		}
+		node, err := remove(doc, o.From)
+		if err != nil {
+			return fmt.Errorf(`remove "from" location: %w`, err)
+		}
+		return add(doc, o.Path, node)
+	case opCopy:
+		node, err := get(doc, o.From)
+		if err != nil {
+			return fmt.Errorf(`get "from" location: %w`, err)
+		}
+		return add(doc, o.Path, deepCopy(node))
+	case opTest:
+		node, err := get(doc, o.Path)
+		if err != nil {
+			return err
+		}
+		return test(node, &o.Value)
+	default:
+		return fmt.Errorf("unsupported operation %q: must be one of %s", o.Op,
+			strings.Join([]string{opAdd, opRemove, opReplace, opMove, opCopy, opTest}, ", "))
+	}
+}
+
+// add inserts value at the location referenced by the JSON pointer path.
+// If the location is a mapping key that already exists, its value is replaced.
+// If the location is a sequence index, value is inserted before the index or appended when the index is "-".
+func add(doc *yaml.Node, path string, value *yaml.Node) error {
+	if path == "" {
+		doc.Content[0] = value
+		return nil
+	}
+	parent, key, err := getParent(doc, path)
+	if err != nil {
+		return err
+	}
+	switch parent.Kind {
+	case yaml.MappingNode:
+		if i := mappingValueIndex(parent, key); i != -1 {
+			parent.Content[i] = value
+			return nil
+		}
+		parent.Content = append(parent.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: key,
+		}, value)
+		return nil
+	case yaml.SequenceNode:
+		if key == "-" {
+			parent.Content = append(parent.Content, value)
+			return nil
+		}
+		idx, err := sequenceIndex(parent, key, true)
+		if err != nil {
+			return err
+		}
+		parent.Content = append(parent.Content[:idx], append([]*yaml.Node{value}, parent.Content[idx:]...)...)
+		return nil
+	default:
+		return fmt.Errorf("parent of %q is not a mapping or sequence", path)
+	}
+}
+
+// remove deletes the node at the location referenced by the JSON pointer path and returns it.
+func remove(doc *yaml.Node, path string) (*yaml.Node, error) {
+	if path == "" {
+		return nil, errors.New("cannot remove the root of the template")
+	}
+	parent, key, err := getParent(doc, path)
+	if err != nil {
+		return nil, err
+	}
+	switch parent.Kind {
+	case yaml.MappingNode:
+		i := mappingValueIndex(parent, key)
+		if i == -1 {
+			return nil, fmt.Errorf("key %q does not exist", key)
+		}
+		node := parent.Content[i]
+		parent.Content = append(parent.Content[:i-1], parent.Content[i+1:]...)
+		return node, nil
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key, false)
+		if err != nil {
+			return nil, err
+		}
+		node := parent.Content[idx]
+		parent.Content = append(parent.Content[:idx], parent.Content[idx+1:]...)
+		return node, nil
+	default:
+		return nil, fmt.Errorf("parent of %q is not a mapping or sequence", path)
+	}
+}
+
+// replace swaps the node at the location referenced by the JSON pointer path with value.
+func replace(doc *yaml.Node, path string, value *yaml.Node) error {
+	if path == "" {
+		doc.Content[0] = value
+		return nil
+	}
+	parent, key, err := getParent(doc, path)
+	if err != nil {
+		return err
+	}
+	switch parent.Kind {
+	case yaml.MappingNode:
+		i := mappingValueIndex(parent, key)
+		if i == -1 {
+			return fmt.Errorf("key %q does not exist", key)
+		}
+		parent.Content[i] = value
+		return nil
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key, false)
+		if err != nil {
+			return err
+		}
+		parent.Content[idx] = value
+		return nil
+	default:
+		return fmt.Errorf("parent of %q is not a mapping or sequence", path)
+	}
+}
+
+// test returns an error if the got node is not semantically equal to the wanted node.
+func test(got, wanted *yaml.Node) error {
+	var gotVal, wantedVal interface{}
+	if err := got.Decode(&gotVal); err != nil {
+		return fmt.Errorf("decode template value: %w", err)
+	}
+	if err := wanted.Decode(&wantedVal); err != nil {
+		return fmt.Errorf("decode test value: %w", err)
+	}
+	if !reflect.DeepEqual(gotVal, wantedVal) {
+		return fmt.Errorf("value %v does not match the expected value %v", gotVal, wantedVal)
+	}
+	return nil
+}
+
+// get returns the node at the location referenced by the JSON pointer path.
+func get(doc *yaml.Node, path string) (*yaml.Node, error) {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, err
+	}
+	return walk(doc.Content[0], tokens)
+}
+
+// getParent returns the parent node of the location referenced by the JSON pointer path and the last reference token.
+func getParent(doc *yaml.Node, path string) (*yaml.Node, string, error) {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, "", err
+	}
+	parent, err := walk(doc.Content[0], tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return parent, tokens[len(tokens)-1], nil
+}
+
+func walk(node *yaml.Node, tokens []string) (*yaml.Node, error) {
+	for i, token := range tokens {
+		switch node.Kind {
+		case yaml.MappingNode:
+			idx := mappingValueIndex(node, token)
+			if idx == -1 {
+				return nil, fmt.Errorf("key %q does not exist under %q", token, formatPointer(tokens[:i]))
+			}
+			node = node.Content[idx]
+		case yaml.SequenceNode:
+			idx, err := sequenceIndex(node, token, false)
+			if err != nil {
+				return nil, fmt.Errorf("%w under %q", err, formatPointer(tokens[:i]))
+			}
+			node = node.Content[idx]
+		default:
+			return nil, fmt.Errorf("%q is not a mapping or sequence", formatPointer(tokens[:i]))
+		}
+	}
+	return node, nil
+}
+
+// mappingValueIndex returns the index of the value node for key in a mapping node, or -1 if the key does not exist.
+func mappingValueIndex(node *yaml.Node, key string) int {
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		if node.Content[i].Value == key {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// sequenceIndex converts a reference token into an index of a sequence node.
+// If isInsert is true, the index can reference one past the last element.
+func sequenceIndex(node *yaml.Node, token string, isInsert bool) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid sequence index %q", token)
+	}
+	upper := len(node.Content) - 1
+	if isInsert {
+		upper = len(node.Content)
+	}
+	if idx > upper {
+		return 0, fmt.Errorf("index %d is out of bounds for a sequence of length %d", idx, len(node.Content))
+	}
+	return idx, nil
+}
+
+// parsePointer splits a JSON pointer into its unescaped reference tokens.
+// See https://www.rfc-editor.org/rfc/rfc6901.
+func parsePointer(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with a %q", path, "/")
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func formatPointer(tokens []string) string {
+	if len(tokens) == 0 {
+		return "/"
+	}
+	escaped := make([]string, len(tokens))
+	for i, token := range tokens {
+		escaped[i] = strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+func deepCopy(node *yaml.Node) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	cp := *node
+	cp.Content = make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		cp.Content[i] = deepCopy(child)
+	}
+	return &cp
+}
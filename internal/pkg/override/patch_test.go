@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLPatch_Override(t *testing.T) {
+	const tmpl = `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      Tags:
+        - Key: app
+          Value: demo
+`
+	tests := map[string]struct {
+		patch       string
+		wanted      string
+		wantedError string
+	}{
+		"add a new key to a mapping": {
+			patch: `
+- op: add
+  path: /Resources/Queue/Properties/DelaySeconds
+  value: 5`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      Tags:
+        - Key: app
+          Value: demo
+      DelaySeconds: 5
+`,
+		},
+		"add replaces an existing key": {
+			patch: `
+- op: add
+  path: /Resources/Queue/Properties/QueueName
+  value: payments`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: payments
+      Tags:
+        - Key: app
+          Value: demo
+`,
+		},
+		"add appends and inserts into sequences": {
+			patch: `
+- op: add
+  path: /Resources/Queue/Properties/Tags/-
+  value: {Key: env, Value: test}
+- op: add
+  path: /Resources/Queue/Properties/Tags/0
+  value: {Key: owner, Value: me}`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      Tags:
+        - {Key: owner, Value: me}
+        - Key: app
+          Value: demo
+        - {Key: env, Value: test}
+`,
+		},
+		"add supports escaped keys": {
+			patch: `
+- op: add
+  path: /Resources/Queue/Metadata
+  value: {}
+- op: add
+  path: /Resources/Queue/Metadata/a~1b~0c
+  value: hi`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      Tags:
+        - Key: app
+          Value: demo
+    Metadata: {a/b~c: hi}
+`,
+		},
+		"remove and replace": {
+			patch: `
+- op: remove
+  path: /Resources/Queue/Properties/Tags/0
+- op: replace
+  path: /Resources/Queue/Type
+  value: AWS::SNS::Topic`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SNS::Topic
+    Properties:
+      QueueName: orders
+      Tags: []
+`,
+		},
+		"move, copy and test": {
+			patch: `
+- op: test
+  path: /Resources/Queue/Properties/Tags/0
+  value:
+    Key: app
+    Value: demo
+- op: copy
+  from: /Resources/Queue/Properties/QueueName
+  path: /Resources/Queue/Properties/Alias
+- op: move
+  from: /Resources/Queue/Properties/Tags
+  path: /Resources/Queue/Tags`,
+			wanted: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      Alias: orders
+    Tags:
+      - Key: app
+        Value: demo
+`,
+		},
+		"error on a missing parent": {
+			patch: `
+- op: add
+  path: /Resources/Queue/Properties/Tags/0
+  value: {}
+- op: add
+  path: /Resources/Topic/Properties/Name
+  value: hi`,
+			wantedError: `apply YAML patch operation at index 1 ("add" on path "/Resources/Topic/Properties/Name"): key "Topic" does not exist under "/Resources"`,
+		},
+		"error on removing a key that does not exist": {
+			patch: `
+- op: remove
+  path: /Resources/Queue/Properties/DelaySeconds`,
+			wantedError: `apply YAML patch operation at index 0 ("remove" on path "/Resources/Queue/Properties/DelaySeconds"): key "DelaySeconds" does not exist`,
+		},
+		"error on an out of bounds index": {
+			patch: `
+- op: replace
+  path: /Resources/Queue/Properties/Tags/3
+  value: {}`,
+			wantedError: `apply YAML patch operation at index 0 ("replace" on path "/Resources/Queue/Properties/Tags/3"): index 3 is out of bounds for a sequence of length 1`,
+		},
+		"error on a failed test": {
+			patch: `
+- op: test
+  path: /Resources/Queue/Properties/QueueName
+  value: payments`,
+			wantedError: `apply YAML patch operation at index 0 ("test" on path "/Resources/Queue/Properties/QueueName"): value orders does not match the expected value payments`,
+		},
+		"error on a path without a leading slash": {
+			patch: `
+- op: remove
+  path: Resources`,
+			wantedError: `apply YAML patch operation at index 0 ("remove" on path "Resources"): path "Resources" must start with a "/"`,
+		},
+		"error on a move into a child": {
+			patch: `
+- op: move
+  from: /Resources/Queue
+  path: /Resources/Queue/Properties/Queue`,
+			wantedError: `apply YAML patch operation at index 0 ("move" on path "/Resources/Queue/Properties/Queue"): cannot move "/Resources/Queue" into one of its children`,
+		},
+		"error on an unsupported operation": {
+			patch: `
+- op: upsert
+  path: /Resources`,
+			wantedError: `apply YAML patch operation at index 0 ("upsert" on path "/Resources"): unsupported operation "upsert": must be one of add, remove, replace, move, copy, test`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			path := filepath.Join("copilot", "frontend", "overrides", "cfn.patches.yml")
+			_ = afero.WriteFile(fs, path, []byte(strings.TrimSpace(tc.patch)), 0644)
+			p := WithYAMLPatch(path, YAMLPatchOpts{
+				FS: fs,
+			})
+
+			// WHEN
+			out, err := p.Override([]byte(tmpl))
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
+
+func TestYAMLPatch_Override_Errors(t *testing.T) {
+	t.Run("should return a wrapped error if the patch document cannot be read", func(t *testing.T) {
+		// GIVEN
+		p := WithYAMLPatch("cfn.patches.yml", YAMLPatchOpts{
+			FS: afero.NewMemMapFs(),
+		})
+
+		// WHEN
+		_, err := p.Override([]byte("Resources: {}"))
+
+		// THEN
+		require.ErrorContains(t, err, `read YAML patch document at "cfn.patches.yml"`)
+	})
+	t.Run("should return an error if the template is empty", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "cfn.patches.yml", []byte("- {op: remove, path: /Resources}"), 0644)
+		p := WithYAMLPatch("cfn.patches.yml", YAMLPatchOpts{
+			FS: fs,
+		})
+
+		// WHEN
+		_, err := p.Override(nil)
+
+		// THEN
+		require.EqualError(t, err, "cannot apply YAML patch document on an empty CloudFormation template")
+	})
+}
@@ -13,6 +13,7 @@ import (
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(args *dockerengine.BuildArguments) error
+	BuildAndPushMultiPlatform(args *dockerengine.BuildArguments) (digest string, err error)
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
@@ -49,6 +50,8 @@ func NewWithURI(registry Registry, name, uri string) *Repository {
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// If the build arguments list several platforms, the images are pushed along with their manifest list, and the digest
+// of the manifest list is returned.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (digest string, err error) {
 	if args.URI == "" {
 		uri, err := r.URI()
@@ -57,22 +60,23 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 		}
 		args.URI = uri
 	}
+	if len(args.Platforms) > 0 {
+		// Multi-platform images are pushed while they're built, so we need to log in first.
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
+		}
+		digest, err := docker.BuildAndPushMultiPlatform(args)
+		if err != nil {
+			return "", fmt.Errorf("build Dockerfile at %s and push to repo %s: %w", args.Dockerfile, r.name, err)
+		}
+		return digest, nil
+	}
 	if err := docker.Build(args); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
-
-	// Perform docker login only if credStore attribute value != ecr-login
-	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
-		username, password, err := r.registry.Auth()
-		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
-		}
-
-		if err := docker.Login(args.URI, username, password); err != nil {
-			return "", fmt.Errorf("login to repo %s: %w", r.name, err)
-		}
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
 	}
-
 	digest, err = docker.Push(args.URI, args.Tags...)
 	if err != nil {
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
@@ -80,6 +84,21 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 	return digest, nil
 }
 
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
+	// Perform docker login only if credStore attribute value != ecr-login
+	if docker.IsEcrCredentialHelperEnabled(uri) {
+		return nil
+	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() (string, error) {
 	if r.uri != "" {
@@ -30,8 +30,12 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		Tags:       []string{mockTag1, mockTag2, mockTag3},
 	}
 
+	multiPlatformDockerArguments := defaultDockerArguments
+	multiPlatformDockerArguments.Platforms = []string{"linux/amd64", "linux/arm64"}
+
 	testCases := map[string]struct {
 		inURI        string
+		inPlatforms  []string
 		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
 
 		mockRegistry func(m *mocks.MockRegistry)
@@ -119,6 +123,32 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"failed to build and push multi-platform image": {
+			inURI:       defaultDockerArguments.URI,
+			inPlatforms: multiPlatformDockerArguments.Platforms,
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(true)
+				m.EXPECT().BuildAndPushMultiPlatform(&multiPlatformDockerArguments).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("build Dockerfile at %s and push to repo my-repo: some error", inDockerfilePath),
+		},
+		"logs in before building and pushing multi-platform image": {
+			inURI:       defaultDockerArguments.URI,
+			inPlatforms: multiPlatformDockerArguments.Platforms,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(false),
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().BuildAndPushMultiPlatform(&multiPlatformDockerArguments).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil),
+				)
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -145,6 +175,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platforms:  tc.inPlatforms,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
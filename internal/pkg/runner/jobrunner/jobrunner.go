@@ -5,16 +5,29 @@
 package jobrunner
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
+// overridesInputPath is the path of the execution input that state machines accepting runtime overrides pass to ECS.
+const overridesInputPath = "$.Overrides"
+
 // StateMachineExecutor is the interface that implements the Execute method to invoke a state machine.
 type StateMachineExecutor interface {
-	Execute(stateMachineARN string) error
+	Execute(stateMachineARN, input string) (string, error)
+	StateMachineDefinition(stateMachineARN string) (string, error)
+}
+
+// TaskDefinitionRegisterer is the interface to register a new revision of a task definition.
+type TaskDefinitionRegisterer interface {
+	RegisterTaskDefinitionRevision(taskDefName string, opts ...awsecs.RegisterTaskDefinitionOpts) (string, error)
 }
 
 // CFNStackResourceLister is the interface to list CloudFormation stack resources.
@@ -28,8 +41,23 @@ type JobRunner struct {
 	env string
 	job string
 
+	overrides Overrides
+
 	cfn          CFNStackResourceLister
 	stateMachine StateMachineExecutor
+	ecs          TaskDefinitionRegisterer
+}
+
+// Overrides holds the values that replace the ones of the job's main container for a single execution.
+type Overrides struct {
+	EnvVars map[string]string // Environment variables to add or replace.
+	Secrets map[string]string // Secrets to add or replace, referenced by SSM parameter name or secret ARN.
+	Command []string          // Command to run instead of the one of the image or manifest.
+}
+
+// IsEmpty returns true if no value is overridden.
+func (o Overrides) IsEmpty() bool {
+	return len(o.EnvVars) == 0 && len(o.Secrets) == 0 && len(o.Command) == 0
 }
 
 // Config hold the data needed to create a JobRunner.
@@ -38,9 +66,12 @@ type Config struct {
 	Env string // Name of the environment.
 	Job string // Name of the job.
 
+	Overrides Overrides // Optional. Runtime overrides for the execution.
+
 	// Dependencies to invoke a job.
-	CFN          CFNStackResourceLister // CloudFormation client to list stack resources.
-	StateMachine StateMachineExecutor   // StepFunction client to execute a state machine.
+	CFN          CFNStackResourceLister   // CloudFormation client to list stack resources.
+	StateMachine StateMachineExecutor     // StepFunction client to execute a state machine.
+	ECS          TaskDefinitionRegisterer // ECS client to register a task definition with overridden secrets.
 }
 
 // New creates a new JobRunner.
@@ -49,32 +80,111 @@ func New(cfg *Config) *JobRunner {
 		app:          cfg.App,
 		env:          cfg.Env,
 		job:          cfg.Job,
+		overrides:    cfg.Overrides,
 		cfn:          cfg.CFN,
 		stateMachine: cfg.StateMachine,
+		ecs:          cfg.ECS,
 	}
 
 }
 
-// Run invokes a job.
+// Run invokes a job and returns the ARN of the state machine execution.
 // An error is returned if the state machine's ARN can not be derived from the job, or the execution fails.
-func (job *JobRunner) Run() error {
+func (job *JobRunner) Run() (string, error) {
 	resources, err := job.cfn.StackResources(stack.NameForService(job.app, job.env, job.job))
 	if err != nil {
-		return fmt.Errorf("describe stack %q: %v", stack.NameForService(job.app, job.env, job.job), err)
+		return "", fmt.Errorf("describe stack %q: %v", stack.NameForService(job.app, job.env, job.job), err)
 	}
 
-	var arn string
+	var arn, taskDef string
 	for _, resource := range resources {
-		if aws.StringValue(resource.ResourceType) == "AWS::StepFunctions::StateMachine" {
+		switch aws.StringValue(resource.ResourceType) {
+		case "AWS::StepFunctions::StateMachine":
 			arn = aws.StringValue(resource.PhysicalResourceId)
-			break
+		case "AWS::ECS::TaskDefinition":
+			taskDef = aws.StringValue(resource.PhysicalResourceId)
 		}
 	}
 	if arn == "" {
-		return fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+		return "", fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
 	}
-	if err := job.stateMachine.Execute(arn); err != nil {
-		return fmt.Errorf("execute state machine %q: %v", arn, err)
+	input, err := job.executionInput(arn, taskDef)
+	if err != nil {
+		return "", err
+	}
+	executionARN, err := job.stateMachine.Execute(arn, input)
+	if err != nil {
+		return "", fmt.Errorf("execute state machine %q: %v", arn, err)
+	}
+	return executionARN, nil
+}
+
+type executionInput struct {
+	Overrides      taskOverride
+	TaskDefinition string
+}
+
+type taskOverride struct {
+	ContainerOverrides []containerOverride
+}
+
+type containerOverride struct {
+	Name        string
+	Environment []keyValuePair `json:",omitempty"`
+	Command     []string       `json:",omitempty"`
+}
+
+type keyValuePair struct {
+	Name  string
+	Value string
+}
+
+// executionInput returns the JSON input of the execution that the state machine passes to the ECS RunTask call,
+// or an empty string if there is nothing to override.
+func (job *JobRunner) executionInput(stateMachineARN, taskDef string) (string, error) {
+	if job.overrides.IsEmpty() {
+		return "", nil
+	}
+	definition, err := job.stateMachine.StateMachineDefinition(stateMachineARN)
+	if err != nil {
+		return "", fmt.Errorf("get state machine definition for job %q: %w", job.job, err)
+	}
+	if !strings.Contains(definition, overridesInputPath) {
+		return "", fmt.Errorf("state machine for job %q does not accept runtime overrides, redeploy the job to update it", job.job)
+	}
+	if taskDef == "" {
+		return "", fmt.Errorf("task definition for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+	}
+	if len(job.overrides.Secrets) > 0 {
+		taskDef, err = job.ecs.RegisterTaskDefinitionRevision(taskDef, awsecs.WithContainerSecrets(job.job, job.overrides.Secrets))
+		if err != nil {
+			return "", fmt.Errorf("override secrets of job %q: %w", job.job, err)
+		}
+	}
+
+	override := containerOverride{
+		Name:    job.job,
+		Command: job.overrides.Command,
+	}
+	names := make([]string, 0, len(job.overrides.EnvVars))
+	for name := range job.overrides.EnvVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		override.Environment = append(override.Environment, keyValuePair{
+			Name:  name,
+			Value: job.overrides.EnvVars[name],
+		})
+	}
+	out, err := json.Marshal(executionInput{
+		Overrides: taskOverride{
+			ContainerOverrides: []containerOverride{override},
+		},
+		TaskDefinition: taskDef,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal execution input: %w", err)
 	}
-	return nil
+	return string(out), nil
 }
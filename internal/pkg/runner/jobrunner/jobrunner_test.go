@@ -15,23 +15,40 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+var (
+	mockJobStackResources = []*cloudformation.StackResource{
+		{
+			ResourceType:       aws.String("AWS::ECS::TaskDefinition"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:1"),
+		},
+		{
+			ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+			PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+		},
+	}
+	mockDefinitionWithOverrides = `{"States": {"Run Fargate Task": {"Parameters": {"Overrides.$": "$.Overrides"}}}}`
+)
+
 func TestJobRunner_Run(t *testing.T) {
 
 	testCases := map[string]struct {
 		MockExecutor func(m *mocks.MockStateMachineExecutor)
 
-		App string
-		Env string
-		Job string
+		App       string
+		Env       string
+		Job       string
+		Overrides Overrides
 
 		MockCFN func(m *mocks.MockCFNStackResourceLister)
+		MockECS func(m *mocks.MockTaskDefinitionRegisterer)
 
+		wantedARN   string
 		wantedError error
 	}{
 
 		"missing stack": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job", "").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -44,7 +61,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"missing statemachine resource": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job", "").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -61,7 +78,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"failed statemachine execution": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(fmt.Errorf("ExecutionLimitExceeded"))
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job", "").Return("", fmt.Errorf("ExecutionLimitExceeded"))
 			},
 			App: "appname",
 			Env: "envname",
@@ -79,7 +96,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"run success": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil)
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job", "").Return("mockExecutionARN", nil)
 			},
 			App: "appname",
 			Env: "envname",
@@ -92,6 +109,63 @@ func TestJobRunner_Run(t *testing.T) {
 					},
 				}, nil)
 			},
+			wantedARN: "mockExecutionARN",
+		},
+
+		"state machine without runtime overrides support": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().StateMachineDefinition("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(`{"StartAt": "Run Fargate Task"}`, nil)
+			},
+			App:       "appname",
+			Env:       "envname",
+			Job:       "jobname",
+			Overrides: Overrides{Command: []string{"report", "--date", "2022-01-01"}},
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return(mockJobStackResources, nil)
+			},
+			wantedError: errors.New(`state machine for job "jobname" does not accept runtime overrides, redeploy the job to update it`),
+		},
+
+		"failed to register a task definition revision with the secrets": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().StateMachineDefinition(gomock.Any()).Return(mockDefinitionWithOverrides, nil)
+			},
+			App:       "appname",
+			Env:       "envname",
+			Job:       "jobname",
+			Overrides: Overrides{Secrets: map[string]string{"DB_PASS": "/copilot/app/env/secrets/db"}},
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return(mockJobStackResources, nil)
+			},
+			MockECS: func(m *mocks.MockTaskDefinitionRegisterer) {
+				m.EXPECT().RegisterTaskDefinitionRevision("arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:1", gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New(`override secrets of job "jobname": some error`),
+		},
+
+		"run success with overrides": {
+			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
+				m.EXPECT().StateMachineDefinition("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(mockDefinitionWithOverrides, nil)
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job",
+					`{"Overrides":{"ContainerOverrides":[{"Name":"jobname","Environment":[{"Name":"DATE","Value":"2022-01-01"},{"Name":"TENANT","Value":"acme"}],"Command":["report","--verbose"]}]},"TaskDefinition":"arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:2"}`).
+					Return("mockExecutionARN", nil)
+			},
+			App: "appname",
+			Env: "envname",
+			Job: "jobname",
+			Overrides: Overrides{
+				EnvVars: map[string]string{"TENANT": "acme", "DATE": "2022-01-01"},
+				Secrets: map[string]string{"DB_PASS": "/copilot/app/env/secrets/db"},
+				Command: []string{"report", "--verbose"},
+			},
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return(mockJobStackResources, nil)
+			},
+			MockECS: func(m *mocks.MockTaskDefinitionRegisterer) {
+				m.EXPECT().RegisterTaskDefinitionRevision("arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:1", gomock.Any()).
+					Return("arn:aws:ecs:us-east-1:111111111111:task-definition/app-env-job:2", nil)
+			},
+			wantedARN: "mockExecutionARN",
 		},
 	}
 
@@ -103,24 +177,31 @@ func TestJobRunner_Run(t *testing.T) {
 
 			cfn := mocks.NewMockCFNStackResourceLister(ctrl)
 			sfn := mocks.NewMockStateMachineExecutor(ctrl)
+			ecs := mocks.NewMockTaskDefinitionRegisterer(ctrl)
 
 			tc.MockCFN(cfn)
 			tc.MockExecutor(sfn)
+			if tc.MockECS != nil {
+				tc.MockECS(ecs)
+			}
 
 			jobRunner := JobRunner{
 				stateMachine: sfn,
 				app:          tc.App,
 				env:          tc.Env,
 				job:          tc.Job,
+				overrides:    tc.Overrides,
 				cfn:          cfn,
+				ecs:          ecs,
 			}
 
-			err := jobRunner.Run()
+			arn, err := jobRunner.Run()
 
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, arn)
 			}
 		})
 	}
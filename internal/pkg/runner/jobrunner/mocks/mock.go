@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,17 +36,76 @@ func (m *MockStateMachineExecutor) EXPECT() *MockStateMachineExecutorMockRecorde
 }
 
 // Execute mocks base method.
-func (m *MockStateMachineExecutor) Execute(stateMachineARN string) error {
+func (m *MockStateMachineExecutor) Execute(stateMachineARN, input string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", stateMachineARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Execute", stateMachineARN, input)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN interface{}) *gomock.Call {
+func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN, input)
+}
+
+// StateMachineDefinition mocks base method.
+func (m *MockStateMachineExecutor) StateMachineDefinition(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineDefinition", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineDefinition indicates an expected call of StateMachineDefinition.
+func (mr *MockStateMachineExecutorMockRecorder) StateMachineDefinition(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockStateMachineExecutor)(nil).StateMachineDefinition), stateMachineARN)
+}
+
+// MockTaskDefinitionRegisterer is a mock of TaskDefinitionRegisterer interface.
+type MockTaskDefinitionRegisterer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskDefinitionRegistererMockRecorder
+}
+
+// MockTaskDefinitionRegistererMockRecorder is the mock recorder for MockTaskDefinitionRegisterer.
+type MockTaskDefinitionRegistererMockRecorder struct {
+	mock *MockTaskDefinitionRegisterer
+}
+
+// NewMockTaskDefinitionRegisterer creates a new mock instance.
+func NewMockTaskDefinitionRegisterer(ctrl *gomock.Controller) *MockTaskDefinitionRegisterer {
+	mock := &MockTaskDefinitionRegisterer{ctrl: ctrl}
+	mock.recorder = &MockTaskDefinitionRegistererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskDefinitionRegisterer) EXPECT() *MockTaskDefinitionRegistererMockRecorder {
+	return m.recorder
+}
+
+// RegisterTaskDefinitionRevision mocks base method.
+func (m *MockTaskDefinitionRegisterer) RegisterTaskDefinitionRevision(taskDefName string, opts ...ecs.RegisterTaskDefinitionOpts) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{taskDefName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterTaskDefinitionRevision", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTaskDefinitionRevision indicates an expected call of RegisterTaskDefinitionRevision.
+func (mr *MockTaskDefinitionRegistererMockRecorder) RegisterTaskDefinitionRevision(taskDefName interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN)
+	varargs := append([]interface{}{taskDefName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskDefinitionRevision", reflect.TypeOf((*MockTaskDefinitionRegisterer)(nil).RegisterTaskDefinitionRevision), varargs...)
 }
 
 // MockCFNStackResourceLister is a mock of CFNStackResourceLister interface.
@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	cfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+)
+
+// StackDriftDetectionDescriber is the CloudFormation interface needed to describe a stack drift detection operation.
+type StackDriftDetectionDescriber interface {
+	DriftDetectionStatus(detectionID string) (*cfn.DriftDetection, error)
+}
+
+// StackDriftDetection is the status of a drift detection operation on a stack.
+type StackDriftDetection struct {
+	StackName            string
+	Status               string
+	StatusReason         string
+	StackDriftStatus     string
+	DriftedResourceCount int64
+}
+
+// StackDriftDetectionStreamer is a Streamer for StackDriftDetection statuses until the drift detection operation completes.
+type StackDriftDetectionStreamer struct {
+	client      StackDriftDetectionDescriber
+	clock       clock
+	rand        func(n int) int
+	stackName   string
+	detectionID string
+
+	subscribers   []chan StackDriftDetection
+	isDone        bool
+	lastStatus    string
+	eventsToFlush []StackDriftDetection
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewStackDriftDetectionStreamer creates a new StackDriftDetectionStreamer that streams the status
+// of a drift detection operation on a stack until it completes or fails.
+func NewStackDriftDetectionStreamer(client StackDriftDetectionDescriber, stackName, detectionID string) *StackDriftDetectionStreamer {
+	return &StackDriftDetectionStreamer{
+		client:      client,
+		clock:       realClock{},
+		rand:        rand.Intn,
+		stackName:   stackName,
+		detectionID: detectionID,
+	}
+}
+
+// Subscribe returns a read-only channel that will receive drift detection statuses from the StackDriftDetectionStreamer.
+func (s *StackDriftDetectionStreamer) Subscribe() <-chan StackDriftDetection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan StackDriftDetection)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the status of the drift detection operation if it changed since the last Fetch.
+// If an error occurs while describing the operation, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted and whether the operation is done.
+func (s *StackDriftDetectionStreamer) Fetch() (next time.Time, done bool, err error) {
+	detection, err := s.client.DriftDetectionStatus(s.detectionID)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), false, nil
+		}
+		return next, false, fmt.Errorf("fetch drift detection status of stack %s: %w", s.stackName, err)
+	}
+	s.retries = 0
+	if detection.Status != s.lastStatus {
+		s.lastStatus = detection.Status
+		s.eventsToFlush = append(s.eventsToFlush, StackDriftDetection{
+			StackName:            s.stackName,
+			Status:               detection.Status,
+			StatusReason:         detection.StatusReason,
+			StackDriftStatus:     detection.StackDriftStatus,
+			DriftedResourceCount: detection.DriftedResourceCount,
+		})
+	}
+	return nextFetchDate(s.clock, s.rand, s.retries), detection.IsDone(), nil
+}
+
+// Notify flushes all new statuses to the streamer's subscribers.
+func (s *StackDriftDetectionStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan StackDriftDetection
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *StackDriftDetectionStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDriftDetectionDescriber struct {
+	detections []*cfn.DriftDetection
+	err        error
+}
+
+func (m *mockDriftDetectionDescriber) DriftDetectionStatus(detectionID string) (*cfn.DriftDetection, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	detection := m.detections[0]
+	if len(m.detections) > 1 {
+		m.detections = m.detections[1:]
+	}
+	return detection, nil
+}
+
+func TestStackDriftDetectionStreamer_Subscribe(t *testing.T) {
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &StackDriftDetectionStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestStackDriftDetectionStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on describe drift detection status call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockDriftDetectionDescriber{
+			err: errors.New("some error"),
+		}
+		streamer := NewStackDriftDetectionStreamer(m, "phonetool-test", "mockDetectionID")
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch drift detection status of stack phonetool-test: some error")
+	})
+	t.Run("retries on throttling errors", func(t *testing.T) {
+		// GIVEN
+		now := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := &mockDriftDetectionDescriber{
+			err: awserr.New("RequestThrottled", "throttled", nil),
+		}
+		streamer := NewStackDriftDetectionStreamer(m, "phonetool-test", "mockDetectionID")
+		streamer.clock = fakeClock{fakeNow: now}
+		streamer.rand = func(n int) int { return n }
+
+		// WHEN
+		next, done, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.False(t, done)
+		require.Equal(t, 1, streamer.retries)
+		require.True(t, next.After(now))
+	})
+	t.Run("stores only status changes until the drift detection completes", func(t *testing.T) {
+		// GIVEN
+		m := &mockDriftDetectionDescriber{
+			detections: []*cfn.DriftDetection{
+				{Status: "DETECTION_IN_PROGRESS"},
+				{Status: "DETECTION_IN_PROGRESS"},
+				{Status: "DETECTION_COMPLETE", StackDriftStatus: "DRIFTED", DriftedResourceCount: 1},
+			},
+		}
+		streamer := NewStackDriftDetectionStreamer(m, "phonetool-test", "mockDetectionID")
+
+		// WHEN
+		_, done, err := streamer.Fetch()
+		require.NoError(t, err)
+		require.False(t, done)
+		_, done, err = streamer.Fetch()
+		require.NoError(t, err)
+		require.False(t, done)
+		_, done, err = streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, done)
+		require.Equal(t, []StackDriftDetection{
+			{
+				StackName: "phonetool-test",
+				Status:    "DETECTION_IN_PROGRESS",
+			},
+			{
+				StackName:            "phonetool-test",
+				Status:               "DETECTION_COMPLETE",
+				StackDriftStatus:     "DRIFTED",
+				DriftedResourceCount: 1,
+			},
+		}, streamer.eventsToFlush)
+	})
+}
+
+func TestStackDriftDetectionStreamer_Notify(t *testing.T) {
+	// GIVEN
+	streamer := &StackDriftDetectionStreamer{
+		eventsToFlush: []StackDriftDetection{
+			{StackName: "phonetool-test", Status: "DETECTION_COMPLETE"},
+		},
+	}
+	sub := streamer.Subscribe()
+
+	// WHEN
+	go streamer.Notify()
+	event := <-sub
+
+	// THEN
+	require.Equal(t, StackDriftDetection{StackName: "phonetool-test", Status: "DETECTION_COMPLETE"}, event)
+}
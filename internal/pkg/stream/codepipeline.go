@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	cp "github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+)
+
+// PipelineExecutionDescriber is the interface to describe a pipeline execution and the state of its stages.
+type PipelineExecutionDescriber interface {
+	GetPipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error)
+	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+}
+
+// PipelineExecution is a description of a pipeline execution.
+type PipelineExecution struct {
+	Status        string
+	StatusSummary string
+	// States of the stages of the pipeline for this execution.
+	// Stages that the execution did not reach yet have no actions.
+	StageStates []codepipeline.StageState
+}
+
+// IsDone returns true if the execution stopped.
+func (e PipelineExecution) IsDone() bool {
+	switch e.Status {
+	case "", cp.PipelineExecutionStatusInProgress, cp.PipelineExecutionStatusStopping:
+		return false
+	default:
+		return true
+	}
+}
+
+// PipelineExecutionStreamer is a Streamer for PipelineExecution descriptions until the execution stops.
+type PipelineExecutionStreamer struct {
+	client       PipelineExecutionDescriber
+	clock        clock
+	rand         func(n int) int
+	pipelineName string
+	executionID  string
+
+	subscribers   []chan PipelineExecution
+	isDone        bool
+	eventsToFlush []PipelineExecution
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewPipelineExecutionStreamer creates a new PipelineExecutionStreamer that streams descriptions
+// of the pipeline execution until it succeeds, fails, is stopped or superseded.
+func NewPipelineExecutionStreamer(client PipelineExecutionDescriber, pipelineName, executionID string) *PipelineExecutionStreamer {
+	return &PipelineExecutionStreamer{
+		client:       client,
+		clock:        realClock{},
+		rand:         rand.Intn,
+		pipelineName: pipelineName,
+		executionID:  executionID,
+	}
+}
+
+// Subscribe returns a read-only channel that will receive execution descriptions from the PipelineExecutionStreamer.
+func (s *PipelineExecutionStreamer) Subscribe() <-chan PipelineExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan PipelineExecution)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the status of the execution and the state of the stages that it ran.
+// If an error occurs while describing the execution, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted and whether the execution stopped.
+func (s *PipelineExecutionStreamer) Fetch() (next time.Time, done bool, err error) {
+	execution, err := s.client.GetPipelineExecution(s.pipelineName, s.executionID)
+	if err != nil {
+		return s.throttledOrErr(fmt.Errorf("fetch pipeline execution: %w", err))
+	}
+	state, err := s.client.GetPipelineState(s.pipelineName)
+	if err != nil {
+		return s.throttledOrErr(fmt.Errorf("fetch pipeline state: %w", err))
+	}
+	s.retries = 0
+	var stages []codepipeline.StageState
+	for _, stage := range state.StageStates {
+		if stage.ExecutionID != s.executionID {
+			// The stage holds the state of another execution, so this execution did not reach it yet.
+			stages = append(stages, codepipeline.StageState{
+				StageName:  stage.StageName,
+				Transition: stage.Transition,
+			})
+			continue
+		}
+		stages = append(stages, *stage)
+	}
+	desc := PipelineExecution{
+		Status:        execution.Status,
+		StatusSummary: execution.StatusSummary,
+		StageStates:   stages,
+	}
+	s.eventsToFlush = append(s.eventsToFlush, desc)
+	return nextFetchDate(s.clock, s.rand, s.retries), desc.IsDone(), nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *PipelineExecutionStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan PipelineExecution
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *PipelineExecutionStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+func (s *PipelineExecutionStreamer) throttledOrErr(err error) (next time.Time, done bool, _ error) {
+	if request.IsErrorThrottle(err) {
+		s.retries += 1
+		return nextFetchDate(s.clock, s.rand, s.retries), false, nil
+	}
+	return next, false, err
+}
@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPipeline struct {
+	execution    *codepipeline.PipelineExecution
+	state        *codepipeline.PipelineState
+	executionErr error
+	stateErr     error
+}
+
+func (m *mockPipeline) GetPipelineExecution(pipelineName, executionID string) (*codepipeline.PipelineExecution, error) {
+	return m.execution, m.executionErr
+}
+
+func (m *mockPipeline) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
+	return m.state, m.stateErr
+}
+
+func TestPipelineExecutionStreamer_Subscribe(t *testing.T) {
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &PipelineExecutionStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestPipelineExecutionStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on get pipeline execution call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockPipeline{
+			executionErr: errors.New("some error"),
+		}
+		streamer := NewPipelineExecutionStreamer(m, "mockPipeline", "mockExecutionID")
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch pipeline execution: some error")
+	})
+	t.Run("returns a wrapped error on get pipeline state call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockPipeline{
+			execution: &codepipeline.PipelineExecution{Status: "InProgress"},
+			stateErr:  errors.New("some error"),
+		}
+		streamer := NewPipelineExecutionStreamer(m, "mockPipeline", "mockExecutionID")
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch pipeline state: some error")
+	})
+	t.Run("stores only the stages run by the execution until it stops", func(t *testing.T) {
+		// GIVEN
+		now := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := &mockPipeline{
+			execution: &codepipeline.PipelineExecution{ID: "2", Status: "InProgress"},
+			state: &codepipeline.PipelineState{
+				StageStates: []*codepipeline.StageState{
+					{
+						StageName:   "Source",
+						Actions:     []codepipeline.StageAction{{Name: "SourceCodeFor-app", Status: "Succeeded"}},
+						ExecutionID: "2",
+					},
+					{
+						StageName:   "Build",
+						Actions:     []codepipeline.StageAction{{Name: "Build", Status: "InProgress"}},
+						Transition:  "ENABLED",
+						ExecutionID: "2",
+					},
+					{
+						StageName:   "DeployTo-test",
+						Actions:     []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "Failed"}},
+						Transition:  "ENABLED",
+						ExecutionID: "1",
+					},
+				},
+			},
+		}
+		streamer := &PipelineExecutionStreamer{
+			client:       m,
+			clock:        fakeClock{fakeNow: now},
+			rand:         func(n int) int { return n },
+			pipelineName: "mockPipeline",
+			executionID:  "2",
+		}
+
+		// WHEN
+		_, done, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.False(t, done)
+
+		// WHEN
+		m.execution = &codepipeline.PipelineExecution{ID: "2", Status: "Succeeded"}
+		m.state.StageStates[1] = &codepipeline.StageState{
+			StageName:   "Build",
+			Actions:     []codepipeline.StageAction{{Name: "Build", Status: "Succeeded"}},
+			Transition:  "ENABLED",
+			ExecutionID: "2",
+		}
+		m.state.StageStates[2] = &codepipeline.StageState{
+			StageName:   "DeployTo-test",
+			Actions:     []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "Succeeded"}},
+			Transition:  "ENABLED",
+			ExecutionID: "2",
+		}
+		_, done, err = streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, done)
+		require.Equal(t, []PipelineExecution{
+			{
+				Status: "InProgress",
+				StageStates: []codepipeline.StageState{
+					{
+						StageName:   "Source",
+						Actions:     []codepipeline.StageAction{{Name: "SourceCodeFor-app", Status: "Succeeded"}},
+						ExecutionID: "2",
+					},
+					{
+						StageName:   "Build",
+						Actions:     []codepipeline.StageAction{{Name: "Build", Status: "InProgress"}},
+						Transition:  "ENABLED",
+						ExecutionID: "2",
+					},
+					{
+						StageName:  "DeployTo-test",
+						Transition: "ENABLED",
+					},
+				},
+			},
+			{
+				Status: "Succeeded",
+				StageStates: []codepipeline.StageState{
+					{
+						StageName:   "Source",
+						Actions:     []codepipeline.StageAction{{Name: "SourceCodeFor-app", Status: "Succeeded"}},
+						ExecutionID: "2",
+					},
+					{
+						StageName:   "Build",
+						Actions:     []codepipeline.StageAction{{Name: "Build", Status: "Succeeded"}},
+						Transition:  "ENABLED",
+						ExecutionID: "2",
+					},
+					{
+						StageName:   "DeployTo-test",
+						Actions:     []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "Succeeded"}},
+						Transition:  "ENABLED",
+						ExecutionID: "2",
+					},
+				},
+			},
+		}, streamer.eventsToFlush)
+	})
+}
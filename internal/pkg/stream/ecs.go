@@ -13,11 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 )
 
 const (
 	// ECS service deployment constants.
 	ecsPrimaryDeploymentStatus = "PRIMARY"
+	ecsActiveDeploymentStatus  = "ACTIVE"
 	rollOutCompleted           = "COMPLETED"
 	rollOutFailed              = "FAILED"
 	rollOutEmpty               = ""
@@ -30,6 +32,11 @@ type ECSServiceDescriber interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
+// TargetGroupWeightDescriber is the interface to describe how a listener rule splits traffic between target groups.
+type TargetGroupWeightDescriber interface {
+	TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error)
+}
+
 // ECSDeployment represent an ECS rolling update deployment.
 type ECSDeployment struct {
 	Status          string
@@ -41,6 +48,7 @@ type ECSDeployment struct {
 	RolloutState    string
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	TrafficPercent  *int // Share of the load balancer traffic served by the deployment, nil if traffic isn't shifted.
 }
 
 func (d ECSDeployment) isPrimary() bool {
@@ -73,6 +81,11 @@ type ECSDeploymentStreamer struct {
 	service                string
 	deploymentCreationTime time.Time
 
+	// Listener rule that shifts traffic between two target groups during the deployment, if any.
+	weights         TargetGroupWeightDescriber
+	listenerRuleARN string
+	oldTargetGroup  string // Target group serving the traffic when the deployment started.
+
 	subscribers   []chan ECSService
 	isDone        bool
 	pastEventIDs  map[string]bool
@@ -82,10 +95,22 @@ type ECSDeploymentStreamer struct {
 	retries int
 }
 
+// ECSDeploymentStreamerOpt is an optional configuration for an ECSDeploymentStreamer.
+type ECSDeploymentStreamerOpt func(s *ECSDeploymentStreamer)
+
+// WithTrafficSplit streams the share of traffic served by each deployment as the listener rule
+// shifts traffic from the old target group to the new one.
+func WithTrafficSplit(describer TargetGroupWeightDescriber, listenerRuleARN string) ECSDeploymentStreamerOpt {
+	return func(s *ECSDeploymentStreamer) {
+		s.weights = describer
+		s.listenerRuleARN = listenerRuleARN
+	}
+}
+
 // NewECSDeploymentStreamer creates a new ECSDeploymentStreamer that streams service descriptions
 // since the deployment creation time and until the primary deployment is completed.
-func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time) *ECSDeploymentStreamer {
-	return &ECSDeploymentStreamer{
+func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time, opts ...ECSDeploymentStreamerOpt) *ECSDeploymentStreamer {
+	s := &ECSDeploymentStreamer{
 		client:                 ecs,
 		clock:                  realClock{},
 		rand:                   rand.Intn,
@@ -94,6 +119,10 @@ func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string,
 		deploymentCreationTime: deploymentCreationTime,
 		pastEventIDs:           make(map[string]bool),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Subscribe returns a read-only channel that will receive service descriptions from the ECSDeploymentStreamer.
@@ -122,7 +151,6 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
 		}
 		return next, false, fmt.Errorf("fetch service description: %w", err)
 	}
-	s.retries = 0
 	var deployments []ECSDeployment
 	for _, deployment := range out.Deployments {
 		status := aws.StringValue(deployment.Status)
@@ -143,6 +171,18 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
 			done = true
 		}
 	}
+	if s.weights != nil {
+		weights, err := s.weights.TargetGroupWeights(s.listenerRuleARN)
+		if err != nil {
+			if request.IsErrorThrottle(err) {
+				s.retries += 1
+				return nextFetchDate(s.clock, s.rand, s.retries), false, nil
+			}
+			return next, false, fmt.Errorf("fetch traffic split of listener rule %s: %w", s.listenerRuleARN, err)
+		}
+		s.splitTraffic(deployments, weights)
+	}
+	s.retries = 0
 	var failureMsgs []string
 	for _, event := range out.Events {
 		if createdAt := aws.TimeValue(event.CreatedAt); createdAt.Before(s.deploymentCreationTime) {
@@ -164,6 +204,42 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
 	return nextFetchDate(s.clock, s.rand, s.retries), done, nil
 }
 
+// splitTraffic sets the share of traffic served by the primary deployment, which sends traffic to the new target group,
+// and by the active deployment, which sends traffic to the target group that served the traffic when the deployment started.
+func (s *ECSDeploymentStreamer) splitTraffic(deployments []ECSDeployment, weights []elbv2.TargetGroupWeight) {
+	if s.oldTargetGroup == "" {
+		// Traffic hasn't shifted yet on the first fetch, so the old target group is the one with the most weight.
+		var max int
+		for _, w := range weights {
+			if s.oldTargetGroup == "" || w.Weight > max {
+				s.oldTargetGroup, max = w.ARN, w.Weight
+			}
+		}
+	}
+	var total, old int
+	for _, w := range weights {
+		total += w.Weight
+		if w.ARN == s.oldTargetGroup {
+			old = w.Weight
+		}
+	}
+	if total == 0 {
+		return
+	}
+	oldPercent := old * 100 / total
+	newPercent := 100 - oldPercent
+	for i := range deployments {
+		switch {
+		case len(deployments) == 1:
+			deployments[i].TrafficPercent = aws.Int(100)
+		case deployments[i].isPrimary():
+			deployments[i].TrafficPercent = aws.Int(newPercent)
+		case deployments[i].Status == ecsActiveDeploymentStatus:
+			deployments[i].TrafficPercent = aws.Int(oldPercent)
+		}
+	}
+}
+
 // Notify flushes all new events to the streamer's subscribers.
 func (s *ECSDeploymentStreamer) Notify() {
 	// Copy current list of subscribers over, so that we can we add more subscribers while
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +24,20 @@ func (m mockECS) Service(clusterName, serviceName string) (*ecs.Service, error)
 	return m.out, m.err
 }
 
+type mockTargetGroupWeights struct {
+	out [][]elbv2.TargetGroupWeight
+	err error
+}
+
+func (m *mockTargetGroupWeights) TargetGroupWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	out := m.out[0]
+	m.out = m.out[1:]
+	return out, nil
+}
+
 func TestECSDeploymentStreamer_Subscribe(t *testing.T) {
 	t.Run("allow new subscriptions if stack streamer is still active", func(t *testing.T) {
 		// GIVEN
@@ -354,6 +369,109 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only one event to flush")
 		require.Nil(t, streamer.eventsToFlush[0].LatestFailureEvents, "there should be no failed events emitted")
 	})
+	t.Run("returns a wrapped error if the traffic split cannot be fetched", func(t *testing.T) {
+		// GIVEN
+		streamer := NewECSDeploymentStreamer(mockECS{out: &ecs.Service{}}, "my-cluster", "my-svc", time.Now(),
+			WithTrafficSplit(&mockTargetGroupWeights{err: errors.New("some error")}, "my-rule"))
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch traffic split of listener rule my-rule: some error")
+	})
+	t.Run("stores the share of traffic served by each deployment as traffic shifts", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := mockECS{
+			out: &ecs.Service{
+				Deployments: []*awsecs.Deployment{
+					{
+						DesiredCount:   aws.Int64(10),
+						RunningCount:   aws.Int64(10),
+						Status:         aws.String("PRIMARY"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2"),
+						RolloutState:   aws.String("IN_PROGRESS"),
+						CreatedAt:      aws.Time(startDate),
+						UpdatedAt:      aws.Time(startDate),
+					},
+					{
+						DesiredCount:   aws.Int64(10),
+						RunningCount:   aws.Int64(10),
+						Status:         aws.String("ACTIVE"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
+						RolloutState:   aws.String("COMPLETED"),
+						CreatedAt:      aws.Time(startDate.Add(-time.Hour)),
+						UpdatedAt:      aws.Time(startDate.Add(-time.Hour)),
+					},
+				},
+			},
+		}
+		weights := &mockTargetGroupWeights{
+			out: [][]elbv2.TargetGroupWeight{
+				{
+					{ARN: "blue", Weight: 100},
+					{ARN: "green", Weight: 0},
+				},
+				{
+					{ARN: "blue", Weight: 90},
+					{ARN: "green", Weight: 10},
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", startDate, WithTrafficSplit(weights, "my-rule"))
+		streamer.clock = fakeClock{startDate}
+		streamer.rand = func(n int) int { return n }
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+		require.NoError(t, err)
+		_, _, err = streamer.Fetch()
+		require.NoError(t, err)
+
+		// THEN
+		require.Len(t, streamer.eventsToFlush, 2)
+		require.Equal(t, aws.Int(0), streamer.eventsToFlush[0].Deployments[0].TrafficPercent)
+		require.Equal(t, aws.Int(100), streamer.eventsToFlush[0].Deployments[1].TrafficPercent)
+		require.Equal(t, aws.Int(10), streamer.eventsToFlush[1].Deployments[0].TrafficPercent)
+		require.Equal(t, aws.Int(90), streamer.eventsToFlush[1].Deployments[1].TrafficPercent)
+	})
+	t.Run("the only deployment serves all the traffic", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := mockECS{
+			out: &ecs.Service{
+				Deployments: []*awsecs.Deployment{
+					{
+						DesiredCount:   aws.Int64(10),
+						RunningCount:   aws.Int64(10),
+						Status:         aws.String("PRIMARY"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2"),
+						RolloutState:   aws.String("COMPLETED"),
+						CreatedAt:      aws.Time(startDate),
+						UpdatedAt:      aws.Time(startDate),
+					},
+				},
+			},
+		}
+		weights := &mockTargetGroupWeights{
+			out: [][]elbv2.TargetGroupWeight{
+				{
+					{ARN: "blue", Weight: 0},
+					{ARN: "green", Weight: 100},
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", startDate, WithTrafficSplit(weights, "my-rule"))
+
+		// WHEN
+		_, done, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, done)
+		require.Equal(t, aws.Int(100), streamer.eventsToFlush[0].Deployments[0].TrafficPercent)
+	})
 }
 
 func TestECSDeploymentStreamer_Notify(t *testing.T) {
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+)
+
+// StateMachineExecutionDescriber is the interface to describe a state machine execution and its history.
+type StateMachineExecutionDescriber interface {
+	DescribeExecution(executionARN string) (*stepfunctions.Execution, error)
+	ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error)
+}
+
+// StateMachineEvent is a state transition of a state machine execution.
+type StateMachineEvent struct {
+	Type      string
+	Timestamp time.Time
+	StateName string // Name of the state that the execution was in when the event happened.
+	Attempt   int    // Number of times the task of the state was scheduled, greater than 1 for retries.
+	Error     string
+	Cause     string
+}
+
+// StateMachineExecution is a description of a state machine execution.
+type StateMachineExecution struct {
+	Status       string
+	Error        string
+	Cause        string
+	LatestEvents []StateMachineEvent // Events that happened since the previous description.
+	TaskARNs     []string            // ARNs of the ECS tasks started by the execution so far.
+}
+
+// IsDone returns true if the execution stopped.
+func (e StateMachineExecution) IsDone() bool {
+	return e.Status != "" && e.Status != sfn.ExecutionStatusRunning
+}
+
+// StateMachineExecutionStreamer is a Streamer for StateMachineExecution descriptions until the execution stops.
+type StateMachineExecutionStreamer struct {
+	client       StateMachineExecutionDescriber
+	clock        clock
+	rand         func(n int) int
+	executionARN string
+
+	subscribers   []chan StateMachineExecution
+	isDone        bool
+	lastEventID   int64
+	currentState  string
+	attempts      map[string]int
+	taskARNs      []string
+	eventsToFlush []StateMachineExecution
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewStateMachineExecutionStreamer creates a new StateMachineExecutionStreamer that streams descriptions
+// of the execution until it succeeds, fails, times out or is aborted.
+func NewStateMachineExecutionStreamer(client StateMachineExecutionDescriber, executionARN string) *StateMachineExecutionStreamer {
+	return &StateMachineExecutionStreamer{
+		client:       client,
+		clock:        realClock{},
+		rand:         rand.Intn,
+		executionARN: executionARN,
+		attempts:     make(map[string]int),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive execution descriptions from the StateMachineExecutionStreamer.
+func (s *StateMachineExecutionStreamer) Subscribe() <-chan StateMachineExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan StateMachineExecution)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the status and the new history events of the execution.
+// If an error occurs while describing the execution, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted and whether the execution stopped.
+func (s *StateMachineExecutionStreamer) Fetch() (next time.Time, done bool, err error) {
+	execution, err := s.client.DescribeExecution(s.executionARN)
+	if err != nil {
+		return s.throttledOrErr(fmt.Errorf("fetch execution description: %w", err))
+	}
+	history, err := s.client.ExecutionHistory(s.executionARN)
+	if err != nil {
+		return s.throttledOrErr(fmt.Errorf("fetch execution history: %w", err))
+	}
+	s.retries = 0
+	var events []StateMachineEvent
+	for _, event := range history {
+		if event.ID <= s.lastEventID {
+			continue
+		}
+		s.lastEventID = event.ID
+		events = append(events, s.stateMachineEvent(event))
+	}
+	desc := StateMachineExecution{
+		Status:       execution.Status,
+		Error:        execution.Error,
+		Cause:        execution.Cause,
+		LatestEvents: events,
+		TaskARNs:     append([]string(nil), s.taskARNs...),
+	}
+	s.eventsToFlush = append(s.eventsToFlush, desc)
+	return nextFetchDate(s.clock, s.rand, s.retries), desc.IsDone(), nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *StateMachineExecutionStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan StateMachineExecution
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *StateMachineExecutionStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+func (s *StateMachineExecutionStreamer) throttledOrErr(err error) (next time.Time, done bool, _ error) {
+	if request.IsErrorThrottle(err) {
+		s.retries += 1
+		return nextFetchDate(s.clock, s.rand, s.retries), false, nil
+	}
+	return next, false, err
+}
+
+// stateMachineEvent converts a history event and keeps track of the current state, its attempts and the started tasks.
+func (s *StateMachineExecutionStreamer) stateMachineEvent(in stepfunctions.HistoryEvent) StateMachineEvent {
+	if in.StateName != "" && in.Type != sfn.HistoryEventTypeTaskStateExited {
+		s.currentState = in.StateName
+	}
+	switch in.Type {
+	case sfn.HistoryEventTypeTaskScheduled:
+		s.attempts[s.currentState]++
+	case sfn.HistoryEventTypeTaskSubmitted:
+		s.taskARNs = append(s.taskARNs, submittedTaskARNs(in.Output)...)
+	}
+	return StateMachineEvent{
+		Type:      in.Type,
+		Timestamp: in.Timestamp,
+		StateName: s.currentState,
+		Attempt:   s.attempts[s.currentState],
+		Error:     in.Error,
+		Cause:     in.Cause,
+	}
+}
+
+// submittedTaskARNs returns the ARNs of the tasks in the output of an ECS RunTask call submitted by Step Functions.
+func submittedTaskARNs(output string) []string {
+	var resp struct {
+		Tasks []struct {
+			TaskArn string
+		}
+	}
+	if err := json.Unmarshal([]byte(output), &resp); err != nil {
+		return nil
+	}
+	var arns []string
+	for _, task := range resp.Tasks {
+		if task.TaskArn != "" {
+			arns = append(arns, task.TaskArn)
+		}
+	}
+	return arns
+}
@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStateMachine struct {
+	execution   *stepfunctions.Execution
+	history     []stepfunctions.HistoryEvent
+	describeErr error
+	historyErr  error
+}
+
+func (m *mockStateMachine) DescribeExecution(executionARN string) (*stepfunctions.Execution, error) {
+	return m.execution, m.describeErr
+}
+
+func (m *mockStateMachine) ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error) {
+	return m.history, m.historyErr
+}
+
+func TestStateMachineExecutionStreamer_Subscribe(t *testing.T) {
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &StateMachineExecutionStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestStateMachineExecutionStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on describe execution call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockStateMachine{
+			describeErr: errors.New("some error"),
+		}
+		streamer := NewStateMachineExecutionStreamer(m, "mockExecutionARN")
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch execution description: some error")
+	})
+	t.Run("returns a wrapped error on get execution history call failure", func(t *testing.T) {
+		// GIVEN
+		m := &mockStateMachine{
+			execution:  &stepfunctions.Execution{Status: sfn.ExecutionStatusRunning},
+			historyErr: errors.New("some error"),
+		}
+		streamer := NewStateMachineExecutionStreamer(m, "mockExecutionARN")
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch execution history: some error")
+	})
+	t.Run("stores only new events with retries and task ARNs until the execution stops", func(t *testing.T) {
+		// GIVEN
+		now := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := &mockStateMachine{
+			execution: &stepfunctions.Execution{Status: sfn.ExecutionStatusRunning},
+			history: []stepfunctions.HistoryEvent{
+				{ID: 1, Type: sfn.HistoryEventTypeExecutionStarted, Timestamp: now},
+				{ID: 2, Type: sfn.HistoryEventTypeTaskStateEntered, Timestamp: now, StateName: "Run Fargate Task"},
+				{ID: 3, Type: sfn.HistoryEventTypeTaskScheduled, Timestamp: now},
+				{ID: 4, Type: sfn.HistoryEventTypeTaskSubmitted, Timestamp: now, Output: `{"Tasks":[{"TaskArn":"arn:aws:ecs:us-west-2:1111:task/cluster/1"}]}`},
+			},
+		}
+		streamer := &StateMachineExecutionStreamer{
+			client:       m,
+			clock:        fakeClock{fakeNow: now},
+			rand:         func(n int) int { return n },
+			executionARN: "mockExecutionARN",
+			attempts:     make(map[string]int),
+		}
+
+		// WHEN
+		_, done, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.False(t, done)
+
+		// WHEN
+		m.execution = &stepfunctions.Execution{
+			Status: sfn.ExecutionStatusFailed,
+			Error:  "States.TaskFailed",
+			Cause:  "Essential container in task exited",
+		}
+		m.history = append(m.history,
+			stepfunctions.HistoryEvent{ID: 5, Type: sfn.HistoryEventTypeTaskFailed, Timestamp: now, Error: "States.TaskFailed"},
+			stepfunctions.HistoryEvent{ID: 6, Type: sfn.HistoryEventTypeTaskScheduled, Timestamp: now},
+			stepfunctions.HistoryEvent{ID: 7, Type: sfn.HistoryEventTypeExecutionFailed, Timestamp: now, Error: "States.TaskFailed"},
+		)
+		_, done, err = streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, done)
+		require.Equal(t, []StateMachineExecution{
+			{
+				Status: sfn.ExecutionStatusRunning,
+				LatestEvents: []StateMachineEvent{
+					{Type: sfn.HistoryEventTypeExecutionStarted, Timestamp: now},
+					{Type: sfn.HistoryEventTypeTaskStateEntered, Timestamp: now, StateName: "Run Fargate Task"},
+					{Type: sfn.HistoryEventTypeTaskScheduled, Timestamp: now, StateName: "Run Fargate Task", Attempt: 1},
+					{Type: sfn.HistoryEventTypeTaskSubmitted, Timestamp: now, StateName: "Run Fargate Task", Attempt: 1},
+				},
+				TaskARNs: []string{"arn:aws:ecs:us-west-2:1111:task/cluster/1"},
+			},
+			{
+				Status: sfn.ExecutionStatusFailed,
+				Error:  "States.TaskFailed",
+				Cause:  "Essential container in task exited",
+				LatestEvents: []StateMachineEvent{
+					{Type: sfn.HistoryEventTypeTaskFailed, Timestamp: now, StateName: "Run Fargate Task", Attempt: 1, Error: "States.TaskFailed"},
+					{Type: sfn.HistoryEventTypeTaskScheduled, Timestamp: now, StateName: "Run Fargate Task", Attempt: 2},
+					{Type: sfn.HistoryEventTypeExecutionFailed, Timestamp: now, StateName: "Run Fargate Task", Attempt: 2, Error: "States.TaskFailed"},
+				},
+				TaskARNs: []string{"arn:aws:ecs:us-west-2:1111:task/cluster/1"},
+			},
+		}, streamer.eventsToFlush)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -20,8 +22,18 @@ const (
 	s3ScriptsDirName            = "scripts"
 	s3CustomResourcesDirName    = "custom-resources"
 	s3EnvironmentsAddonsDirName = "environments"
+	s3DeploymentsDirName        = "deployments"
+	s3PipelinesDirName          = "pipelines"
+	s3StaticSitesDirName        = "static-sites"
 )
 
+// IsReserved returns true if the slash-separated key is the directory under which Copilot stores its artifacts,
+// or is nested under it.
+func IsReserved(key string) bool {
+	key = strings.TrimPrefix(path.Clean("/"+key), "/")
+	return key == s3ArtifactDirName || strings.HasPrefix(key, s3ArtifactDirName+"/")
+}
+
 // MkdirSHA256 prefixes the key with the SHA256 hash of the contents of "manual/<hash>/key".
 func MkdirSHA256(key string, content []byte) string {
 	return path.Join(s3ArtifactDirName, fmt.Sprintf("%x", sha256.Sum256(content)), key)
@@ -69,3 +81,28 @@ func EnvFiles(key string, content []byte) string {
 func CustomResource(key string, zipFile []byte) string {
 	return path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, key, fmt.Sprintf("%x.zip", sha256.Sum256(zipFile)))
 }
+
+// Deployments returns the path under which the deployment revisions of a workload are stored.
+// Example: manual/deployments/test/frontend/.
+func Deployments(env, workload string) string {
+	return path.Join(s3ArtifactDirName, s3DeploymentsDirName, env, workload) + "/"
+}
+
+// Deployment returns the path to store the record of a deployment revision of a workload.
+// Example: manual/deployments/test/frontend/3.json.
+func Deployment(env, workload string, revision int) string {
+	return path.Join(Deployments(env, workload), strconv.Itoa(revision)+".json")
+}
+
+// PipelineWorkspace returns the path to store the packaged workspace of a pipeline.
+// The path doesn't change across deployments so that the source action of the pipeline can refer to it.
+// Example: manual/pipelines/my-pipeline/workspace.zip.
+func PipelineWorkspace(pipeline string) string {
+	return path.Join(s3ArtifactDirName, s3PipelinesDirName, pipeline, "workspace.zip")
+}
+
+// StaticSiteMetadata returns the path to store the record of the metadata of the files synced for a static site.
+// Example: manual/static-sites/test/frontend/metadata.json.
+func StaticSiteMetadata(env, workload string) string {
+	return path.Join(s3ArtifactDirName, s3StaticSitesDirName, env, workload, "metadata.json")
+}
@@ -20,3 +20,28 @@ func TestEnvironmentAddons(t *testing.T) {
 func TestEnvironmentAddonsAsset(t *testing.T) {
 	require.Equal(t, "manual/addons/environments/assets/hash", EnvironmentAddonAsset("hash"))
 }
+
+func TestDeployments(t *testing.T) {
+	require.Equal(t, "manual/deployments/test/frontend/", Deployments("test", "frontend"))
+}
+
+func TestDeployment(t *testing.T) {
+	require.Equal(t, "manual/deployments/test/frontend/3.json", Deployment("test", "frontend", 3))
+}
+
+func TestPipelineWorkspace(t *testing.T) {
+	require.Equal(t, "manual/pipelines/my-pipeline/workspace.zip", PipelineWorkspace("my-pipeline"))
+}
+
+func TestStaticSiteMetadata(t *testing.T) {
+	require.Equal(t, "manual/static-sites/test/frontend/metadata.json", StaticSiteMetadata("test", "frontend"))
+}
+
+func TestIsReserved(t *testing.T) {
+	require.True(t, IsReserved("manual"))
+	require.True(t, IsReserved("./manual/addons/"))
+	require.True(t, IsReserved("/manual/deployments"))
+	require.False(t, IsReserved("manuals"))
+	require.False(t, IsReserved("static/manual"))
+	require.False(t, IsReserved(""))
+}
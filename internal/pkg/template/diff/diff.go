@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff provides functionality to compare two CloudFormation templates written in YAML.
+package diff
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tree represents a difference tree between two YAML documents.
+type Tree struct {
+	root *node
+}
+
+// node is a single difference in a Tree.
+// A node with children is a mapping or a sequence that has differences nested under it.
+// A leaf node is an insertion if old is nil, a deletion if new is nil, and a modification otherwise.
+type node struct {
+	key      string
+	old      *yaml.Node
+	new      *yaml.Node
+	children []*node
+}
+
+// From is the YAML document that another YAML document is compared against.
+type From []byte
+
+// Parse constructs a diff tree that represents the differences of the YAML document "to" against the "from" document.
+// CloudFormation intrinsic functions written in their short form, such as "!Ref", are treated as equivalent
+// to their full form, such as "Ref:", so that a change in notation alone is not reported as a difference.
+func (from From) Parse(to []byte) (Tree, error) {
+	fromNode, err := unmarshal(from)
+	if err != nil {
+		return Tree{}, fmt.Errorf("unmarshal old template: %w", err)
+	}
+	toNode, err := unmarshal(to)
+	if err != nil {
+		return Tree{}, fmt.Errorf("unmarshal new template: %w", err)
+	}
+	// Compare against an empty document if one of the templates is missing, such as when a stack is not deployed yet.
+	switch {
+	case fromNode == nil && toNode != nil:
+		fromNode = &yaml.Node{Kind: toNode.Kind}
+	case toNode == nil && fromNode != nil:
+		toNode = &yaml.Node{Kind: fromNode.Kind}
+	}
+	return Tree{
+		root: parse("", fromNode, toNode),
+	}, nil
+}
+
+// IsEmpty returns true if there are no differences between the two documents.
+func (t Tree) IsEmpty() bool {
+	return t.root == nil
+}
+
+func unmarshal(doc []byte) (*yaml.Node, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(doc, &root); err != nil {
+		return nil, err
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		return nil, nil
+	}
+	return normalizeIntrinsics(root.Content[0]), nil
+}
+
+// parse returns the difference between the old and new nodes under key, or nil if they are equal.
+func parse(key string, old, new *yaml.Node) *node {
+	old, new = resolveAlias(old), resolveAlias(new)
+	if isEqual(old, new) {
+		return nil
+	}
+	if old == nil || new == nil || old.Kind != new.Kind {
+		return &node{key: key, old: old, new: new}
+	}
+	var children []*node
+	switch new.Kind {
+	case yaml.MappingNode:
+		children = parseMap(old, new)
+	case yaml.SequenceNode:
+		children = parseSeq(old, new)
+	default:
+		return &node{key: key, old: old, new: new}
+	}
+	return &node{key: key, children: children}
+}
+
+func parseMap(old, new *yaml.Node) []*node {
+	var children []*node
+	oldValues := make(map[string]*yaml.Node)
+	for i := 0; i < len(old.Content)-1; i += 2 {
+		oldValues[old.Content[i].Value] = old.Content[i+1]
+	}
+	seen := make(map[string]bool)
+	for i := 0; i < len(new.Content)-1; i += 2 {
+		key := new.Content[i].Value
+		seen[key] = true
+		if diff := parse(key, oldValues[key], new.Content[i+1]); diff != nil {
+			children = append(children, diff)
+		}
+	}
+	for i := 0; i < len(old.Content)-1; i += 2 {
+		key := old.Content[i].Value
+		if seen[key] {
+			continue
+		}
+		children = append(children, &node{key: key, old: old.Content[i+1]})
+	}
+	return children
+}
+
+// parseSeq matches the longest common subsequence of equal items between the old and new sequences.
+// Unmatched items between two matches are compared pairwise, and any remaining items are insertions or deletions.
+func parseSeq(old, new *yaml.Node) []*node {
+	oldItems, newItems := old.Content, new.Content
+	lcs := make([][]int, len(oldItems)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newItems)+1)
+	}
+	for i := len(oldItems) - 1; i >= 0; i-- {
+		for j := len(newItems) - 1; j >= 0; j-- {
+			switch {
+			case isEqual(resolveAlias(oldItems[i]), resolveAlias(newItems[j])):
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var children []*node
+	var unmatchedOld, unmatchedNew []int
+	flush := func() {
+		for k := 0; k < len(unmatchedOld) || k < len(unmatchedNew); k++ {
+			switch {
+			case k >= len(unmatchedOld):
+				children = append(children, &node{key: seqKey(unmatchedNew[k]), new: newItems[unmatchedNew[k]]})
+			case k >= len(unmatchedNew):
+				children = append(children, &node{key: seqKey(unmatchedOld[k]), old: oldItems[unmatchedOld[k]]})
+			default:
+				if diff := parse(seqKey(unmatchedNew[k]), oldItems[unmatchedOld[k]], newItems[unmatchedNew[k]]); diff != nil {
+					children = append(children, diff)
+				}
+			}
+		}
+		unmatchedOld, unmatchedNew = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(oldItems) && j < len(newItems) {
+		switch {
+		case isEqual(resolveAlias(oldItems[i]), resolveAlias(newItems[j])):
+			flush()
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			unmatchedOld = append(unmatchedOld, i)
+			i++
+		default:
+			unmatchedNew = append(unmatchedNew, j)
+			j++
+		}
+	}
+	for ; i < len(oldItems); i++ {
+		unmatchedOld = append(unmatchedOld, i)
+	}
+	for ; j < len(newItems); j++ {
+		unmatchedNew = append(unmatchedNew, j)
+	}
+	flush()
+	return children
+}
+
+func seqKey(idx int) string {
+	return fmt.Sprintf("[%d]", idx)
+}
+
+// isEqual returns true if the two nodes are semantically equal.
+// Scalars are compared by their values, mappings regardless of the order of their keys, and sequences item by item.
+func isEqual(a, b *yaml.Node) bool {
+	a, b = resolveAlias(a), resolveAlias(b)
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Kind != b.Kind {
+		return false
+	}
+	switch a.Kind {
+	case yaml.ScalarNode:
+		return a.Value == b.Value
+	case yaml.SequenceNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := range a.Content {
+			if !isEqual(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	case yaml.MappingNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		bValues := make(map[string]*yaml.Node)
+		for i := 0; i < len(b.Content)-1; i += 2 {
+			bValues[b.Content[i].Value] = b.Content[i+1]
+		}
+		for i := 0; i < len(a.Content)-1; i += 2 {
+			bValue, ok := bValues[a.Content[i].Value]
+			if !ok || !isEqual(a.Content[i+1], bValue) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
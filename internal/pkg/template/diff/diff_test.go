@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrom_Parse(t *testing.T) {
+	testCases := map[string]struct {
+		old         string
+		new         string
+		wanted      string
+		wantedEmpty bool
+		wantedError string
+	}{
+		"no differences when only the order of keys changes": {
+			old: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders`,
+			new: `
+Resources:
+  Queue:
+    Properties:
+      QueueName: orders
+    Type: AWS::SQS::Queue`,
+			wantedEmpty: true,
+		},
+		"no differences between the short and full form of intrinsic functions": {
+			old: `
+Outputs:
+  Arn:
+    Value: !GetAtt Queue.Arn
+  Name:
+    Value: !Ref Queue
+  URL:
+    Value: !Sub 'https://${Queue}'
+  Joined:
+    Value: !Join ['', [!Ref Queue, '-dlq']]`,
+			new: `
+Outputs:
+  Arn:
+    Value:
+      Fn::GetAtt: [Queue, Arn]
+  Name:
+    Value:
+      Ref: Queue
+  URL:
+    Value:
+      Fn::Sub: 'https://${Queue}'
+  Joined:
+    Value:
+      Fn::Join:
+        - ''
+        - - Ref: Queue
+          - '-dlq'`,
+			wantedEmpty: true,
+		},
+		"reports insertions, deletions and modifications of scalars": {
+			old: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: orders
+      DelaySeconds: 5`,
+			new: `
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: payments
+      VisibilityTimeout: 30`,
+			wanted: `~ Resources:
+    ~ Queue:
+        ~ Properties:
+            ~ QueueName: orders -> payments
+            + VisibilityTimeout: 30
+            - DelaySeconds: 5
+`,
+		},
+		"reports changes to intrinsic functions in their full form": {
+			old: `
+Value: !GetAtt Queue.Arn`,
+			new: `
+Value: !GetAtt DeadLetterQueue.Arn`,
+			wanted: `~ Value:
+    ~ Fn::GetAtt:
+        ~ [0]: Queue -> DeadLetterQueue
+`,
+		},
+		"reports inserted and removed items in a sequence": {
+			old: `
+Tags:
+  - Key: app
+    Value: demo
+  - Key: env
+    Value: test`,
+			new: `
+Tags:
+  - Key: owner
+    Value: me
+  - Key: app
+    Value: demo`,
+			wanted: `~ Tags:
+    + [0]:
+        Key: owner
+        Value: me
+    - [1]:
+        Key: env
+        Value: test
+`,
+		},
+		"reports modified items in a sequence": {
+			old: `
+Ports: [80, 443]`,
+			new: `
+Ports: [8080, 443]`,
+			wanted: `~ Ports:
+    ~ [0]: 80 -> 8080
+`,
+		},
+		"reports a change in kind": {
+			old: `
+Value: hello`,
+			new: `
+Value:
+  Ref: Hello`,
+			wanted: `~ Value:
+    - (old): hello
+    + (new):
+        Ref: Hello
+`,
+		},
+		"reports every top-level key as an insertion when the old document is empty": {
+			new: `
+Parameters:
+  AppName:
+    Type: String
+Resources: {}`,
+			wanted: `+ Parameters:
+    AppName:
+      Type: String
++ Resources: {}
+`,
+		},
+		"returns an error on malformed YAML": {
+			old:         "Resources: [",
+			wantedError: "unmarshal old template: yaml: line 1: did not find expected node content",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			tree, err := From(tc.old).Parse([]byte(tc.new))
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEmpty, tree.IsEmpty())
+			if tc.wantedEmpty {
+				return
+			}
+			buf := new(strings.Builder)
+			require.NoError(t, tree.Write(buf))
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const getAttFnName = "Fn::GetAtt"
+
+// intrinsicFnNames maps the short form tag of a CloudFormation intrinsic function to its full form key.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/intrinsic-function-reference.html.
+var intrinsicFnNames = map[string]string{
+	"!Ref":         "Ref",
+	"!Condition":   "Condition",
+	"!Base64":      "Fn::Base64",
+	"!Cidr":        "Fn::Cidr",
+	"!FindInMap":   "Fn::FindInMap",
+	"!GetAtt":      getAttFnName,
+	"!GetAZs":      "Fn::GetAZs",
+	"!ImportValue": "Fn::ImportValue",
+	"!Join":        "Fn::Join",
+	"!Select":      "Fn::Select",
+	"!Split":       "Fn::Split",
+	"!Sub":         "Fn::Sub",
+	"!Transform":   "Fn::Transform",
+	"!And":         "Fn::And",
+	"!Equals":      "Fn::Equals",
+	"!If":          "Fn::If",
+	"!Not":         "Fn::Not",
+	"!Or":          "Fn::Or",
+}
+
+// normalizeIntrinsics rewrites, in place, every intrinsic function under n from its short form to its full form.
+// For example, "!Ref Foo" becomes "Ref: Foo", and "!GetAtt Foo.Bar" becomes "Fn::GetAtt: [Foo, Bar]".
+func normalizeIntrinsics(n *yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	for i, child := range n.Content {
+		n.Content[i] = normalizeIntrinsics(child)
+	}
+	if n.Kind == yaml.MappingNode && len(n.Content) == 2 && n.Content[0].Value == getAttFnName {
+		n.Content[1] = splitGetAtt(n.Content[1])
+	}
+	name, ok := intrinsicFnNames[n.Tag]
+	if !ok {
+		return n
+	}
+	value := *n
+	value.Tag = defaultTag(n.Kind)
+	value.Style &^= yaml.TaggedStyle
+	var fnValue = &value
+	if name == getAttFnName {
+		fnValue = splitGetAtt(fnValue)
+	}
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			{
+				Kind:  yaml.ScalarNode,
+				Tag:   "!!str",
+				Value: name,
+			},
+			fnValue,
+		},
+	}
+}
+
+// splitGetAtt transforms the "LogicalID.Attribute" scalar form of Fn::GetAtt to the [LogicalID, Attribute] sequence form.
+func splitGetAtt(n *yaml.Node) *yaml.Node {
+	if n.Kind != yaml.ScalarNode {
+		return n
+	}
+	parts := strings.SplitN(n.Value, ".", 2)
+	if len(parts) != 2 {
+		return n
+	}
+	return &yaml.Node{
+		Kind:  yaml.SequenceNode,
+		Tag:   "!!seq",
+		Style: yaml.FlowStyle,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: parts[0]},
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: parts[1]},
+		},
+	}
+}
+
+func defaultTag(kind yaml.Kind) string {
+	switch kind {
+	case yaml.MappingNode:
+		return "!!map"
+	case yaml.SequenceNode:
+		return "!!seq"
+	default:
+		return "!!str"
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+const indentInc = 4
+
+// Write writes the string representation of the diff tree to w.
+// Insertions are prefixed with "+", deletions with "-", and modifications with "~".
+func (t Tree) Write(w io.Writer) error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.key == "" && t.root.children != nil {
+		for _, child := range t.root.children {
+			if err := write(w, child, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return write(w, t.root, 0)
+}
+
+func write(w io.Writer, n *node, indent int) error {
+	pad := strings.Repeat(" ", indent)
+	switch {
+	case n.children != nil:
+		if _, err := fmt.Fprintf(w, "%s%s\n", pad, color.Yellow.Sprintf("~ %s:", n.key)); err != nil {
+			return err
+		}
+		for _, child := range n.children {
+			if err := write(w, child, indent+indentInc); err != nil {
+				return err
+			}
+		}
+		return nil
+	case n.old == nil:
+		return writeValue(w, pad, color.Green.Sprintf("+ %s:", n.key), n.new, color.Green.Sprint)
+	case n.new == nil:
+		return writeValue(w, pad, color.Red.Sprintf("- %s:", n.key), n.old, color.Red.Sprint)
+	}
+	oldValue, err := marshal(n.old)
+	if err != nil {
+		return err
+	}
+	newValue, err := marshal(n.new)
+	if err != nil {
+		return err
+	}
+	isScalar := n.old.Kind == yaml.ScalarNode && n.new.Kind == yaml.ScalarNode
+	if isScalar && !strings.Contains(oldValue, "\n") && !strings.Contains(newValue, "\n") {
+		_, err := fmt.Fprintf(w, "%s%s %s -> %s\n", pad, color.Yellow.Sprintf("~ %s:", n.key), oldValue, newValue)
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%s%s\n", pad, color.Yellow.Sprintf("~ %s:", n.key)); err != nil {
+		return err
+	}
+	pad = strings.Repeat(" ", indent+indentInc)
+	if err := writeValue(w, pad, color.Red.Sprint("- (old):"), n.old, color.Red.Sprint); err != nil {
+		return err
+	}
+	return writeValue(w, pad, color.Green.Sprint("+ (new):"), n.new, color.Green.Sprint)
+}
+
+// writeValue writes the header followed by the value, inline if the value fits on a single line.
+func writeValue(w io.Writer, pad, header string, value *yaml.Node, colorize func(a ...interface{}) string) error {
+	out, err := marshal(value)
+	if err != nil {
+		return err
+	}
+	if value.Kind == yaml.ScalarNode && !strings.Contains(out, "\n") || isFlow(value) {
+		_, err := fmt.Fprintf(w, "%s%s %s\n", pad, header, colorize(out))
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%s%s\n", pad, header); err != nil {
+		return err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if _, err := fmt.Fprintf(w, "%s%s%s\n", pad, strings.Repeat(" ", indentInc), colorize(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshal(n *yaml.Node) (string, error) {
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(n); err != nil {
+		return "", fmt.Errorf("marshal YAML node: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("marshal YAML node: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func isFlow(n *yaml.Node) bool {
+	return n.Style&yaml.FlowStyle != 0
+}
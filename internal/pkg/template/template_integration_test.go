@@ -343,6 +343,29 @@ DiscoveryServiceArn:
 				EnvVersion:      "v1.42.0",
 			},
 		},
+		"renders a valid template with canary deployments": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				DeploymentConfiguration: template.DeploymentConfigurationOpts{
+					MinHealthyPercent: 100,
+					MaxPercent:        200,
+					Strategy:          "CANARY",
+					TrafficShift: &template.TrafficShiftOpts{
+						Percent:           10,
+						IntervalInMinutes: 5,
+					},
+					BakeTimeInMinutes: aws.Int(10),
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+				EnvVersion:      "v1.42.0",
+			},
+		},
 		"renders a valid template with Windows platform": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
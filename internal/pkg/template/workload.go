@@ -108,6 +108,7 @@ var (
 		"nlb",
 		"vpc-connector",
 		"alb",
+		"target-group",
 		"rollback-alarms",
 	}
 
@@ -529,6 +530,22 @@ type DeploymentConfigurationOpts struct {
 	// The upper limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
 	MaxPercent int
 	Rollback   RollingUpdateRollbackConfig
+
+	// Traffic shifting configuration for services behind an Application Load Balancer.
+	Strategy          string            // Either "BLUE_GREEN", "CANARY" or "LINEAR", empty for rolling deployments.
+	TrafficShift      *TrafficShiftOpts // Steps of a "CANARY" or "LINEAR" deployment.
+	BakeTimeInMinutes *int              // Time to keep the old tasks after all traffic moved to the new tasks.
+}
+
+// TrafficShiftOpts holds configuration for moving traffic to the new tasks in steps.
+type TrafficShiftOpts struct {
+	Percent           int // Percentage of traffic moved at each step.
+	IntervalInMinutes int // Time to wait between steps.
+}
+
+// IsTrafficShifted returns true if deployments move traffic between two target groups instead of rolling tasks.
+func (cfg DeploymentConfigurationOpts) IsTrafficShifted() bool {
+	return cfg.Strategy != ""
 }
 
 // RollingUpdateRollbackConfig holds config for rollback alarms.
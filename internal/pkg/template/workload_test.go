@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -65,6 +66,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/nlb.yml", []byte("nlb"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/vpc-connector.yml", []byte("vpc-connector"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb.yml", []byte("alb"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/target-group.yml", []byte("target-group"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/rollback-alarms.yml", []byte("rollback-alarms"), 0644)
 
 				return fs
@@ -101,6 +103,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   nlb
   vpc-connector
   alb
+  target-group
   rollback-alarms
 `,
 		},
@@ -479,3 +482,66 @@ func TestRollingUpdateRollbackConfig_TruncateAlarmName(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseLoadBalancedWebService_TrafficShift(t *testing.T) {
+	type listenerRule struct {
+		Properties struct {
+			Actions []map[string]interface{} `yaml:"Actions"`
+		} `yaml:"Properties"`
+	}
+	type cfn struct {
+		Resources map[string]listenerRule `yaml:"Resources"`
+	}
+	testCases := map[string]struct {
+		httpsListener bool
+		strategy      string
+
+		wantedRule string
+	}{
+		"canary deployments behind an HTTP listener": {
+			strategy:   "CANARY",
+			wantedRule: "HTTPListenerRule",
+		},
+		"blue/green deployments behind an HTTPS listener": {
+			httpsListener: true,
+			strategy:      "BLUE_GREEN",
+			wantedRule:    "HTTPSListenerRule",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			render := func(version string) listenerRule {
+				content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+					HTTPSListener: tc.httpsListener,
+					ALBEnabled:    true,
+					Variables: map[string]Variable{
+						"VERSION": PlainVariable(version),
+					},
+					DeploymentConfiguration: DeploymentConfigurationOpts{
+						Strategy: tc.strategy,
+					},
+				})
+				require.NoError(t, err)
+				var actual cfn
+				require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+				rule, ok := actual.Resources[tc.wantedRule]
+				require.True(t, ok, "listener rule %s must be rendered", tc.wantedRule)
+				return rule
+			}
+
+			// WHEN
+			first := render("v1")
+			second := render("v2") // Deploy again after ECS shifted the traffic of the first deployment.
+
+			// THEN
+			// The listener rule must only forward to the primary target group so that CloudFormation
+			// doesn't reset the weights that ECS sets on the rule during a deployment.
+			require.Len(t, first.Properties.Actions, 1)
+			require.Equal(t, "forward", first.Properties.Actions[0]["Type"])
+			require.NotContains(t, first.Properties.Actions[0], "ForwardConfig")
+			require.Equal(t, first, second)
+		})
+	}
+}
@@ -17,6 +17,14 @@ var (
 	OutputWriter     = color.Output
 )
 
+// WriterOrDiagnostic returns w, or the DiagnosticWriter if w is nil.
+func WriterOrDiagnostic(w io.Writer) io.Writer {
+	if w == nil {
+		return DiagnosticWriter
+	}
+	return w
+}
+
 // Colored string formatting functions.
 var (
 	successSprintf = color.HiGreenString
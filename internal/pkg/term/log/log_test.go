@@ -11,6 +11,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestWriterOrDiagnostic(t *testing.T) {
+	// GIVEN
+	diagnostic := &strings.Builder{}
+	DiagnosticWriter = diagnostic
+	w := &strings.Builder{}
+
+	// WHEN
+	fmt.Fprint(WriterOrDiagnostic(w), "hello")
+	fmt.Fprint(WriterOrDiagnostic(nil), "world")
+
+	// THEN
+	require.Equal(t, "hello", w.String())
+	require.Equal(t, "world", diagnostic.String())
+}
+
 func TestSuccess(t *testing.T) {
 	// GIVEN
 	b := &strings.Builder{}
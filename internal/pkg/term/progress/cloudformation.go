@@ -38,6 +38,10 @@ type ECSServiceRendererOpts struct {
 	Group      *errgroup.Group
 	Ctx        context.Context
 	RenderOpts RenderOptions
+
+	// Listener rule that shifts traffic between deployments, to render the share of traffic served by each deployment.
+	TargetGroupWeights stream.TargetGroupWeightDescriber
+	ListenerRuleARN    string
 }
 
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
@@ -94,9 +98,11 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 		ecsDescriber: ecsDescriber,
 		logicalID:    logicalID,
 
-		group:      g,
-		ctx:        ctx,
-		renderOpts: opts.RenderOpts,
+		group:              g,
+		ctx:                ctx,
+		renderOpts:         opts.RenderOpts,
+		targetGroupWeights: opts.TargetGroupWeights,
+		listenerRuleARN:    opts.ListenerRuleARN,
 		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
 			RenderOpts: opts.RenderOpts,
 		}),
@@ -313,9 +319,11 @@ type ecsServiceResourceComponent struct {
 	logicalID    string                     // LogicalID for the service.
 
 	// Optional inputs.
-	group      *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
-	ctx        context.Context // Context for the ECSDeploymentStreamer.
-	renderOpts RenderOptions
+	group              *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
+	ctx                context.Context // Context for the ECSDeploymentStreamer.
+	renderOpts         RenderOptions
+	targetGroupWeights stream.TargetGroupWeightDescriber // Client needed to stream the traffic split of the deployments.
+	listenerRuleARN    string
 
 	// Sub-components.
 	resourceRenderer   DynamicRenderer
@@ -398,7 +406,11 @@ func (c *ecsServiceResourceComponent) Done() <-chan struct{} {
 
 func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceARN string, startTime time.Time) DynamicRenderer {
 	cluster, service := parseServiceARN(serviceARN)
-	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime)
+	var opts []stream.ECSDeploymentStreamerOpt
+	if c.targetGroupWeights != nil && c.listenerRuleARN != "" {
+		opts = append(opts, stream.WithTrafficSplit(c.targetGroupWeights, c.listenerRuleARN))
+	}
+	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime, opts...)
 	renderer := ListeningRollingUpdateRenderer(streamer, NestedRenderOptions(c.renderOpts))
 	c.group.Go(func() error {
 		return stream.Stream(c.ctx, streamer)
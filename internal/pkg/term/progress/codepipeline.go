@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	cp "github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// PipelineExecutionSubscriber is the interface to subscribe channels to pipeline execution descriptions.
+type PipelineExecutionSubscriber interface {
+	Subscribe() <-chan stream.PipelineExecution
+}
+
+// ListeningPipelineExecutionRenderer renders the status of a pipeline execution and a lane for each of its stages.
+func ListeningPipelineExecutionRenderer(streamer PipelineExecutionSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &pipelineExecutionComponent{
+		padding: opts.Padding,
+		stream:  streamer.Subscribe(),
+		done:    make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type pipelineExecutionComponent struct {
+	// Data to render.
+	status  string
+	summary string
+	stages  []*lane
+
+	// Style configuration for the component.
+	padding int
+
+	stream <-chan stream.PipelineExecution // Channel where execution descriptions are received.
+	done   chan struct{}                   // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                      // Lock used to mutate data to render.
+}
+
+// Listen updates the execution status and the lanes of the stages as descriptions are streamed.
+func (c *pipelineExecutionComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.status = ev.Status
+		c.summary = ev.StatusSummary
+		for _, stage := range ev.StageStates {
+			updateStageLane(c.stage(stage.StageName), stage)
+		}
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the execution status, the lanes of the stages and the status summary if the execution failed.
+func (c *pipelineExecutionComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var components []Renderer
+	if c.status != "" {
+		components = append(components, &singleLineComponent{
+			Text:    fmt.Sprintf("%s [%s]", color.Faint.Sprintf("Execution"), splitCamelCase(c.status)),
+			Padding: c.padding,
+		})
+	}
+	for _, stage := range c.stages {
+		components = append(components, stage.components(c.padding)...)
+	}
+	components = append(components, c.failureSummary()...)
+
+	buf := new(bytes.Buffer)
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, fmt.Errorf("render pipeline execution component: %w", err)
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render pipeline execution component to writer: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *pipelineExecutionComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+// stage returns the lane of a stage, and creates it if this is the first time the stage is seen.
+func (c *pipelineExecutionComponent) stage(name string) *lane {
+	for _, l := range c.stages {
+		if l.name == name {
+			return l
+		}
+	}
+	l := &lane{
+		name:      name,
+		status:    laneStatusWaiting,
+		stopWatch: newStopWatch(),
+	}
+	c.stages = append(c.stages, l)
+	return l
+}
+
+func (c *pipelineExecutionComponent) failureSummary() []Renderer {
+	if c.summary == "" || c.status == cp.PipelineExecutionStatusSucceeded {
+		return nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the summary.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Status summary")),
+			Padding: c.padding,
+		},
+	}
+	for _, line := range splitByLength(c.summary, maxCellLength) {
+		components = append(components, &singleLineComponent{
+			Text:    line,
+			Padding: c.padding + nestedComponentPadding,
+		})
+	}
+	return components
+}
+
+// updateStageLane sets the status of the lane to the aggregate status of the stage's actions.
+// The stopwatch of the lane only runs while the stage is observed in progress.
+func updateStageLane(l *lane, stage codepipeline.StageState) {
+	l.reason = ""
+	switch stage.AggregateStatus() {
+	case cp.StageExecutionStatusInProgress:
+		if l.status != laneStatusInProgress {
+			// The stage might be retried after it failed.
+			l.stopWatch.reset()
+			l.stopWatch.start()
+		}
+		l.status = laneStatusInProgress
+		if action, ok := stage.PendingApproval(); ok {
+			l.reason = fmt.Sprintf("waiting for approval of %s", action.Name)
+		}
+	case cp.StageExecutionStatusSucceeded:
+		l.status = laneStatusSucceeded
+		l.stopWatch.stop()
+	case cp.StageExecutionStatusFailed:
+		l.status = laneStatusFailed
+		var failed []string
+		for _, action := range stage.Actions {
+			if action.Status == cp.ActionExecutionStatusFailed || action.Status == cp.ActionExecutionStatusAbandoned {
+				failed = append(failed, action.Name)
+			}
+		}
+		l.reason = fmt.Sprintf("%s failed", strings.Join(failed, ", "))
+		l.stopWatch.stop()
+	default:
+		l.status = laneStatusWaiting
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineExecutionComponent_Listen(t *testing.T) {
+	t.Run("should update the status of the execution and of each stage to the latest event", func(t *testing.T) {
+		// GIVEN
+		events := make(chan stream.PipelineExecution)
+		done := make(chan struct{})
+		c := &pipelineExecutionComponent{
+			stream: events,
+			done:   done,
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			events <- stream.PipelineExecution{
+				Status: "InProgress",
+				StageStates: []codepipeline.StageState{
+					{StageName: "Source", Actions: []codepipeline.StageAction{{Name: "SourceCodeFor-app", Status: "InProgress"}}},
+					{StageName: "DeployTo-test"},
+					{StageName: "DeployTo-prod"},
+				},
+			}
+			events <- stream.PipelineExecution{
+				Status: "InProgress",
+				StageStates: []codepipeline.StageState{
+					{StageName: "Source", Actions: []codepipeline.StageAction{{Name: "SourceCodeFor-app", Status: "Succeeded"}}},
+					{StageName: "DeployTo-test", Actions: []codepipeline.StageAction{
+						{Name: "CreateOrUpdate-api-test", Status: "Succeeded"},
+						{Name: "TestCommands", Status: "Failed"},
+					}},
+					{StageName: "DeployTo-prod", Actions: []codepipeline.StageAction{
+						{Name: "ApprovePromotionTo-prod", Status: "InProgress", ApprovalToken: "token"},
+					}},
+				},
+			}
+			close(events)
+		}()
+
+		// THEN
+		<-done // Listen should have closed the channel.
+		require.Equal(t, "InProgress", c.status)
+		require.Len(t, c.stages, 3)
+		require.Equal(t, laneStatusSucceeded, c.stages[0].status)
+		require.Equal(t, laneStatusFailed, c.stages[1].status)
+		require.Equal(t, "TestCommands failed", c.stages[1].reason)
+		require.Equal(t, laneStatusInProgress, c.stages[2].status)
+		require.Equal(t, "waiting for approval of ApprovePromotionTo-prod", c.stages[2].reason)
+	})
+}
+
+func TestPipelineExecutionComponent_Render(t *testing.T) {
+	newStage := func(name, status, reason string) *lane {
+		l := &lane{
+			name:   name,
+			status: status,
+			reason: reason,
+			stopWatch: &stopWatch{
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate, testDate.Add(10 * time.Second)},
+				},
+			},
+		}
+		if status != laneStatusWaiting {
+			l.stopWatch.start()
+		}
+		if status == laneStatusSucceeded || status == laneStatusFailed {
+			l.stopWatch.stop()
+		}
+		return l
+	}
+	testCases := map[string]struct {
+		inStatus  string
+		inSummary string
+		inStages  func() []*lane
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render nothing before the first description": {
+			inStages: func() []*lane { return nil },
+
+			wantedNumLines: 0,
+			wantedOut:      "",
+		},
+		"should render the status of the execution and a lane for each stage": {
+			inStatus: "InProgress",
+			inStages: func() []*lane {
+				return []*lane{
+					newStage("Source", laneStatusSucceeded, ""),
+					newStage("DeployTo-test", laneStatusInProgress, "waiting for approval of ApprovePromotionTo-test"),
+					newStage("DeployTo-prod", laneStatusWaiting, ""),
+				}
+			},
+
+			wantedNumLines: 5,
+			wantedOut: "Execution [in progress]\n" +
+				"- Source\t[complete]\t[10.0s]\n" +
+				"- DeployTo-test\t[in progress]\t[10.0s]\n" +
+				"  waiting for approval of ApprovePromotionTo-test\t\t\n" +
+				"- DeployTo-prod\t[waiting]\t\n",
+		},
+		"should render the status summary if the execution failed": {
+			inStatus:  "Failed",
+			inSummary: "Rejected by admin",
+			inStages: func() []*lane {
+				return []*lane{
+					newStage("DeployTo-prod", laneStatusFailed, "ApprovePromotionTo-prod failed"),
+				}
+			},
+
+			wantedNumLines: 6,
+			wantedOut: "Execution [failed]\n" +
+				"- DeployTo-prod\t[failed]\t[10.0s]\n" +
+				"  ApprovePromotionTo-prod failed\t\t\n" +
+				"\n" +
+				"✘ Status summary\n" +
+				"  Rejected by admin\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &pipelineExecutionComponent{
+				status:  tc.inStatus,
+				summary: tc.inSummary,
+				stages:  tc.inStages(),
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
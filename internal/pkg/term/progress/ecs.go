@@ -95,9 +95,18 @@ func (c *rollingUpdateComponent) Done() <-chan struct{} {
 
 func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int, err error) {
 	header := []string{"", "Revision", "Rollout", "Desired", "Running", "Failed", "Pending"}
+	isTrafficShifted := false
+	for _, d := range c.deployments {
+		if d.TrafficPercent != nil {
+			isTrafficShifted = true
+		}
+	}
+	if isTrafficShifted {
+		header = append(header, "Traffic")
+	}
 	var rows [][]string
 	for _, d := range c.deployments {
-		rows = append(rows, []string{
+		row := []string{
 			d.Status,
 			d.TaskDefRevision,
 			prettifyRolloutStatus(d.RolloutState),
@@ -105,7 +114,11 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 			strconv.Itoa(d.RunningCount),
 			strconv.Itoa(d.FailedCount),
 			strconv.Itoa(d.PendingCount),
-		})
+		}
+		if isTrafficShifted {
+			row = append(row, prettifyTrafficPercent(d.TrafficPercent))
+		}
+		rows = append(rows, row)
 	}
 	table := newTableComponent(color.Faint.Sprintf("Deployments"), header, rows)
 	table.Padding = c.padding
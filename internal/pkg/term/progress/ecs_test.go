@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/stretchr/testify/require"
 )
@@ -98,6 +99,33 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			wantedOut: `Deployments
            Revision  Rollout      Desired  Running  Failed  Pending
   PRIMARY  2         [completed]  10       10       0       0
+`,
+		},
+		"should render the share of traffic served by each deployment": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "2",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "IN_PROGRESS",
+					TrafficPercent:  aws.Int(10),
+				},
+				{
+					Status:          "ACTIVE",
+					TaskDefRevision: "1",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "COMPLETED",
+					TrafficPercent:  aws.Int(90),
+				},
+			},
+
+			wantedNumLines: 4,
+			wantedOut: `Deployments
+           Revision  Rollout        Desired  Running  Failed  Pending  Traffic
+  PRIMARY  2         [in progress]  10       10       0       0        10%
+  ACTIVE   1         [completed]    10       10       0       0        90%
 `,
 		},
 		"should render a single failure event": {
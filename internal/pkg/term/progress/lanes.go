@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Statuses of a lane.
+const (
+	laneStatusWaiting    = "waiting"
+	laneStatusInProgress = "in progress"
+	laneStatusSucceeded  = "complete"
+	laneStatusFailed     = "failed"
+	laneStatusSkipped    = "skipped"
+)
+
+// LaneRenderer renders one line, or lane, per operation with its status and elapsed time.
+// Operations are updated concurrently by calling Start, Succeed, Fail or Skip with the name of the lane.
+type LaneRenderer struct {
+	lanes   []*lane
+	padding int
+
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex // Lock used to mutate data to render.
+}
+
+type lane struct {
+	name      string
+	status    string
+	reason    string
+	stopWatch *stopWatch
+}
+
+// NewLaneRenderer returns a LaneRenderer with a lane for each name in the order provided.
+// All lanes start in the waiting status.
+func NewLaneRenderer(names []string, opts RenderOptions) *LaneRenderer {
+	lanes := make([]*lane, len(names))
+	for i, name := range names {
+		lanes[i] = &lane{
+			name:      name,
+			status:    laneStatusWaiting,
+			stopWatch: newStopWatch(),
+		}
+	}
+	return &LaneRenderer{
+		lanes:   lanes,
+		padding: opts.Padding,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start marks the operation of a lane as in progress.
+func (r *LaneRenderer) Start(name string) {
+	r.update(name, func(l *lane) {
+		l.status = laneStatusInProgress
+		l.stopWatch.start()
+	})
+}
+
+// Succeed marks the operation of a lane as complete.
+func (r *LaneRenderer) Succeed(name string) {
+	r.update(name, func(l *lane) {
+		l.status = laneStatusSucceeded
+		l.stopWatch.stop()
+	})
+}
+
+// Fail marks the operation of a lane as failed with the reason for the failure.
+func (r *LaneRenderer) Fail(name string, reason string) {
+	r.update(name, func(l *lane) {
+		l.status = laneStatusFailed
+		l.reason = reason
+		l.stopWatch.stop()
+	})
+}
+
+// Skip marks the operation of a lane as skipped with the reason why it won't run.
+func (r *LaneRenderer) Skip(name string, reason string) {
+	r.update(name, func(l *lane) {
+		l.status = laneStatusSkipped
+		l.reason = reason
+	})
+}
+
+// Close signals that no more lanes will be updated so that the renderer is done.
+func (r *LaneRenderer) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+// Render prints each lane as a line item followed by the reason of the lane if it failed or was skipped.
+func (r *LaneRenderer) Render(out io.Writer) (numLines int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var components []Renderer
+	for _, l := range r.lanes {
+		components = append(components, l.components(r.padding)...)
+	}
+	buf := new(bytes.Buffer)
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, fmt.Errorf("render lanes: %w", err)
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render lanes to writer: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when Close is called.
+func (r *LaneRenderer) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *LaneRenderer) update(name string, fn func(l *lane)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, l := range r.lanes {
+		if l.name == name {
+			fn(l)
+			return
+		}
+	}
+}
+
+func (l *lane) components(padding int) []Renderer {
+	columns := []string{fmt.Sprintf("- %s", l.name), l.prettyStatus(), prettifyElapsedTime(l.stopWatch)}
+	components := []Renderer{
+		&singleLineComponent{
+			Text:    strings.Join(columns, "\t"),
+			Padding: padding,
+		},
+	}
+	if l.reason == "" {
+		return components
+	}
+	for _, text := range splitByLength(l.reason, maxCellLength) {
+		if l.status == laneStatusFailed {
+			text = colorFailureReason(text)
+		} else {
+			text = color.Faint.Sprint(text)
+		}
+		components = append(components, &singleLineComponent{
+			Text:    strings.Join([]string{text, "", ""}, "\t"),
+			Padding: padding + nestedComponentPadding,
+		})
+	}
+	return components
+}
+
+func (l *lane) prettyStatus() string {
+	switch l.status {
+	case laneStatusSucceeded:
+		return color.Green.Sprintf("[%s]", l.status)
+	case laneStatusFailed:
+		return color.Red.Sprintf("[%s]", l.status)
+	default:
+		return color.Faint.Sprintf("[%s]", l.status)
+	}
+}
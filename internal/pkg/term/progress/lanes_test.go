@@ -0,0 +1,58 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaneRenderer_Render(t *testing.T) {
+	t.Run("should render a line per lane with its status, elapsed time and reason", func(t *testing.T) {
+		// GIVEN
+		r := NewLaneRenderer([]string{"db", "api", "frontend", "worker"}, RenderOptions{})
+		for _, l := range r.lanes {
+			l.stopWatch.clock = &fakeClock{
+				wantedValues: []time.Time{testDate, testDate.Add(10 * time.Second)},
+			}
+		}
+
+		// WHEN
+		r.Start("db")
+		r.Succeed("db")
+		r.Start("api")
+		r.Fail("api", "some error")
+		r.Skip("frontend", "depends on api")
+		r.Start("unknown")
+		buf := new(strings.Builder)
+		nl, err := r.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 6, nl)
+		require.Equal(t, "- db\t[complete]\t[10.0s]\n"+
+			"- api\t[failed]\t[10.0s]\n"+
+			"  some error\t\t\n"+
+			"- frontend\t[skipped]\t\n"+
+			"  depends on api\t\t\n"+
+			"- worker\t[waiting]\t\n", buf.String())
+	})
+}
+
+func TestLaneRenderer_Done(t *testing.T) {
+	t.Run("should be done once closed", func(t *testing.T) {
+		// GIVEN
+		r := NewLaneRenderer([]string{"api"}, RenderOptions{})
+
+		// WHEN
+		r.Close()
+		r.Close() // Closing multiple times is safe.
+
+		// THEN
+		<-r.Done()
+	})
+}
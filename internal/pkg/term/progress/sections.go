@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const defaultSectionTailLines = 5 // Number of lines of output rendered under an operation in progress.
+
+// SectionRenderer renders a section per operation: a line with the status and elapsed time of the operation,
+// followed by the last lines of output of the operation while it's in progress.
+// Operations are updated concurrently by calling Start, Succeed or Fail with the name of the section,
+// and write their output to the io.Writer returned by Writer.
+type SectionRenderer struct {
+	sections []*section
+	padding  int
+
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex // Lock used to mutate data to render.
+}
+
+type section struct {
+	lane
+	output bytes.Buffer // All the output of the operation.
+}
+
+// NewSectionRenderer returns a SectionRenderer with a section for each name in the order provided.
+// All sections start in the waiting status.
+func NewSectionRenderer(names []string, opts RenderOptions) *SectionRenderer {
+	sections := make([]*section, len(names))
+	for i, name := range names {
+		sections[i] = &section{
+			lane: lane{
+				name:      name,
+				status:    laneStatusWaiting,
+				stopWatch: newStopWatch(),
+			},
+		}
+	}
+	return &SectionRenderer{
+		sections: sections,
+		padding:  opts.Padding,
+		done:     make(chan struct{}),
+	}
+}
+
+// Writer returns the io.Writer that buffers the output of the operation of a section.
+func (r *SectionRenderer) Writer(name string) io.Writer {
+	return &sectionWriter{
+		renderer: r,
+		name:     name,
+	}
+}
+
+// Output returns all the output written so far by the operation of a section.
+func (r *SectionRenderer) Output(name string) string {
+	var out string
+	r.update(name, func(s *section) {
+		out = s.output.String()
+	})
+	return out
+}
+
+// Start marks the operation of a section as in progress.
+func (r *SectionRenderer) Start(name string) {
+	r.update(name, func(s *section) {
+		s.status = laneStatusInProgress
+		s.stopWatch.start()
+	})
+}
+
+// Succeed marks the operation of a section as complete.
+func (r *SectionRenderer) Succeed(name string) {
+	r.update(name, func(s *section) {
+		s.status = laneStatusSucceeded
+		s.stopWatch.stop()
+	})
+}
+
+// Fail marks the operation of a section as failed with the reason for the failure.
+func (r *SectionRenderer) Fail(name string, reason string) {
+	r.update(name, func(s *section) {
+		s.status = laneStatusFailed
+		s.reason = reason
+		s.stopWatch.stop()
+	})
+}
+
+// Close signals that no more sections will be updated so that the renderer is done.
+func (r *SectionRenderer) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+// Render prints each section as a line item followed by the reason of the section if it failed,
+// or by the last lines of its output if it's in progress.
+func (r *SectionRenderer) Render(out io.Writer) (numLines int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var components []Renderer
+	for _, s := range r.sections {
+		components = append(components, s.components(r.padding)...)
+		if s.status != laneStatusInProgress {
+			continue
+		}
+		for _, line := range tailLines(s.output.String(), defaultSectionTailLines) {
+			components = append(components, &singleLineComponent{
+				Text:    strings.Join([]string{color.Faint.Sprint(line), "", ""}, "\t"),
+				Padding: r.padding + nestedComponentPadding,
+			})
+		}
+	}
+	buf := new(bytes.Buffer)
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, fmt.Errorf("render sections: %w", err)
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render sections to writer: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when Close is called.
+func (r *SectionRenderer) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *SectionRenderer) update(name string, fn func(s *section)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.sections {
+		if s.name == name {
+			fn(s)
+			return
+		}
+	}
+}
+
+// sectionWriter is an io.Writer that appends to the output of a section.
+type sectionWriter struct {
+	renderer *SectionRenderer
+	name     string
+}
+
+// Write appends p to the output of the section.
+func (w *sectionWriter) Write(p []byte) (int, error) {
+	w.renderer.update(w.name, func(s *section) {
+		s.output.Write(p)
+	})
+	return len(p), nil
+}
+
+// tailLines returns the last n non-empty lines of the output, truncated so that each of them fits in a single line.
+func tailLines(output string, n int) []string {
+	lines := strings.FieldsFunc(output, func(r rune) bool {
+		return r == '\n' || r == '\r'
+	})
+	var tail []string
+	for i := len(lines) - 1; i >= 0 && len(tail) < n; i-- {
+		// Tabs would be interpreted as column separators when the sections are aligned.
+		line := strings.TrimSpace(strings.ReplaceAll(lines[i], "\t", " "))
+		if line == "" {
+			continue
+		}
+		if runes := []rune(line); len(runes) > maxCellLength {
+			line = string(runes[:maxCellLength-3]) + "..."
+		}
+		tail = append([]string{line}, tail...)
+	}
+	return tail
+}
@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSectionRenderer_Render(t *testing.T) {
+	t.Run("should render a line per section followed by the tail of the output of the sections in progress", func(t *testing.T) {
+		// GIVEN
+		r := NewSectionRenderer([]string{"api", "nginx", "logrouter", "envoy"}, RenderOptions{})
+		for _, s := range r.sections {
+			s.stopWatch.clock = &fakeClock{
+				wantedValues: []time.Time{testDate, testDate.Add(10 * time.Second)},
+			}
+		}
+
+		// WHEN
+		r.Start("api")
+		fmt.Fprint(r.Writer("api"), "#1 [internal] load build definition\n#2 [1/2] FROM golang\n\n#3 [2/2] RUN\tgo build\r#4 exporting to image\n")
+		fmt.Fprint(r.Writer("api"), "#5 naming to docker.io/")
+		fmt.Fprint(r.Writer("api"), "library/api\n#6 DONE 0.1s\n")
+		r.Start("nginx")
+		fmt.Fprint(r.Writer("nginx"), "Step 1/2 : FROM nginx\n")
+		r.Succeed("nginx")
+		r.Start("logrouter")
+		r.Fail("logrouter", "some error")
+		r.Start("unknown")
+		buf := new(strings.Builder)
+		nl, err := r.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 10, nl)
+		require.Equal(t, "- api\t[in progress]\t[10.0s]\n"+
+			"  #2 [1/2] FROM golang\t\t\n"+
+			"  #3 [2/2] RUN go build\t\t\n"+
+			"  #4 exporting to image\t\t\n"+
+			"  #5 naming to docker.io/library/api\t\t\n"+
+			"  #6 DONE 0.1s\t\t\n"+
+			"- nginx\t[complete]\t[10.0s]\n"+
+			"- logrouter\t[failed]\t[10.0s]\n"+
+			"  some error\t\t\n"+
+			"- envoy\t[waiting]\t\n", buf.String())
+		require.Equal(t, "Step 1/2 : FROM nginx\n", r.Output("nginx"))
+	})
+}
+
+func TestSectionRenderer_Done(t *testing.T) {
+	t.Run("should be done once closed", func(t *testing.T) {
+		// GIVEN
+		r := NewSectionRenderer([]string{"api"}, RenderOptions{})
+
+		// WHEN
+		r.Close()
+		r.Close() // Closing multiple times is safe.
+
+		// THEN
+		<-r.Done()
+	})
+}
+
+func TestTailLines(t *testing.T) {
+	long := strings.Repeat("a", 100)
+
+	require.Equal(t, []string{"b", "c"}, tailLines("a\nb\n\n  c  \n", 2))
+	require.Equal(t, []string{strings.Repeat("a", 67) + "..."}, tailLines(long, 5))
+	require.Empty(t, tailLines("", 5))
+}
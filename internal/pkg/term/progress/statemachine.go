@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	maxExecutionEventsToDisplay = 8 // Total number of state transitions we want to display at most for a state machine execution.
+	executionEventTimeFormat    = "15:04:05"
+)
+
+// StateMachineExecutionSubscriber is the interface to subscribe channels to state machine execution descriptions.
+type StateMachineExecutionSubscriber interface {
+	Subscribe() <-chan stream.StateMachineExecution
+}
+
+// ListeningStateMachineExecutionRenderer renders the status and state transitions of a state machine execution.
+func ListeningStateMachineExecutionRenderer(streamer StateMachineExecutionSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &stateMachineExecutionComponent{
+		padding:   opts.Padding,
+		maxEvents: maxExecutionEventsToDisplay,
+		stream:    streamer.Subscribe(),
+		done:      make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type stateMachineExecutionComponent struct {
+	// Data to render.
+	status string
+	cause  string
+	events []stream.StateMachineEvent
+
+	// Style configuration for the component.
+	padding   int
+	maxEvents int
+
+	stream <-chan stream.StateMachineExecution // Channel where execution descriptions are received.
+	done   chan struct{}                       // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                          // Lock used to mutate data to render.
+}
+
+// Listen updates the execution status and the latest state transitions as events are streamed.
+func (c *stateMachineExecutionComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.status = ev.Status
+		c.cause = ev.Cause
+		c.events = append(c.events, ev.LatestEvents...)
+		if len(c.events) > c.maxEvents {
+			c.events = c.events[len(c.events)-c.maxEvents:]
+		}
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the execution status, the state transitions as a tableComponent and the failure cause if any.
+func (c *stateMachineExecutionComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	components := []Renderer{
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s %s", color.Faint.Sprintf("Execution"), prettifyRolloutStatus(c.status)),
+			Padding: c.padding,
+		},
+		c.eventsTable(),
+	}
+	components = append(components, c.failureCause()...)
+
+	buf := new(bytes.Buffer)
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, fmt.Errorf("render state machine execution component: %w", err)
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render state machine execution component to writer: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *stateMachineExecutionComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *stateMachineExecutionComponent) eventsTable() Renderer {
+	var rows [][]string
+	for _, ev := range c.events {
+		rows = append(rows, []string{
+			ev.Timestamp.Format(executionEventTimeFormat),
+			ev.StateName,
+			prettifyExecutionEvent(ev),
+		})
+	}
+	table := newTableComponent(color.Faint.Sprintf("State transitions"), []string{"Time", "State", "Event"}, rows)
+	table.Padding = c.padding
+	return table
+}
+
+func (c *stateMachineExecutionComponent) failureCause() []Renderer {
+	if c.cause == "" {
+		return nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the failure cause.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Failure cause")),
+			Padding: c.padding,
+		},
+	}
+	for _, line := range splitByLength(c.cause, maxCellLength) {
+		components = append(components, &singleLineComponent{
+			Text:    line,
+			Padding: c.padding + nestedComponentPadding,
+		})
+	}
+	return components
+}
+
+// prettifyExecutionEvent returns a lowercase description of the event type, such as "task failed",
+// along with the retry attempt and the error of the event if any.
+func prettifyExecutionEvent(ev stream.StateMachineEvent) string {
+	pretty := splitCamelCase(ev.Type)
+	if ev.Type == sfn.HistoryEventTypeTaskScheduled && ev.Attempt > 1 {
+		pretty = fmt.Sprintf("%s (retry %d)", pretty, ev.Attempt-1)
+	}
+	if ev.Error != "" {
+		pretty = fmt.Sprintf("%s: %s", pretty, ev.Error)
+	}
+	return pretty
+}
+
+// splitCamelCase returns the lowercase words of s separated by spaces.
+// For example, given "TaskStateEntered" the output is "task state entered".
+func splitCamelCase(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			sb.WriteRune(' ')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
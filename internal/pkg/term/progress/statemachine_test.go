@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateMachineExecutionComponent_Listen(t *testing.T) {
+	t.Run("should update the status to the latest event and respect the max number of events", func(t *testing.T) {
+		// GIVEN
+		events := make(chan stream.StateMachineExecution)
+		done := make(chan struct{})
+		c := &stateMachineExecutionComponent{
+			maxEvents: 2,
+			stream:    events,
+			done:      done,
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			events <- stream.StateMachineExecution{
+				Status: "RUNNING",
+				LatestEvents: []stream.StateMachineEvent{
+					{Type: "ExecutionStarted"},
+					{Type: "TaskStateEntered"},
+				},
+			}
+			events <- stream.StateMachineExecution{
+				Status: "FAILED",
+				Cause:  "some cause",
+				LatestEvents: []stream.StateMachineEvent{
+					{Type: "ExecutionFailed"},
+				},
+			}
+			close(events)
+		}()
+
+		// THEN
+		<-done // Listen should have closed the channel.
+		require.Equal(t, "FAILED", c.status)
+		require.Equal(t, "some cause", c.cause)
+		require.Equal(t, []stream.StateMachineEvent{
+			{Type: "TaskStateEntered"},
+			{Type: "ExecutionFailed"},
+		}, c.events, "expected max number of events to be respected")
+	})
+}
+
+func TestStateMachineExecutionComponent_Render(t *testing.T) {
+	mockTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inStatus string
+		inCause  string
+		inEvents []stream.StateMachineEvent
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render the status and the state transitions": {
+			inStatus: "RUNNING",
+			inEvents: []stream.StateMachineEvent{
+				{Type: "TaskStateEntered", Timestamp: mockTime, StateName: "Run Fargate Task"},
+				{Type: "TaskFailed", Timestamp: mockTime, StateName: "Run Fargate Task", Attempt: 1, Error: "States.TaskFailed"},
+				{Type: "TaskScheduled", Timestamp: mockTime, StateName: "Run Fargate Task", Attempt: 2},
+			},
+
+			wantedNumLines: 6,
+			wantedOut: `Execution [running]
+State transitions
+  Time      State             Event
+  18:00:00  Run Fargate Task  task state entered
+  18:00:00  Run Fargate Task  task failed: States.TaskFailed
+  18:00:00  Run Fargate Task  task scheduled (retry 1)
+`,
+		},
+		"should render the failure cause": {
+			inStatus: "FAILED",
+			inCause:  "Essential container in task exited",
+
+			wantedNumLines: 4,
+			wantedOut: `Execution [failed]
+
+✘ Failure cause
+  Essential container in task exited
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &stateMachineExecutionComponent{
+				status: tc.inStatus,
+				cause:  tc.inCause,
+				events: tc.inEvents,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
@@ -63,6 +63,13 @@ func prettifyRolloutStatus(rollout string) string {
 	return fmt.Sprintf("[%s]", pretty)
 }
 
+func prettifyTrafficPercent(percent *int) string {
+	if percent == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", *percent)
+}
+
 func prettifyElapsedTime(sw *stopWatch) string {
 	elapsed, hasStarted := sw.elapsed()
 	if !hasStarted {
@@ -23,6 +23,8 @@
 package workspace
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding"
 	"errors"
 	"fmt"
@@ -403,6 +405,22 @@ func (ws *Workspace) WriteServiceManifest(marshaler encoding.BinaryMarshaler, na
 	return ws.write(data, name, manifestFileName)
 }
 
+// OverwriteWorkloadManifest replaces the content of an existing workload's manifest under the copilot/{name}/ directory.
+func (ws *Workspace) OverwriteWorkloadManifest(mft WorkloadManifest, name string) (string, error) {
+	filename := filepath.Join(ws.copilotDirAbs, name, manifestFileName)
+	exist, err := ws.fs.Exists(filename)
+	if err != nil {
+		return "", fmt.Errorf("check if manifest file %s exists: %w", filename, err)
+	}
+	if !exist {
+		return "", &ErrFileNotExists{FileName: filename}
+	}
+	if err := ws.fs.WriteFile(filename, mft, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
 // WriteJobManifest writes the job's manifest under the copilot/{name}/ directory.
 func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
@@ -514,6 +532,47 @@ func (ws *Workspace) ReadFile(fPath string) ([]byte, error) {
 	return ws.fs.ReadFile(fPath)
 }
 
+// ZipCopilotDir returns a zip archive of the "copilot/" directory.
+// The names of the files in the archive are relative to the workspace, such as "copilot/api/manifest.yml".
+func (ws *Workspace) ZipCopilotDir() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	if err := ws.fs.Walk(ws.copilotDirAbs, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(ws.Path(), path)
+		if err != nil {
+			return fmt.Errorf("get relative path of %s: %w", path, err)
+		}
+		content, err := ws.fs.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", path, err)
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return fmt.Errorf("create zip file header for %s: %w", path, err)
+		}
+		header.Name = filepath.ToSlash(name)
+		header.Method = zip.Deflate
+		w, err := archive.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("create zip file %s: %w", header.Name, err)
+		}
+		_, err = w.Write(content)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close zip archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
 // Write writes the content under the path relative to "copilot/" directory.
 // If successful returns the full path of the file, otherwise an empty string and an error.
 func (ws *Workspace) Write(content encoding.BinaryMarshaler, path string) (string, error) {
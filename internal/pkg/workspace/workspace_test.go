@@ -4,8 +4,11 @@
 package workspace
 
 import (
+	"archive/zip"
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -1186,6 +1189,48 @@ func TestWorkspace_ListFiles(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ZipCopilotDir(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	fs.MkdirAll("/project/copilot/api/addons", 0755)
+	fs.MkdirAll("/project/copilot/pipelines/release", 0755)
+	fs.MkdirAll("/project/src", 0755)
+	afero.WriteFile(fs, "/project/copilot/.workspace", []byte("application: phonetool"), 0644)
+	afero.WriteFile(fs, "/project/copilot/api/manifest.yml", []byte("name: api"), 0644)
+	afero.WriteFile(fs, "/project/copilot/api/addons/db.yml", []byte("Resources:"), 0644)
+	afero.WriteFile(fs, "/project/copilot/pipelines/release/buildspec.yml", []byte("version: 0.2"), 0644)
+	afero.WriteFile(fs, "/project/src/main.go", []byte("package main"), 0644)
+	ws := &Workspace{
+		copilotDirAbs: "/project/copilot",
+		fs: &afero.Afero{
+			Fs: fs,
+		},
+	}
+
+	// WHEN
+	content, err := ws.ZipCopilotDir()
+
+	// THEN
+	require.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+		files[f.Name] = string(data)
+	}
+	require.Equal(t, map[string]string{
+		"copilot/.workspace":                      "application: phonetool",
+		"copilot/api/manifest.yml":                "name: api",
+		"copilot/api/addons/db.yml":               "Resources:",
+		"copilot/pipelines/release/buildspec.yml": "version: 0.2",
+	}, files)
+}
+
 func TestWorkspace_ReadFile(t *testing.T) {
 	testCases := map[string]struct {
 		fPath string
@@ -1290,6 +1335,62 @@ func TestWorkspace_Write(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwriteWorkloadManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"return error if the manifest does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				return fs
+			},
+			wantedErr: &ErrFileNotExists{FileName: "/copilot/webhook/manifest.yml"},
+		},
+		"replaces the content of the existing manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte("name: webhook\ncount: 1\n"), 0644)
+				return fs
+			},
+			wantedPath: "/copilot/webhook/manifest.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: tc.fs(),
+			}
+			ws := &Workspace{
+				workingDirAbs: "/",
+				copilotDirAbs: "/copilot",
+				fs:            utils,
+			}
+			mft := WorkloadManifest("name: webhook\ncount: 3\n")
+
+			// WHEN
+			actualPath, actualErr := ws.OverwriteWorkloadManifest(mft, "webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, tc.wantedPath, actualPath)
+			out, err := utils.ReadFile(tc.wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, []byte(mft), out)
+		})
+	}
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"